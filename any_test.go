@@ -0,0 +1,90 @@
+package into_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+type celsius float64
+
+func TestTryIntoAny(t *testing.T) {
+	n := 42
+	var nilPtr *int
+	named := celsius(36.6)
+
+	tests := []struct {
+		name    string
+		input   any
+		want    int16
+		wantErr bool
+	}{
+		{"jsonFloat", float64(8080), 8080, false},
+		{"jsonNumber", json.Number("123"), 123, false},
+		{"string", "-7", -7, false},
+		{"bool", true, 1, false},
+		{"namedFloat", named, 36, false},
+		{"pointer", &n, 42, false},
+		{"pointerToPointer", func() any { p := &n; return &p }(), 42, false},
+		{"pointerToNamed", &named, 36, false},
+		{"overflow", int64(40000), 0, true},
+		{"nil", nil, 0, true},
+		{"nilPointer", nilPtr, 0, true},
+		{"unsupported", []int{1}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryIntoAny[int16](tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TryIntoAny() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := TryIntoAny[int](nil); !errors.Is(err, ErrNil) {
+		t.Errorf("TryIntoAny(nil) error = %v, want ErrNil", err)
+	}
+	if got, err := TryIntoAny[celsius]("21.5"); err != nil || got != 21.5 {
+		t.Errorf("TryIntoAny[celsius](\"21.5\") = %v, %v, want 21.5", got, err)
+	}
+}
+
+func TestTryIntoValue(t *testing.T) {
+	var port uint16
+	if err := TryIntoValue(reflect.ValueOf(&port).Elem(), reflect.ValueOf("8080")); err != nil || port != 8080 {
+		t.Errorf("TryIntoValue(uint16, \"8080\") = %v, %v, want 8080", port, err)
+	}
+
+	// A failed conversion leaves the destination unchanged.
+	if err := TryIntoValue(reflect.ValueOf(&port).Elem(), reflect.ValueOf(-1)); err == nil || port != 8080 {
+		t.Errorf("TryIntoValue(uint16, -1) = %v, %v, want error and 8080", port, err)
+	}
+
+	// Nil pointers in the destination are allocated.
+	var ptr *celsius
+	if err := TryIntoValue(reflect.ValueOf(&ptr).Elem(), reflect.ValueOf(int8(20))); err != nil || ptr == nil || *ptr != 20 {
+		t.Errorf("TryIntoValue(*celsius, 20) = %v, %v, want 20", ptr, err)
+	}
+
+	// Values read from unexported fields are accepted as sources.
+	src := struct{ level celsius }{level: 3.9}
+	var level int
+	if err := TryIntoValue(reflect.ValueOf(&level).Elem(), reflect.ValueOf(src).Field(0)); err != nil || level != 3 {
+		t.Errorf("TryIntoValue(int, unexported field) = %v, %v, want 3", level, err)
+	}
+
+	if err := TryIntoValue(reflect.ValueOf(port), reflect.ValueOf(1)); err == nil {
+		t.Error("TryIntoValue() with an unsettable destination error = nil")
+	}
+	if err := TryIntoValue(reflect.ValueOf(&port).Elem(), reflect.Value{}); !errors.Is(err, ErrNil) {
+		t.Errorf("TryIntoValue() with an invalid source error = %v, want ErrNil", err)
+	}
+}
@@ -0,0 +1,255 @@
+package into
+
+import "strconv"
+
+// AppendIntToBytes appends the string form of an int value to a byte slice.
+//
+// AppendIntToBytes appends an int value to dst in the form returned by
+// IntToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the int value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendIntToBytes([]byte("value="), -42)
+//	fmt.Println(string(buf)) // Output: value=-42
+func AppendIntToBytes(dst []byte, value int) []byte {
+	return strconv.AppendInt(dst, int64(value), 10)
+}
+
+// AppendInt8ToBytes appends the string form of an int8 value to a byte slice.
+//
+// AppendInt8ToBytes appends an int8 value to dst in the form returned by
+// Int8ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the int8 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendInt8ToBytes([]byte("value="), -8)
+//	fmt.Println(string(buf)) // Output: value=-8
+func AppendInt8ToBytes(dst []byte, value int8) []byte {
+	return strconv.AppendInt(dst, int64(value), 10)
+}
+
+// AppendInt16ToBytes appends the string form of an int16 value to a byte slice.
+//
+// AppendInt16ToBytes appends an int16 value to dst in the form returned by
+// Int16ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the int16 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendInt16ToBytes([]byte("value="), -16)
+//	fmt.Println(string(buf)) // Output: value=-16
+func AppendInt16ToBytes(dst []byte, value int16) []byte {
+	return strconv.AppendInt(dst, int64(value), 10)
+}
+
+// AppendInt32ToBytes appends the string form of an int32 value to a byte slice.
+//
+// AppendInt32ToBytes appends an int32 value to dst in the form returned by
+// Int32ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the int32 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendInt32ToBytes([]byte("value="), -32)
+//	fmt.Println(string(buf)) // Output: value=-32
+func AppendInt32ToBytes(dst []byte, value int32) []byte {
+	return strconv.AppendInt(dst, int64(value), 10)
+}
+
+// AppendInt64ToBytes appends the string form of an int64 value to a byte slice.
+//
+// AppendInt64ToBytes appends an int64 value to dst in the form returned by
+// Int64ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the int64 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendInt64ToBytes([]byte("value="), -64)
+//	fmt.Println(string(buf)) // Output: value=-64
+func AppendInt64ToBytes(dst []byte, value int64) []byte {
+	return strconv.AppendInt(dst, value, 10)
+}
+
+// AppendUintToBytes appends the string form of a uint value to a byte slice.
+//
+// AppendUintToBytes appends a uint value to dst in the form returned by
+// UintToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the uint value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendUintToBytes([]byte("value="), 42)
+//	fmt.Println(string(buf)) // Output: value=42
+func AppendUintToBytes(dst []byte, value uint) []byte {
+	return strconv.AppendUint(dst, uint64(value), 10)
+}
+
+// AppendUint8ToBytes appends the string form of a uint8 value to a byte slice.
+//
+// AppendUint8ToBytes appends a uint8 value to dst in the form returned by
+// Uint8ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the uint8 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendUint8ToBytes([]byte("value="), 8)
+//	fmt.Println(string(buf)) // Output: value=8
+func AppendUint8ToBytes(dst []byte, value uint8) []byte {
+	return strconv.AppendUint(dst, uint64(value), 10)
+}
+
+// AppendUint16ToBytes appends the string form of a uint16 value to a byte slice.
+//
+// AppendUint16ToBytes appends a uint16 value to dst in the form returned by
+// Uint16ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the uint16 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendUint16ToBytes([]byte("value="), 16)
+//	fmt.Println(string(buf)) // Output: value=16
+func AppendUint16ToBytes(dst []byte, value uint16) []byte {
+	return strconv.AppendUint(dst, uint64(value), 10)
+}
+
+// AppendUint32ToBytes appends the string form of a uint32 value to a byte slice.
+//
+// AppendUint32ToBytes appends a uint32 value to dst in the form returned by
+// Uint32ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the uint32 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendUint32ToBytes([]byte("value="), 32)
+//	fmt.Println(string(buf)) // Output: value=32
+func AppendUint32ToBytes(dst []byte, value uint32) []byte {
+	return strconv.AppendUint(dst, uint64(value), 10)
+}
+
+// AppendUint64ToBytes appends the string form of a uint64 value to a byte slice.
+//
+// AppendUint64ToBytes appends a uint64 value to dst in the form returned by
+// Uint64ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the uint64 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendUint64ToBytes([]byte("value="), 64)
+//	fmt.Println(string(buf)) // Output: value=64
+func AppendUint64ToBytes(dst []byte, value uint64) []byte {
+	return strconv.AppendUint(dst, value, 10)
+}
+
+// AppendFloat32ToBytes appends the string form of a float32 value to a byte slice.
+//
+// AppendFloat32ToBytes appends a float32 value to dst in the form returned by
+// Float32ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the float32 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendFloat32ToBytes([]byte("value="), 0.1)
+//	fmt.Println(string(buf)) // Output: value=0.1
+func AppendFloat32ToBytes(dst []byte, value float32) []byte {
+	return strconv.AppendFloat(dst, float64(value), 'f', -1, 32)
+}
+
+// AppendFloat64ToBytes appends the string form of a float64 value to a byte slice.
+//
+// AppendFloat64ToBytes appends a float64 value to dst in the form returned by
+// Float64ToString and returns the extended slice, so that serialization code
+// can reuse a buffer instead of allocating a string for each value.
+//
+// Parameters:
+//   - dst: the byte slice to append to; it may be nil.
+//   - value: the float64 value to be appended.
+//
+// Returns:
+//   - []byte: the extended byte slice.
+//
+// Example:
+//
+//	buf := AppendFloat64ToBytes([]byte("value="), 1e21)
+//	fmt.Println(string(buf)) // Output: value=1000000000000000000000
+func AppendFloat64ToBytes(dst []byte, value float64) []byte {
+	return strconv.AppendFloat(dst, value, 'f', -1, 64)
+}
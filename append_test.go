@@ -0,0 +1,55 @@
+package into_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+// TestAppendToBytes checks that the Append functions produce the same
+// string as the corresponding ToString converters.
+func TestAppendToBytes(t *testing.T) {
+	tests := []struct {
+		name   string
+		append func([]byte) []byte
+		want   func() (string, error)
+	}{
+		{"Int", func(b []byte) []byte { return AppendIntToBytes(b, math.MinInt) }, func() (string, error) { return IntToString(math.MinInt) }},
+		{"Int8", func(b []byte) []byte { return AppendInt8ToBytes(b, math.MinInt8) }, func() (string, error) { return Int8ToString(math.MinInt8) }},
+		{"Int16", func(b []byte) []byte { return AppendInt16ToBytes(b, math.MaxInt16) }, func() (string, error) { return Int16ToString(math.MaxInt16) }},
+		{"Int32", func(b []byte) []byte { return AppendInt32ToBytes(b, -1) }, func() (string, error) { return Int32ToString(-1) }},
+		{"Int64", func(b []byte) []byte { return AppendInt64ToBytes(b, math.MaxInt64) }, func() (string, error) { return Int64ToString(math.MaxInt64) }},
+		{"Uint", func(b []byte) []byte { return AppendUintToBytes(b, math.MaxUint) }, func() (string, error) { return UintToString(math.MaxUint) }},
+		{"Uint8", func(b []byte) []byte { return AppendUint8ToBytes(b, math.MaxUint8) }, func() (string, error) { return Uint8ToString(math.MaxUint8) }},
+		{"Uint16", func(b []byte) []byte { return AppendUint16ToBytes(b, 0) }, func() (string, error) { return Uint16ToString(0) }},
+		{"Uint32", func(b []byte) []byte { return AppendUint32ToBytes(b, math.MaxUint32) }, func() (string, error) { return Uint32ToString(math.MaxUint32) }},
+		{"Uint64", func(b []byte) []byte { return AppendUint64ToBytes(b, math.MaxUint64) }, func() (string, error) { return Uint64ToString(math.MaxUint64) }},
+		{"Float32", func(b []byte) []byte { return AppendFloat32ToBytes(b, 0.1) }, func() (string, error) { return Float32ToString(0.1) }},
+		{"Float32 NaN", func(b []byte) []byte { return AppendFloat32ToBytes(b, float32(math.NaN())) }, func() (string, error) { return Float32ToString(float32(math.NaN())) }},
+		{"Float64", func(b []byte) []byte { return AppendFloat64ToBytes(b, 1e21) }, func() (string, error) { return Float64ToString(1e21) }},
+		{"Float64 -Inf", func(b []byte) []byte { return AppendFloat64ToBytes(b, math.Inf(-1)) }, func() (string, error) { return Float64ToString(math.Inf(-1)) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tt.want()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(tt.append([]byte("x="))); got != "x="+want {
+				t.Errorf("got %q, want %q", got, "x="+want)
+			}
+		})
+	}
+}
+
+func TestAppendToBytesAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendInt64ToBytes(buf[:0], math.MinInt64)
+		buf = AppendFloat64ToBytes(buf, math.Pi)
+	})
+	if allocs != 0 {
+		t.Errorf("allocs = %v, want 0", allocs)
+	}
+}
@@ -0,0 +1,168 @@
+package into
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// LossKind classifies the information lost by a conversion.
+type LossKind int
+
+const (
+	// LossFraction is reported when the fraction of a float is dropped by
+	// the Rounding option, as in 2.5 to 2.
+	LossFraction LossKind = iota
+	// LossPrecision is reported when a number is rounded to the nearest
+	// value of the target type, as in int64(1<<53 + 1) to float64.
+	LossPrecision
+	// LossRange is reported when an out-of-range number is clamped to the
+	// target range by the Saturate policy.
+	LossRange
+	// LossNaN is reported when NaN is converted to 0 by the Zero policy.
+	LossNaN
+	// LossTimezone is reported when a time.Time with a nonzero UTC offset
+	// is formatted with a TimeLayout that has no zone.
+	LossTimezone
+)
+
+// String returns a description of k, such as "fraction dropped".
+func (k LossKind) String() string {
+	switch k {
+	case LossFraction:
+		return "fraction dropped"
+	case LossPrecision:
+		return "precision lost"
+	case LossRange:
+		return "clamped to range"
+	case LossNaN:
+		return "NaN converted to zero"
+	case LossTimezone:
+		return "timezone dropped"
+	}
+	return fmt.Sprintf("LossKind(%d)", int(k))
+}
+
+// Loss describes a conversion that succeeded but lost information.
+type Loss struct {
+	// Kind is the kind of information lost.
+	Kind LossKind
+	// From is the basic type of the source value.
+	From reflect.Type
+	// To is the target type.
+	To reflect.Type
+	// Value is the source value.
+	Value any
+	// Result is the converted value.
+	Result any
+}
+
+// String describes l, e.g. "float64 2.5 to int 2: fraction dropped".
+func (l Loss) String() string {
+	return fmt.Sprintf("%s %v to %s %v: %s", l.From, l.Value, l.To, l.Result, l.Kind)
+}
+
+// Auditor is called with each lossy conversion.
+type Auditor func(loss Loss)
+
+// auditor holds an auditorHolder, since atomic.Value cannot store nil.
+var auditor atomic.Value
+
+type auditorHolder struct{ fn Auditor }
+
+// SetAuditor sets the function called for each conversion that loses information.
+//
+// SetAuditor enables the audit mode: fn is called after every successful
+// conversion by TryInto, TryIntoWith, TryIntoAny, TryIntoValue and the
+// functions built on them whose result does not represent the source value,
+// because a fraction was dropped, a number was rounded or clamped, NaN was
+// converted to zero or a timezone was dropped. Failed conversions are not
+// reported. This helps to find the call sites that depend on lossy
+// conversions when migrating code that casts blindly. fn is called on the
+// goroutine of the conversion and must be safe for concurrent use. A nil fn
+// disables the audit mode. It is safe for concurrent use.
+//
+// Parameters:
+//   - fn: the auditor, or nil.
+//
+// Example:
+//
+//	SetAuditor(func(loss Loss) {
+//	  log.Printf("lossy conversion: %s", loss)
+//	})
+//	_, _ = TryIntoWith[int](2.5, Options{Rounding: HalfUp})
+//	// lossy conversion: float64 2.5 to int 3: fraction dropped
+func SetAuditor(fn Auditor) {
+	auditor.Store(auditorHolder{fn})
+}
+
+// loadAuditor returns the auditor set by SetAuditor, or nil.
+func loadAuditor() Auditor {
+	h, _ := auditor.Load().(auditorHolder)
+	return h.fn
+}
+
+// auditLoss calls fn if converting the basic value value to result, of
+// type dst, under opts lost information.
+func auditLoss(fn Auditor, dst reflect.Type, value any, result any, opts Options) {
+	if kind, ok := lossOf(reflect.ValueOf(value), reflect.ValueOf(result), opts); ok {
+		fn(Loss{Kind: kind, From: reflect.TypeOf(value), To: dst, Value: value, Result: result})
+	}
+}
+
+// lossOf reports the information lost by converting src to dst, if any.
+func lossOf(src, dst reflect.Value, opts Options) (LossKind, bool) {
+	if t, ok := src.Interface().(time.Time); ok {
+		if dst.Kind() != reflect.String || opts.TimeLayout == "" {
+			return 0, false
+		}
+		_, offset := t.Zone()
+		return LossTimezone, offset != 0 && !layoutHasZone(opts.TimeLayout)
+	}
+
+	srcFloat := src.Kind() == reflect.Float32 || src.Kind() == reflect.Float64
+	dstFloat := dst.Kind() == reflect.Float32 || dst.Kind() == reflect.Float64
+	if !isNumericKind(src.Kind()) || !isNumericKind(dst.Kind()) {
+		return 0, false
+	}
+	if opts.FloatBits && srcFloat != dstFloat {
+		// The bits are reinterpreted rather than converted.
+		return 0, false
+	}
+	if srcFloat && math.IsNaN(src.Float()) {
+		return LossNaN, !dstFloat
+	}
+
+	from, to := bigValue(src), bigValue(dst)
+	switch {
+	case from.Cmp(to) == 0:
+		return 0, false
+	case !fitsKind(dst.Kind(), src):
+		return LossRange, true
+	case srcFloat && !dstFloat && !from.IsInt():
+		return LossFraction, true
+	}
+	return LossPrecision, true
+}
+
+// bigValue returns the numeric value rv exactly.
+func bigValue(rv reflect.Value) *big.Float {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Float).SetUint64(rv.Uint())
+	}
+	return new(big.Float).SetFloat64(rv.Float())
+}
+
+// layoutHasZone reports whether times formatted with layout keep their UTC
+// offset, by formatting the same wall clock time in two zones.
+func layoutHasZone(layout string) bool {
+	utc := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	east := time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("EAST", 3600))
+	return utc.Format(layout) != east.Format(layout)
+}
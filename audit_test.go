@@ -0,0 +1,96 @@
+package into_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestSetAuditor(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	var (
+		mu     sync.Mutex
+		losses []Loss
+	)
+	SetAuditor(func(loss Loss) {
+		mu.Lock()
+		defer mu.Unlock()
+		losses = append(losses, loss)
+	})
+	defer SetAuditor(nil)
+
+	zone := time.FixedZone("CET", 3600)
+	tests := []struct {
+		name    string
+		convert func()
+		want    []LossKind
+	}{
+		{"exact", func() { _, _ = TryInto[int8](2.0) }, nil},
+		{"truncated", func() { _, _ = TryInto[int](2.5) }, []LossKind{LossFraction}},
+		{"rounded", func() { _, _ = TryIntoWith[int](-2.5, Options{Rounding: Floor}) }, []LossKind{LossFraction}},
+		{"precision", func() { _, _ = TryInto[float64](int64(1<<53 + 1)) }, []LossKind{LossPrecision}},
+		{"float32", func() { _, _ = TryInto[float32](0.1) }, []LossKind{LossPrecision}},
+		{"float32 exact", func() { _, _ = TryInto[float32](0.5) }, nil},
+		{"saturated", func() { _, _ = TryIntoWith[int8](300, Options{Overflow: Saturate}) }, []LossKind{LossRange}},
+		{"saturated float", func() { _, _ = TryIntoWith[uint8](-1.5, Options{Overflow: Saturate}) }, []LossKind{LossRange}},
+		{"NaN", func() { _, _ = TryIntoWith[int](math.NaN(), Options{NaN: Zero}) }, []LossKind{LossNaN}},
+		{"NaN float", func() { _, _ = TryInto[float32](math.NaN()) }, nil},
+		{"failed", func() { _, _ = TryInto[int8](300) }, nil},
+		{"string", func() { _, _ = TryInto[int]("42") }, nil},
+		{"float bits", func() { _, _ = TryIntoWith[uint64](0.1, Options{FloatBits: true}) }, nil},
+		{"duration", func() { _, _ = TryIntoAny[time.Duration](1.5) }, []LossKind{LossFraction}},
+		{"timezone", func() {
+			SetDefaults(Options{TimeLayout: "2006-01-02 15:04"})
+			_, _ = TryIntoAny[string](time.Date(2024, 1, 2, 3, 4, 0, 0, zone))
+		}, []LossKind{LossTimezone}},
+		{"timezone kept", func() {
+			SetDefaults(Options{TimeLayout: "2006-01-02 15:04 -0700"})
+			_, _ = TryIntoAny[string](time.Date(2024, 1, 2, 3, 4, 0, 0, zone))
+		}, nil},
+		{"UTC", func() {
+			SetDefaults(Options{TimeLayout: "2006-01-02 15:04"})
+			_, _ = TryIntoAny[string](time.Date(2024, 1, 2, 3, 4, 0, 0, time.UTC))
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			losses = nil
+			SetDefaults(Options{})
+			tt.convert()
+			var got []LossKind
+			for _, loss := range losses {
+				got = append(got, loss.Kind)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("reported %v, want %v", losses, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("reported %v, want %v", losses, tt.want)
+				}
+			}
+		})
+	}
+
+	SetAuditor(nil)
+	losses = nil
+	_, _ = TryInto[int](2.5)
+	if len(losses) != 0 {
+		t.Errorf("auditor called after SetAuditor(nil)")
+	}
+}
+
+func TestLossString(t *testing.T) {
+	var got Loss
+	SetAuditor(func(loss Loss) { got = loss })
+	defer SetAuditor(nil)
+
+	_, _ = TryInto[int](2.5)
+	if want := "float64 2.5 to int 2: fraction dropped"; got.String() != want {
+		t.Errorf("String() = %q, want %q", got.String(), want)
+	}
+}
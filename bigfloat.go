@@ -0,0 +1,152 @@
+package into
+
+import (
+	"math"
+	"math/big"
+)
+
+func init() {
+	Register(Int64ToBigFloat)
+	Register(Uint64ToBigFloat)
+	Register(Float64ToBigFloat)
+	Register(BigFloatToFloat64)
+	Register(BigFloatToString)
+}
+
+// Int64ToBigFloat converts an int64 value to a *big.Float value without rounding.
+//
+// Int64ToBigFloat converts an int64 value to a *big.Float value with a
+// 64-bit mantissa, so every int64 value is represented exactly, unlike with
+// Int64ToFloat64, which rounds values beyond 2^53. The registry converts
+// int64 values to *big.Float with it.
+//
+// Parameters:
+//   - value: the int64 value to be converted.
+//
+// Returns:
+//   - *big.Float: the converted *big.Float value.
+//   - error: No error is returned.
+//
+// Example:
+//
+//	result, _ := Int64ToBigFloat(math.MaxInt64)
+//	fmt.Println(result.Text('f', -1)) // Output: 9223372036854775807
+func Int64ToBigFloat(value int64) (*big.Float, error) {
+	return new(big.Float).SetInt64(value), nil
+}
+
+// Uint64ToBigFloat converts a uint64 value to a *big.Float value without rounding.
+//
+// Uint64ToBigFloat converts a uint64 value to a *big.Float value with a
+// 64-bit mantissa, so every uint64 value is represented exactly, unlike with
+// Uint64ToFloat64, which rounds values beyond 2^53. The registry converts
+// uint64 values to *big.Float with it.
+//
+// Parameters:
+//   - value: the uint64 value to be converted.
+//
+// Returns:
+//   - *big.Float: the converted *big.Float value.
+//   - error: No error is returned.
+//
+// Example:
+//
+//	result, _ := Uint64ToBigFloat(math.MaxUint64)
+//	fmt.Println(result.Text('f', -1)) // Output: 18446744073709551615
+func Uint64ToBigFloat(value uint64) (*big.Float, error) {
+	return new(big.Float).SetUint64(value), nil
+}
+
+// Float64ToBigFloat converts a float64 value to a *big.Float value.
+//
+// Float64ToBigFloat converts a float64 value to a *big.Float value with a
+// 53-bit mantissa, which represents every finite float64 exactly. The
+// infinities are kept, but NaN has no *big.Float representation. The
+// registry converts float64 values to *big.Float with it.
+//
+// Parameters:
+//   - value: the float64 value to be converted.
+//
+// Returns:
+//   - *big.Float: the converted *big.Float value.
+//   - error: ErrNaN if value is NaN.
+//
+// Example:
+//
+//	result, err := Float64ToBigFloat(0.1)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.Text('f', 30)) // Output: 0.100000000000000005551115123126
+func Float64ToBigFloat(value float64) (*big.Float, error) {
+	if math.IsNaN(value) {
+		return nil, ErrNaN
+	}
+	return new(big.Float).SetFloat64(value), nil
+}
+
+// BigFloatToFloat64 converts a *big.Float value to a float64 value.
+//
+// BigFloatToFloat64 converts a *big.Float value to the nearest float64
+// value. Finite values beyond the range of float64 are reported rather than
+// turned into an infinity. The registry converts *big.Float values to
+// float64 with it.
+//
+// Parameters:
+//   - value: the *big.Float value to be converted.
+//
+// Returns:
+//   - float64: the converted float64 value.
+//   - error: ErrNil if value is nil, or an error if value is out of the
+//     float64 range.
+//
+// Example:
+//
+//	result, err := BigFloatToFloat64(big.NewFloat(1.5))
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1.5
+func BigFloatToFloat64(value *big.Float) (float64, error) {
+	if value == nil {
+		return 0, ErrNil
+	}
+	f, _ := value.Float64()
+	if math.IsInf(f, 0) && !value.IsInf() {
+		if f > 0 {
+			return 0, overflowError(value, "float64")
+		}
+		return 0, underflowError(value, "float64")
+	}
+	return f, nil
+}
+
+// BigFloatToString converts a *big.Float value to a decimal string.
+//
+// BigFloatToString formats a *big.Float value in decimal notation without
+// an exponent, with the fewest digits that identify the value at its
+// precision. Integers converted with Int64ToBigFloat or Uint64ToBigFloat
+// keep all of their digits. The registry converts *big.Float values to
+// strings with it.
+//
+// Parameters:
+//   - value: the *big.Float value to be converted.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: ErrNil if value is nil.
+//
+// Example:
+//
+//	f, _ := Uint64ToBigFloat(9007199254740993)
+//	result, err := BigFloatToString(f)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 9007199254740993
+func BigFloatToString(value *big.Float) (string, error) {
+	if value == nil {
+		return "", ErrNil
+	}
+	return value.Text('f', -1), nil
+}
@@ -0,0 +1,73 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestBigFloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (*big.Float, error)
+		want    string
+		wantErr error
+	}{
+		{"Uint64Max", func() (*big.Float, error) { return Uint64ToBigFloat(math.MaxUint64) }, "18446744073709551615", nil},
+		{"Uint64Above2p53", func() (*big.Float, error) { return Uint64ToBigFloat(1<<53 + 1) }, "9007199254740993", nil},
+		{"Int64Min", func() (*big.Float, error) { return Int64ToBigFloat(math.MinInt64) }, "-9223372036854775808", nil},
+		{"Int64Max", func() (*big.Float, error) { return Int64ToBigFloat(math.MaxInt64) }, "9223372036854775807", nil},
+		{"Float64", func() (*big.Float, error) { return Float64ToBigFloat(0.1) }, "0.1", nil},
+		{"Float64Inf", func() (*big.Float, error) { return Float64ToBigFloat(math.Inf(1)) }, "+Inf", nil},
+		{"Float64NaN", func() (*big.Float, error) { return Float64ToBigFloat(math.NaN()) }, "", ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := tt.convert()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got, _ := BigFloatToString(f); got != tt.want {
+				t.Errorf("BigFloatToString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Uint64ToFloat64 rounds 2^53+1, while the *big.Float keeps it.
+	if f, _ := Uint64ToFloat64(1<<53 + 1); f != 1<<53 {
+		t.Errorf("Uint64ToFloat64(2^53+1) = %v, want 2^53", f)
+	}
+	if _, err := Uint64ToFloat64Exact(1<<53 + 1); !errors.Is(err, ErrLossOfPrecision) {
+		t.Errorf("Uint64ToFloat64Exact(2^53+1) error = %v, want ErrLossOfPrecision", err)
+	}
+
+	if got, err := BigFloatToFloat64(big.NewFloat(1.5)); err != nil || got != 1.5 {
+		t.Errorf("BigFloatToFloat64(1.5) = %v, %v, want 1.5", got, err)
+	}
+	huge := new(big.Float).SetMantExp(big.NewFloat(1), 2000)
+	if _, err := BigFloatToFloat64(huge); !errors.Is(err, ErrOverflow) {
+		t.Errorf("BigFloatToFloat64(2^2000) error = %v, want ErrOverflow", err)
+	}
+	if _, err := BigFloatToFloat64(huge.Neg(huge)); !errors.Is(err, ErrUnderflow) {
+		t.Errorf("BigFloatToFloat64(-2^2000) error = %v, want ErrUnderflow", err)
+	}
+	if _, err := BigFloatToString(nil); !errors.Is(err, ErrNil) {
+		t.Errorf("BigFloatToString(nil) error = %v, want ErrNil", err)
+	}
+
+	var dst *big.Float
+	if err := TryIntoValue(reflect.ValueOf(&dst).Elem(), reflect.ValueOf(uint64(math.MaxUint64))); err != nil {
+		t.Fatalf("TryIntoValue(*big.Float, MaxUint64) error = %v", err)
+	}
+	if got := dst.Text('f', -1); got != "18446744073709551615" {
+		t.Errorf("TryIntoValue(*big.Float, MaxUint64) = %s", got)
+	}
+}
@@ -0,0 +1,238 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// BindOptions binds a map of string values to the fields of a struct.
+//
+// BindOptions walks the exported fields of the struct pointed to by dst and
+// converts the value stored under each field's key with the checked string
+// converters. The key is taken from the `into` struct tag and defaults to the
+// field name. Tag options:
+//   - `into:"-"`: the field is skipped.
+//   - `into:"name,required"`: a missing key is reported as an error.
+//
+// Nested struct fields are bound with their key followed by a dot as prefix
+// (e.g. "db.port"), and embedded structs are flattened into the parent.
+// time.Duration fields are parsed with StringToDuration, time.Time fields
+// with StringToTime, and pointer fields are allocated when a value is present.
+// Keys without a matching field are ignored. The options set by SetDefaults
+// are applied to every value.
+//
+// Parameters:
+//   - dst: a non-nil pointer to the struct to be filled.
+//   - kv: the string values keyed by field key.
+//
+// Returns:
+//   - error: a FieldErrors naming the key and field of every conversion that
+//     fails and every required key that is missing.
+//
+// Example:
+//
+//	type Config struct {
+//	  Port    uint16        `into:"port,required"`
+//	  Timeout time.Duration `into:"timeout"`
+//	}
+//	var cfg Config
+//	err := BindOptions(&cfg, map[string]string{"port": "8080", "timeout": "5s"})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(cfg.Port, cfg.Timeout) // Output: 8080 5s
+func BindOptions(dst any, kv map[string]string) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("destination must be a non-nil pointer to a struct")
+	}
+	errs := FieldErrors{}
+	bindStruct(rv.Elem(), kv, "", Defaults(), errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// FieldErrors lists the failures of a struct binding, keyed by the path of
+// each field's key, such as "db.port".
+//
+// BindOptions returns a FieldErrors holding every failure, so that a
+// validation response can report all invalid fields at once:
+//
+//	var fieldErrs FieldErrors
+//	if errors.As(err, &fieldErrs) {
+//	  for path, err := range fieldErrs {
+//	    fmt.Println(path, err)
+//	  }
+//	}
+type FieldErrors map[string]error
+
+// Error returns the messages of the failures, sorted by path and separated
+// by semicolons.
+func (e FieldErrors) Error() string {
+	paths := make([]string, 0, len(e))
+	for path := range e {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	msgs := make([]string, len(paths))
+	for i, path := range paths {
+		msgs[i] = e[path].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is reports whether any failure matches target, so that errors.Is can be
+// used on the whole binding error.
+func (e FieldErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// bindStruct binds kv to the fields of the struct value v.
+//
+// bindStruct binds kv to the fields of the struct value v, looking up
+// each field's key with the given prefix.
+//
+// Parameters:
+//   - v: the addressable struct value to be filled.
+//   - kv: the string values keyed by field key.
+//   - prefix: the prefix prepended to every key of v.
+//   - opts: the conversion policies.
+//   - errs: the failures, added under the key of the field that failed when
+//     a conversion fails or a required key is missing.
+func bindStruct(v reflect.Value, kv map[string]string, prefix string, opts Options, errs FieldErrors) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !(field.Anonymous && isNestedStruct(field.Type)) {
+			continue
+		}
+
+		name, required := parseBindTag(field)
+		if name == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+		if isNestedStruct(field.Type) {
+			nested := prefix + name + "."
+			if field.Anonymous && field.Tag.Get("into") == "" {
+				nested = prefix
+			}
+			bindStruct(fv, kv, nested, opts, errs)
+			continue
+		}
+
+		key := prefix + name
+		s, ok := kv[key]
+		if !ok {
+			if required {
+				errs[key] = fmt.Errorf("missing required key %q for field %s", key, field.Name)
+			}
+			continue
+		}
+		if err := setString(fv, s, opts); err != nil {
+			errs[key] = fmt.Errorf("cannot bind key %q to field %s: %w", key, field.Name, err)
+		}
+	}
+}
+
+// parseBindTag returns the key and the required flag of a struct field.
+func parseBindTag(field reflect.StructField) (name string, required bool) {
+	tag := field.Tag.Get("into")
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "required" {
+			required = true
+		}
+	}
+	return name, required
+}
+
+// isNestedStruct reports whether t is a struct that is bound field by field.
+func isNestedStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+// setString converts s to the type of v and stores the result in v.
+//
+// setString converts s to the type of v with the checked string converters
+// and stores the result in v. Pointers are allocated as needed, and named
+// types are supported through their underlying kind. With EmptyUnset, an
+// empty s leaves v unchanged unless v holds a string, and an empty s sets a
+// BoolOpt to BoolUnset.
+//
+// Parameters:
+//   - v: the settable destination value.
+//   - s: the string value to be converted.
+//   - opts: the conversion policies.
+//
+// Returns:
+//   - error: an error if the conversion fails or the type is unsupported.
+func setString(v reflect.Value, s string, opts Options) error {
+	if opts.TrimSpace {
+		s = strings.TrimSpace(s)
+	}
+	if s == "" && opts.Empty == EmptyUnset && indirectType(v.Type()).Kind() != reflect.String {
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		elem := reflect.New(v.Type().Elem())
+		if err := setString(elem.Elem(), s, opts); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	var (
+		r   any
+		err error
+	)
+	switch {
+	case (v.Type() == durationType || v.Type() == timeType) && s == "" && opts.Empty == EmptyZero:
+		r = reflect.Zero(v.Type()).Interface()
+	case v.Type() == boolOptType:
+		r, err = stringToBoolOpt(s, opts)
+	case v.Type() == durationType:
+		r, err = StringToDuration(s)
+	case v.Type() == timeType:
+		r, err = StringToTime(s)
+	default:
+		r, err = toKindWith(v.Kind(), s, opts)
+	}
+	if err != nil {
+		return err
+	}
+
+	v.Set(reflect.ValueOf(r).Convert(v.Type()))
+	return nil
+}
+
+// indirectType returns the type t points to, following any number of pointers.
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
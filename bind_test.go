@@ -0,0 +1,119 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+type bindDatabase struct {
+	Host string `into:"host"`
+	Port uint16 `into:"port"`
+}
+
+type bindEmbedded struct {
+	Debug bool `into:"debug"`
+}
+
+type bindConfig struct {
+	bindEmbedded
+	Name     string        `into:"name,required"`
+	Workers  int8          `into:"workers"`
+	Ratio    float64       `into:"ratio"`
+	Timeout  time.Duration `into:"timeout"`
+	Started  time.Time     `into:"started"`
+	Limit    *int          `into:"limit"`
+	Database bindDatabase  `into:"db"`
+	Ignored  string        `into:"-"`
+}
+
+func TestBindOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   map[string]string
+		wantErr bool
+	}{
+		{"all", map[string]string{
+			"name": "svc", "workers": "4", "ratio": "0.5", "timeout": "1m30s",
+			"started": "2024-05-01", "limit": "10", "db.host": "localhost",
+			"db.port": "5432", "debug": "true", "Ignored": "x",
+		}, false},
+		{"missingRequired", map[string]string{"workers": "4"}, true},
+		{"overflow", map[string]string{"name": "svc", "workers": "300"}, true},
+		{"invalidDuration", map[string]string{"name": "svc", "timeout": "soon"}, true},
+		{"invalidTime", map[string]string{"name": "svc", "started": "yesterday"}, true},
+		{"nestedOverflow", map[string]string{"name": "svc", "db.port": "70000"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg bindConfig
+			err := BindOptions(&cfg, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BindOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	var cfg bindConfig
+	err := BindOptions(&cfg, tests[0].input)
+	if err != nil {
+		t.Fatalf("BindOptions() error = %v", err)
+	}
+	if cfg.Name != "svc" || cfg.Workers != 4 || cfg.Ratio != 0.5 || !cfg.Debug {
+		t.Errorf("BindOptions() scalars = %+v", cfg)
+	}
+	if cfg.Timeout != 90*time.Second {
+		t.Errorf("BindOptions() Timeout = %v, want %v", cfg.Timeout, 90*time.Second)
+	}
+	if !cfg.Started.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("BindOptions() Started = %v", cfg.Started)
+	}
+	if cfg.Limit == nil || *cfg.Limit != 10 {
+		t.Errorf("BindOptions() Limit = %v, want 10", cfg.Limit)
+	}
+	if cfg.Database.Host != "localhost" || cfg.Database.Port != 5432 {
+		t.Errorf("BindOptions() Database = %+v", cfg.Database)
+	}
+	if cfg.Ignored != "" {
+		t.Errorf("BindOptions() Ignored = %q, want empty", cfg.Ignored)
+	}
+
+	if err := BindOptions(cfg, nil); err == nil {
+		t.Errorf("BindOptions() with non-pointer destination should fail")
+	}
+}
+
+func TestBindOptionsFieldErrors(t *testing.T) {
+	var cfg bindConfig
+	err := BindOptions(&cfg, map[string]string{"workers": "300", "db.port": "70000", "db.host": "localhost"})
+
+	var fieldErrs FieldErrors
+	if !errors.As(err, &fieldErrs) {
+		t.Fatalf("BindOptions() error = %v, want FieldErrors", err)
+	}
+	if len(fieldErrs) != 3 {
+		t.Errorf("BindOptions() FieldErrors = %v, want 3 entries", fieldErrs)
+	}
+	for _, path := range []string{"name", "workers", "db.port"} {
+		if fieldErrs[path] == nil {
+			t.Errorf("BindOptions() FieldErrors[%q] = nil", path)
+		}
+	}
+	if !errors.Is(fieldErrs["workers"], ErrOverflow) || !errors.Is(err, ErrOverflow) {
+		t.Errorf("BindOptions() error = %v, want ErrOverflow", err)
+	}
+	if errors.Is(err, ErrNaN) {
+		t.Errorf("errors.Is(%v, ErrNaN) = true", err)
+	}
+	if cfg.Database.Host != "localhost" {
+		t.Errorf("BindOptions() Database.Host = %q, want localhost", cfg.Database.Host)
+	}
+
+	want := fieldErrs["db.port"].Error() + "; " + fieldErrs["name"].Error() + "; " + fieldErrs["workers"].Error()
+	if err.Error() != want {
+		t.Errorf("BindOptions() error = %q, want %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,270 @@
+package into
+
+import "math"
+
+// The Bits converters reinterpret the two's complement bits of an integer
+// as the integer of the same width and opposite signedness. Unlike the
+// checked converters, such as Int64ToUint64, they never fail: negative
+// values wrap around to large unsigned values and back, as needed by hash
+// functions and bit manipulation code. The float Bits converters likewise
+// expose the IEEE 754 encoding of floats, for serialization and NaN-boxing.
+
+// IntToUintBits reinterprets the bits of an int value as a uint value.
+//
+// IntToUintBits returns the uint with the same two's complement bits as
+// value, so negative values wrap around to values above the maximum of
+// int. Use IntToUint to reject negative values instead.
+//
+// Parameters:
+//   - value: the int value to be reinterpreted.
+//
+// Returns:
+//   - uint: the uint value with the same bits.
+func IntToUintBits(value int) uint {
+	return uint(value)
+}
+
+// UintToIntBits reinterprets the bits of a uint value as an int value.
+//
+// UintToIntBits returns the int with the same two's complement bits as
+// value, so values above the maximum of int wrap around to negative values.
+// Use UintToInt to reject them instead.
+//
+// Parameters:
+//   - value: the uint value to be reinterpreted.
+//
+// Returns:
+//   - int: the int value with the same bits.
+func UintToIntBits(value uint) int {
+	return int(value)
+}
+
+// Int8ToUint8Bits reinterprets the bits of an int8 value as a uint8 value.
+//
+// Int8ToUint8Bits returns the uint8 with the same two's complement bits as
+// value, so negative values wrap around to values above the maximum of
+// int8. Use Int8ToUint8 to reject negative values instead.
+//
+// Parameters:
+//   - value: the int8 value to be reinterpreted.
+//
+// Returns:
+//   - uint8: the uint8 value with the same bits.
+//
+// Example:
+//
+//	fmt.Println(Int8ToUint8Bits(-1)) // Output: 255
+func Int8ToUint8Bits(value int8) uint8 {
+	return uint8(value)
+}
+
+// Uint8ToInt8Bits reinterprets the bits of a uint8 value as an int8 value.
+//
+// Uint8ToInt8Bits returns the int8 with the same two's complement bits as
+// value, so values above the maximum of int8 wrap around to negative values.
+// Use Uint8ToInt8 to reject them instead.
+//
+// Parameters:
+//   - value: the uint8 value to be reinterpreted.
+//
+// Returns:
+//   - int8: the int8 value with the same bits.
+//
+// Example:
+//
+//	fmt.Println(Uint8ToInt8Bits(255)) // Output: -1
+func Uint8ToInt8Bits(value uint8) int8 {
+	return int8(value)
+}
+
+// Int16ToUint16Bits reinterprets the bits of an int16 value as a uint16 value.
+//
+// Int16ToUint16Bits returns the uint16 with the same two's complement bits as
+// value, so negative values wrap around to values above the maximum of
+// int16. Use Int16ToUint16 to reject negative values instead.
+//
+// Parameters:
+//   - value: the int16 value to be reinterpreted.
+//
+// Returns:
+//   - uint16: the uint16 value with the same bits.
+//
+// Example:
+//
+//	fmt.Println(Int16ToUint16Bits(-1)) // Output: 65535
+func Int16ToUint16Bits(value int16) uint16 {
+	return uint16(value)
+}
+
+// Uint16ToInt16Bits reinterprets the bits of a uint16 value as an int16 value.
+//
+// Uint16ToInt16Bits returns the int16 with the same two's complement bits as
+// value, so values above the maximum of int16 wrap around to negative values.
+// Use Uint16ToInt16 to reject them instead.
+//
+// Parameters:
+//   - value: the uint16 value to be reinterpreted.
+//
+// Returns:
+//   - int16: the int16 value with the same bits.
+//
+// Example:
+//
+//	fmt.Println(Uint16ToInt16Bits(65535)) // Output: -1
+func Uint16ToInt16Bits(value uint16) int16 {
+	return int16(value)
+}
+
+// Int32ToUint32Bits reinterprets the bits of an int32 value as a uint32 value.
+//
+// Int32ToUint32Bits returns the uint32 with the same two's complement bits as
+// value, so negative values wrap around to values above the maximum of
+// int32. Use Int32ToUint32 to reject negative values instead.
+//
+// Parameters:
+//   - value: the int32 value to be reinterpreted.
+//
+// Returns:
+//   - uint32: the uint32 value with the same bits.
+//
+// Example:
+//
+//	fmt.Println(Int32ToUint32Bits(-1)) // Output: 4294967295
+func Int32ToUint32Bits(value int32) uint32 {
+	return uint32(value)
+}
+
+// Uint32ToInt32Bits reinterprets the bits of a uint32 value as an int32 value.
+//
+// Uint32ToInt32Bits returns the int32 with the same two's complement bits as
+// value, so values above the maximum of int32 wrap around to negative values.
+// Use Uint32ToInt32 to reject them instead.
+//
+// Parameters:
+//   - value: the uint32 value to be reinterpreted.
+//
+// Returns:
+//   - int32: the int32 value with the same bits.
+//
+// Example:
+//
+//	fmt.Println(Uint32ToInt32Bits(4294967295)) // Output: -1
+func Uint32ToInt32Bits(value uint32) int32 {
+	return int32(value)
+}
+
+// Int64ToUint64Bits reinterprets the bits of an int64 value as a uint64 value.
+//
+// Int64ToUint64Bits returns the uint64 with the same two's complement bits as
+// value, so negative values wrap around to values above the maximum of
+// int64. Use Int64ToUint64 to reject negative values instead.
+//
+// Parameters:
+//   - value: the int64 value to be reinterpreted.
+//
+// Returns:
+//   - uint64: the uint64 value with the same bits.
+//
+// Example:
+//
+//	fmt.Println(Int64ToUint64Bits(-1)) // Output: 18446744073709551615
+func Int64ToUint64Bits(value int64) uint64 {
+	return uint64(value)
+}
+
+// Uint64ToInt64Bits reinterprets the bits of a uint64 value as an int64 value.
+//
+// Uint64ToInt64Bits returns the int64 with the same two's complement bits as
+// value, so values above the maximum of int64 wrap around to negative values.
+// Use Uint64ToInt64 to reject them instead.
+//
+// Parameters:
+//   - value: the uint64 value to be reinterpreted.
+//
+// Returns:
+//   - int64: the int64 value with the same bits.
+//
+// Example:
+//
+//	fmt.Println(Uint64ToInt64Bits(18446744073709551615)) // Output: -1
+func Uint64ToInt64Bits(value uint64) int64 {
+	return int64(value)
+}
+
+// Float64ToUint64Bits returns the IEEE 754 bits of a float64 value.
+//
+// Float64ToUint64Bits returns math.Float64bits(value): the sign bit, the 11
+// exponent bits and the 52 fraction bits, with NaN payloads preserved. With
+// the FloatBits option, TryIntoWith converts float64 values to uint64 with
+// it.
+//
+// Parameters:
+//   - value: the float64 value.
+//
+// Returns:
+//   - uint64: the bits of the value.
+//
+// Example:
+//
+//	fmt.Printf("%#x\n", Float64ToUint64Bits(1)) // Output: 0x3ff0000000000000
+func Float64ToUint64Bits(value float64) uint64 {
+	return math.Float64bits(value)
+}
+
+// Uint64BitsToFloat64 returns the float64 value with the given IEEE 754 bits.
+//
+// Uint64BitsToFloat64 returns math.Float64frombits(value), the inverse of
+// Float64ToUint64Bits. With the FloatBits option, TryIntoWith converts
+// uint64 values to float64 with it.
+//
+// Parameters:
+//   - value: the bits of the value.
+//
+// Returns:
+//   - float64: the float64 value.
+//
+// Example:
+//
+//	fmt.Println(Uint64BitsToFloat64(0x3ff0000000000000)) // Output: 1
+func Uint64BitsToFloat64(value uint64) float64 {
+	return math.Float64frombits(value)
+}
+
+// Float32ToUint32Bits returns the IEEE 754 bits of a float32 value.
+//
+// Float32ToUint32Bits returns math.Float32bits(value): the sign bit, the 8
+// exponent bits and the 23 fraction bits, with NaN payloads preserved. With
+// the FloatBits option, TryIntoWith converts float32 values to uint32 with
+// it.
+//
+// Parameters:
+//   - value: the float32 value.
+//
+// Returns:
+//   - uint32: the bits of the value.
+//
+// Example:
+//
+//	fmt.Printf("%#x\n", Float32ToUint32Bits(1)) // Output: 0x3f800000
+func Float32ToUint32Bits(value float32) uint32 {
+	return math.Float32bits(value)
+}
+
+// Uint32BitsToFloat32 returns the float32 value with the given IEEE 754 bits.
+//
+// Uint32BitsToFloat32 returns math.Float32frombits(value), the inverse of
+// Float32ToUint32Bits. With the FloatBits option, TryIntoWith converts
+// uint32 values to float32 with it.
+//
+// Parameters:
+//   - value: the bits of the value.
+//
+// Returns:
+//   - float32: the float32 value.
+//
+// Example:
+//
+//	fmt.Println(Uint32BitsToFloat32(0x3f800000)) // Output: 1
+func Uint32BitsToFloat32(value uint32) float32 {
+	return math.Float32frombits(value)
+}
@@ -0,0 +1,95 @@
+package into_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestIntegerBits(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Int minus one", IntToUintBits(-1), uint(math.MaxUint)},
+		{"Uint max", UintToIntBits(math.MaxUint), -1},
+		{"Int8 min", Int8ToUint8Bits(math.MinInt8), uint8(0x80)},
+		{"Uint8 max", Uint8ToInt8Bits(math.MaxUint8), int8(-1)},
+		{"Int16 minus two", Int16ToUint16Bits(-2), uint16(0xfffe)},
+		{"Uint16 high bit", Uint16ToInt16Bits(0x8000), int16(math.MinInt16)},
+		{"Int32 positive", Int32ToUint32Bits(42), uint32(42)},
+		{"Uint32 max", Uint32ToInt32Bits(math.MaxUint32), int32(-1)},
+		{"Int64 min", Int64ToUint64Bits(math.MinInt64), uint64(1 << 63)},
+		{"Uint64 max", Uint64ToInt64Bits(math.MaxUint64), int64(-1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntegerBitsRoundTrip(t *testing.T) {
+	for _, v := range []int64{math.MinInt64, -1, 0, 1, math.MaxInt64} {
+		if got := Uint64ToInt64Bits(Int64ToUint64Bits(v)); got != v {
+			t.Errorf("round trip of %d = %d", v, got)
+		}
+	}
+	for _, v := range []int8{math.MinInt8, -1, 0, math.MaxInt8} {
+		if got := Uint8ToInt8Bits(Int8ToUint8Bits(v)); got != v {
+			t.Errorf("round trip of %d = %d", v, got)
+		}
+	}
+}
+
+func TestFloatBits(t *testing.T) {
+	if got := Float64ToUint64Bits(-2); got != 0xc000000000000000 {
+		t.Errorf("Float64ToUint64Bits(-2) = %#x", got)
+	}
+	if got := Uint64BitsToFloat64(0x7ff0000000000000); !math.IsInf(got, 1) {
+		t.Errorf("Uint64BitsToFloat64(+Inf bits) = %v", got)
+	}
+	if got := Float32ToUint32Bits(0.5); got != 0x3f000000 {
+		t.Errorf("Float32ToUint32Bits(0.5) = %#x", got)
+	}
+	if got := Uint32BitsToFloat32(0x80000000); got != 0 || !math.Signbit(float64(got)) {
+		t.Errorf("Uint32BitsToFloat32(-0 bits) = %v", got)
+	}
+
+	// A NaN payload survives the round trip, as NaN-boxing relies on.
+	const boxed = 0x7ff8_0000_dead_beef
+	if got := Float64ToUint64Bits(Uint64BitsToFloat64(boxed)); got != boxed {
+		t.Errorf("NaN payload round trip = %#x, want %#x", got, uint64(boxed))
+	}
+}
+
+func TestTryIntoWithFloatBits(t *testing.T) {
+	opts := Options{FloatBits: true}
+	if got, err := TryIntoWith[uint64](1.0, opts); err != nil || got != 0x3ff0000000000000 {
+		t.Errorf("TryIntoWith[uint64](1.0) = %#x, %v", got, err)
+	}
+	if got, err := TryIntoWith[float64](uint64(0x4000000000000000), opts); err != nil || got != 2 {
+		t.Errorf("TryIntoWith[float64](bits of 2) = %v, %v", got, err)
+	}
+	if got, err := TryIntoWith[uint32](float32(1), opts); err != nil || got != 0x3f800000 {
+		t.Errorf("TryIntoWith[uint32](float32(1)) = %#x, %v", got, err)
+	}
+	if got, err := TryIntoWith[float32](uint32(0x3f800000), opts); err != nil || got != 1 {
+		t.Errorf("TryIntoWith[float32](bits of 1) = %v, %v", got, err)
+	}
+	if got, err := TryIntoWith[uint64](math.NaN(), opts); err != nil || got != math.Float64bits(math.NaN()) {
+		t.Errorf("TryIntoWith[uint64](NaN) = %#x, %v", got, err)
+	}
+
+	// Conversions between other widths keep their numeric meaning.
+	if got, err := TryIntoWith[uint32](2.0, opts); err != nil || got != 2 {
+		t.Errorf("TryIntoWith[uint32](2.0) = %v, %v", got, err)
+	}
+	if got, err := TryIntoWith[uint64](1.0, Options{}); err != nil || got != 1 {
+		t.Errorf("TryIntoWith[uint64](1.0) without FloatBits = %v, %v", got, err)
+	}
+}
@@ -2,8 +2,11 @@ package into
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 // TryIntoBool attempts to convert a value of any type to a boolean value.
@@ -20,8 +23,12 @@ import (
 //   - bool: the converted boolean value.
 //   - error: an error if the conversion fails.
 func TryIntoBool[T convertable](value T) (bool, error) {
-	result, err := toBool(value)
-	return result.(bool), err
+	result, err := toKindWith(reflect.Bool, value, Defaults())
+	observe[bool](value, err)
+	if err != nil {
+		return false, err
+	}
+	return result.(bool), nil
 }
 
 // toBool converts a value of any type to a boolean value.
@@ -41,35 +48,35 @@ func toBool(value any) (any, error) {
 	valueType := reflect.TypeOf(value)
 	switch valueType.Kind() {
 	case reflect.Float64:
-		return Float64ToBool(value.(float64))
+		return Float64IntoBool(value.(float64)), nil
 	case reflect.Float32:
-		return Float32ToBool(value.(float32))
+		return Float32IntoBool(value.(float32)), nil
 	case reflect.Int:
-		return IntToBool(value.(int))
+		return IntIntoBool(value.(int)), nil
 	case reflect.Int8:
-		return Int8ToBool(value.(int8))
+		return Int8IntoBool(value.(int8)), nil
 	case reflect.Int16:
-		return Int16ToBool(value.(int16))
+		return Int16IntoBool(value.(int16)), nil
 	case reflect.Int32:
-		return Int32ToBool(value.(int32))
+		return Int32IntoBool(value.(int32)), nil
 	case reflect.Int64:
-		return Int64ToBool(value.(int64))
+		return Int64IntoBool(value.(int64)), nil
 	case reflect.Uint:
-		return UintToBool(value.(uint))
+		return UintIntoBool(value.(uint)), nil
 	case reflect.Uint8:
-		return Uint8ToBool(value.(uint8))
+		return Uint8IntoBool(value.(uint8)), nil
 	case reflect.Uint16:
-		return Uint16ToBool(value.(uint16))
+		return Uint16IntoBool(value.(uint16)), nil
 	case reflect.Uint32:
-		return Uint32ToBool(value.(uint32))
+		return Uint32IntoBool(value.(uint32)), nil
 	case reflect.Uint64:
-		return Uint64ToBool(value.(uint64))
+		return Uint64IntoBool(value.(uint64)), nil
 	case reflect.String:
 		return StringToBool(value.(string))
 	case reflect.Bool:
 		return value.(bool), nil
 	default:
-		return false, errors.New("unsupported type")
+		return false, unsupportedError(value, "bool")
 	}
 }
 
@@ -88,6 +95,20 @@ func BoolToBool(value bool) (bool, error) {
 	return value, nil
 }
 
+// Float32IntoBool converts a float32 value to a boolean value.
+//
+// Float32IntoBool converts a float32 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the float32 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Float32IntoBool(value float32) bool {
+	return value != 0
+}
+
 // Float32ToBool converts a float32 value to a boolean value.
 //
 // Float32ToBool converts a float32 value to a boolean value.
@@ -99,8 +120,24 @@ func BoolToBool(value bool) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Float32IntoBool, which has no error to handle.
 func Float32ToBool(value float32) (bool, error) {
-	return value != 0, nil
+	return Float32IntoBool(value), nil
+}
+
+// Float64IntoBool converts a float64 value to a boolean value.
+//
+// Float64IntoBool converts a float64 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the float64 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Float64IntoBool(value float64) bool {
+	return value != 0
 }
 
 // Float64ToBool converts a float64 value to a boolean value.
@@ -114,8 +151,24 @@ func Float32ToBool(value float32) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Float64IntoBool, which has no error to handle.
 func Float64ToBool(value float64) (bool, error) {
-	return value != 0, nil
+	return Float64IntoBool(value), nil
+}
+
+// IntIntoBool converts an int value to a boolean value.
+//
+// IntIntoBool converts an int value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the int value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func IntIntoBool(value int) bool {
+	return value != 0
 }
 
 // IntToBool converts an int value to a boolean value.
@@ -129,8 +182,24 @@ func Float64ToBool(value float64) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use IntIntoBool, which has no error to handle.
 func IntToBool(value int) (bool, error) {
-	return value != 0, nil
+	return IntIntoBool(value), nil
+}
+
+// Int8IntoBool converts an int8 value to a boolean value.
+//
+// Int8IntoBool converts an int8 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the int8 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Int8IntoBool(value int8) bool {
+	return value != 0
 }
 
 // Int8ToBool converts an int8 value to a boolean value.
@@ -144,8 +213,24 @@ func IntToBool(value int) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Int8IntoBool, which has no error to handle.
 func Int8ToBool(value int8) (bool, error) {
-	return value != 0, nil
+	return Int8IntoBool(value), nil
+}
+
+// Int16IntoBool converts an int16 value to a boolean value.
+//
+// Int16IntoBool converts an int16 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the int16 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Int16IntoBool(value int16) bool {
+	return value != 0
 }
 
 // Int16ToBool converts an int16 value to a boolean value.
@@ -159,8 +244,24 @@ func Int8ToBool(value int8) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Int16IntoBool, which has no error to handle.
 func Int16ToBool(value int16) (bool, error) {
-	return value != 0, nil
+	return Int16IntoBool(value), nil
+}
+
+// Int32IntoBool converts an int32 value to a boolean value.
+//
+// Int32IntoBool converts an int32 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the int32 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Int32IntoBool(value int32) bool {
+	return value != 0
 }
 
 // Int32ToBool converts an int32 value to a boolean value.
@@ -174,8 +275,24 @@ func Int16ToBool(value int16) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Int32IntoBool, which has no error to handle.
 func Int32ToBool(value int32) (bool, error) {
-	return value != 0, nil
+	return Int32IntoBool(value), nil
+}
+
+// Int64IntoBool converts an int64 value to a boolean value.
+//
+// Int64IntoBool converts an int64 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the int64 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Int64IntoBool(value int64) bool {
+	return value != 0
 }
 
 // Int64ToBool converts an int64 value to a boolean value.
@@ -189,8 +306,10 @@ func Int32ToBool(value int32) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Int64IntoBool, which has no error to handle.
 func Int64ToBool(value int64) (bool, error) {
-	return value != 0, nil
+	return Int64IntoBool(value), nil
 }
 
 // StringToBool converts a string value to a boolean value.
@@ -203,9 +322,87 @@ func Int64ToBool(value int64) (bool, error) {
 //
 // Returns:
 //   - bool: the converted boolean value.
-//   - error: an error if the conversion fails.
+//   - error: a ConversionError wrapping strconv.ErrSyntax if the conversion
+//     fails.
 func StringToBool(value string) (bool, error) {
-	return strconv.ParseBool(value)
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, parseError(err, value, "bool")
+	}
+	return b, nil
+}
+
+// StringToBoolNumeric converts a string value to a boolean value, accepting numbers.
+//
+// StringToBoolNumeric converts a string value to a boolean value like
+// StringToBool, and also accepts numeric strings, which are converted like
+// numbers: any nonzero number such as "2" or "-0.5" is true, and zero such as
+// "0.0" is false. This matches the conversion of numeric sources to bool.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+//   - error: ErrNaN if the value is "NaN", or an error if the value is neither
+//     a boolean nor a number.
+//
+// Example:
+//
+//	result, err := StringToBoolNumeric("2")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: true
+func StringToBoolNumeric(value string) (bool, error) {
+	if b, err := strconv.ParseBool(value); err == nil {
+		return b, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return false, fmt.Errorf("invalid boolean %q", value)
+	}
+	if math.IsNaN(f) {
+		return false, ErrNaN
+	}
+	return f != 0, nil
+}
+
+// StringToBoolTrimmed converts a string value to bool after trimming white space.
+//
+// StringToBoolTrimmed converts a string value to bool like StringToBool, after
+// removing leading and trailing white space from the input.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - bool: the converted bool value.
+//   - error: an error if the trimmed value cannot be converted.
+//
+// Example:
+//
+//	result, err := StringToBoolTrimmed(" true\n")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: true
+func StringToBoolTrimmed(value string) (bool, error) {
+	return StringToBool(strings.TrimSpace(value))
+}
+
+// UintIntoBool converts a uint value to a boolean value.
+//
+// UintIntoBool converts a uint value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the uint value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func UintIntoBool(value uint) bool {
+	return value != 0
 }
 
 // UintToBool converts a uint value to a boolean value.
@@ -219,8 +416,24 @@ func StringToBool(value string) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use UintIntoBool, which has no error to handle.
 func UintToBool(value uint) (bool, error) {
-	return value != 0, nil
+	return UintIntoBool(value), nil
+}
+
+// Uint8IntoBool converts a uint8 value to a boolean value.
+//
+// Uint8IntoBool converts a uint8 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the uint8 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Uint8IntoBool(value uint8) bool {
+	return value != 0
 }
 
 // Uint8ToBool converts a uint8 value to a boolean value.
@@ -234,8 +447,24 @@ func UintToBool(value uint) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Uint8IntoBool, which has no error to handle.
 func Uint8ToBool(value uint8) (bool, error) {
-	return value != 0, nil
+	return Uint8IntoBool(value), nil
+}
+
+// Uint16IntoBool converts a uint16 value to a boolean value.
+//
+// Uint16IntoBool converts a uint16 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the uint16 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Uint16IntoBool(value uint16) bool {
+	return value != 0
 }
 
 // Uint16ToBool converts a uint16 value to a boolean value.
@@ -249,8 +478,24 @@ func Uint8ToBool(value uint8) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Uint16IntoBool, which has no error to handle.
 func Uint16ToBool(value uint16) (bool, error) {
-	return value != 0, nil
+	return Uint16IntoBool(value), nil
+}
+
+// Uint32IntoBool converts a uint32 value to a boolean value.
+//
+// Uint32IntoBool converts a uint32 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the uint32 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Uint32IntoBool(value uint32) bool {
+	return value != 0
 }
 
 // Uint32ToBool converts a uint32 value to a boolean value.
@@ -264,8 +509,24 @@ func Uint16ToBool(value uint16) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Uint32IntoBool, which has no error to handle.
 func Uint32ToBool(value uint32) (bool, error) {
-	return value != 0, nil
+	return Uint32IntoBool(value), nil
+}
+
+// Uint64IntoBool converts a uint64 value to a boolean value.
+//
+// Uint64IntoBool converts a uint64 value to a boolean value.
+// It returns true if the input value is not equal to 0, and false otherwise.
+//
+// Parameters:
+//   - value: the uint64 value to be converted.
+//
+// Returns:
+//   - bool: the converted boolean value.
+func Uint64IntoBool(value uint64) bool {
+	return value != 0
 }
 
 // Uint64ToBool converts a uint64 value to a boolean value.
@@ -279,6 +540,8 @@ func Uint32ToBool(value uint32) (bool, error) {
 // Returns:
 //   - bool: the converted boolean value.
 //   - error: nil.
+//
+// Deprecated: Use Uint64IntoBool, which has no error to handle.
 func Uint64ToBool(value uint64) (bool, error) {
-	return value != 0, nil
+	return Uint64IntoBool(value), nil
 }
\ No newline at end of file
@@ -0,0 +1,156 @@
+package into
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// BoolOpt is a bool that may be unset.
+//
+// BoolOpt tells an absent value apart from false, as PATCH-style APIs need
+// to leave a field unchanged when it is not sent. The zero BoolOpt is
+// BoolUnset. TryIntoBoolOpt and LookupBoolOpt convert values to a BoolOpt,
+// BindOptions, DecodeValues and Record bind BoolOpt fields, and BoolOpt
+// implements json.Marshaler and json.Unmarshaler with null for BoolUnset.
+type BoolOpt int8
+
+const (
+	// BoolUnset is a BoolOpt without a value.
+	BoolUnset BoolOpt = iota
+	// BoolFalse is a BoolOpt holding false.
+	BoolFalse
+	// BoolTrue is a BoolOpt holding true.
+	BoolTrue
+)
+
+// boolOptType is the reflect.Type of BoolOpt.
+var boolOptType = reflect.TypeOf(BoolUnset)
+
+// BoolOptOf returns the BoolOpt holding value.
+func BoolOptOf(value bool) BoolOpt {
+	if value {
+		return BoolTrue
+	}
+	return BoolFalse
+}
+
+// Get returns the bool held by b and whether b is set. If b is BoolUnset, it
+// returns false, false.
+func (b BoolOpt) Get() (bool, bool) {
+	return b == BoolTrue, b != BoolUnset
+}
+
+// IsSet reports whether b holds a value.
+func (b BoolOpt) IsSet() bool {
+	return b != BoolUnset
+}
+
+// String returns "true", "false" or "unset".
+func (b BoolOpt) String() string {
+	switch b {
+	case BoolTrue:
+		return "true"
+	case BoolFalse:
+		return "false"
+	}
+	return "unset"
+}
+
+// MarshalJSON encodes b as true, false, or null if b is BoolUnset.
+func (b BoolOpt) MarshalJSON() ([]byte, error) {
+	if v, ok := b.Get(); ok {
+		return json.Marshal(v)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets b from a JSON value with TryIntoBoolOpt, so that null
+// and "" leave b unset while "true" or 1 set it like TryIntoAny. A key
+// missing from the JSON object does not call UnmarshalJSON, so b keeps its
+// previous value.
+func (b *BoolOpt) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	r, err := TryIntoBoolOpt(v)
+	if err != nil {
+		return err
+	}
+	*b = r
+	return nil
+}
+
+// TryIntoBoolOpt attempts to convert a value of any type to a BoolOpt.
+//
+// TryIntoBoolOpt returns BoolUnset for nil, a nil pointer, and an empty
+// string, after trimming white space if the TrimSpace option is set,
+// whatever the Empty option. Any other value is converted to a bool like
+// TryIntoAny. The options set by SetDefaults are applied.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - BoolOpt: BoolTrue, BoolFalse, or BoolUnset.
+//   - error: an error if the conversion fails.
+//
+// Example:
+//
+//	a, _ := TryIntoBoolOpt("false")
+//	b, _ := TryIntoBoolOpt("")
+//	fmt.Println(a, b) // Output: false unset
+func TryIntoBoolOpt(value any) (BoolOpt, error) {
+	if b, ok := value.(BoolOpt); ok {
+		return b, nil
+	}
+	opts := Defaults()
+	opts.Empty = EmptyUnset
+	if isNull(value, opts) {
+		return BoolUnset, nil
+	}
+	result, err := TryIntoAny[bool](value)
+	if err != nil {
+		return BoolUnset, err
+	}
+	return BoolOptOf(result), nil
+}
+
+// LookupBoolOpt converts the value stored under a key of a map to a BoolOpt.
+//
+// LookupBoolOpt returns BoolUnset if key is missing from m, and converts the
+// value stored under key with TryIntoBoolOpt otherwise.
+//
+// Parameters:
+//   - m: the map, such as a decoded JSON object or form values.
+//   - key: the key to look up.
+//
+// Returns:
+//   - BoolOpt: BoolTrue, BoolFalse, or BoolUnset.
+//   - error: an error if the conversion fails.
+//
+// Example:
+//
+//	patch := map[string]any{"active": false}
+//	a, _ := LookupBoolOpt(patch, "active")
+//	b, _ := LookupBoolOpt(patch, "admin")
+//	fmt.Println(a, b) // Output: false unset
+func LookupBoolOpt[V any](m map[string]V, key string) (BoolOpt, error) {
+	v, ok := m[key]
+	if !ok {
+		return BoolUnset, nil
+	}
+	return TryIntoBoolOpt(v)
+}
+
+// stringToBoolOpt converts s to a BoolOpt for setString.
+func stringToBoolOpt(s string, opts Options) (BoolOpt, error) {
+	if s == "" {
+		return BoolUnset, nil
+	}
+	result, err := toKindWith(reflect.Bool, s, opts)
+	if err != nil {
+		return BoolUnset, err
+	}
+	return BoolOptOf(result.(bool)), nil
+}
@@ -0,0 +1,137 @@
+package into_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestTryIntoBoolOpt(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	b := true
+	var nilPtr *bool
+	tests := []struct {
+		name    string
+		input   any
+		opts    Options
+		want    BoolOpt
+		wantErr bool
+	}{
+		{"true", true, Options{}, BoolTrue, false},
+		{"false", false, Options{}, BoolFalse, false},
+		{"string", "false", Options{}, BoolFalse, false},
+		{"int", 1, Options{}, BoolTrue, false},
+		{"pointer", &b, Options{}, BoolTrue, false},
+		{"nil", nil, Options{}, BoolUnset, false},
+		{"nilPointer", nilPtr, Options{}, BoolUnset, false},
+		{"empty", "", Options{}, BoolUnset, false},
+		{"emptyZero", "", Options{Empty: EmptyZero}, BoolUnset, false},
+		{"blank", " ", Options{TrimSpace: true}, BoolUnset, false},
+		{"BoolOpt", BoolFalse, Options{}, BoolFalse, false},
+		{"invalid", "maybe", Options{}, BoolUnset, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDefaults(tt.opts)
+			got, err := TryIntoBoolOpt(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryIntoBoolOpt(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoBoolOpt(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoolOptGet(t *testing.T) {
+	tests := []struct {
+		b         BoolOpt
+		value, ok bool
+	}{
+		{BoolUnset, false, false},
+		{BoolFalse, false, true},
+		{BoolTrue, true, true},
+	}
+	for _, tt := range tests {
+		if value, ok := tt.b.Get(); value != tt.value || ok != tt.ok || tt.b.IsSet() != tt.ok {
+			t.Errorf("%v.Get() = %v, %v, want %v, %v", tt.b, value, ok, tt.value, tt.ok)
+		}
+	}
+	if BoolOptOf(true) != BoolTrue || BoolOptOf(false) != BoolFalse {
+		t.Errorf("BoolOptOf() did not return the matching BoolOpt")
+	}
+}
+
+func TestLookupBoolOpt(t *testing.T) {
+	patch := map[string]any{"active": false, "admin": nil, "verified": "true"}
+	tests := []struct {
+		key  string
+		want BoolOpt
+	}{
+		{"active", BoolFalse},
+		{"admin", BoolUnset},
+		{"verified", BoolTrue},
+		{"missing", BoolUnset},
+	}
+	for _, tt := range tests {
+		got, err := LookupBoolOpt(patch, tt.key)
+		if err != nil || got != tt.want {
+			t.Errorf("LookupBoolOpt(%q) = %v, %v, want %v", tt.key, got, err, tt.want)
+		}
+	}
+
+	form := map[string]string{"active": "0"}
+	if got, err := LookupBoolOpt(form, "active"); err != nil || got != BoolFalse {
+		t.Errorf("LookupBoolOpt(form) = %v, %v, want false", got, err)
+	}
+}
+
+func TestBoolOptJSON(t *testing.T) {
+	type patch struct {
+		Active BoolOpt `json:"active"`
+		Admin  BoolOpt `json:"admin"`
+		Hidden BoolOpt `json:"hidden"`
+	}
+	var p patch
+	if err := json.Unmarshal([]byte(`{"active": false, "admin": null}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Active != BoolFalse || p.Admin != BoolUnset || p.Hidden != BoolUnset {
+		t.Errorf("Unmarshal = %+v", p)
+	}
+	if err := json.Unmarshal([]byte(`{"active": "maybe"}`), &p); err == nil {
+		t.Errorf("Unmarshal invalid value: error = nil")
+	}
+
+	data, err := json.Marshal(patch{Active: BoolTrue, Admin: BoolFalse})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"active":true,"admin":false,"hidden":null}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+}
+
+func TestBindOptionsBoolOpt(t *testing.T) {
+	type patch struct {
+		Active  BoolOpt  `into:"active"`
+		Admin   BoolOpt  `into:"admin"`
+		Visible *BoolOpt `into:"visible"`
+		Hidden  BoolOpt  `into:"hidden"`
+	}
+	var p patch
+	err := BindOptions(&p, map[string]string{"active": "false", "admin": "", "visible": "true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Active != BoolFalse || p.Admin != BoolUnset || p.Visible == nil || *p.Visible != BoolTrue || p.Hidden != BoolUnset {
+		t.Errorf("BindOptions = %+v", p)
+	}
+	if err := BindOptions(&p, map[string]string{"active": "maybe"}); err == nil {
+		t.Errorf("BindOptions invalid value: error = nil")
+	}
+}
@@ -0,0 +1,155 @@
+package into
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Bounded checks that a numeric value is within a range.
+//
+// Bounded returns value if min <= value <= max, so domain scalars such as
+// HTTP status codes or percentages can be validated with the same errors
+// as the range checks of the converters. If min is greater than max, every
+// value is rejected.
+//
+// Parameters:
+//   - value: the value to be checked.
+//   - min: the smallest accepted value.
+//   - max: the largest accepted value.
+//
+// Returns:
+//   - T: the value.
+//   - error: a *ConversionError wrapping ErrUnderflow if value is below min,
+//     ErrOverflow if it is above max, or ErrNaN if it is NaN.
+//
+// Example:
+//
+//	_, err := Bounded(150, 0, 100)
+//	fmt.Println(err) // Output: cannot convert int 150 to int in [0, 100]: value exceeds the maximum of the target type
+func Bounded[T Int | Uint | Float](value, min, max T) (T, error) {
+	return bounded(value, min, max, fmt.Sprintf("%s in [%v, %v]", typeName[T](), min, max))
+}
+
+// bounded checks that value is within [min, max] like Bounded, with errors
+// naming the target to.
+func bounded[T Int | Uint | Float](value, min, max T, to string) (T, error) {
+	switch {
+	case value != value:
+		return 0, nanError(value, to)
+	case value < min:
+		return 0, underflowError(value, to)
+	case value > max:
+		return 0, overflowError(value, to)
+	}
+	return value, nil
+}
+
+// ConstrainedUint16 describes a uint16 domain scalar with a range.
+//
+// ConstrainedUint16 validates small domain scalars such as port numbers,
+// protocol versions or DSCP values once, in one place, with the errors of
+// Bounded naming the domain instead of uint16. The zero value accepts every
+// uint16 value.
+//
+// Example:
+//
+//	dscp := ConstrainedUint16{Name: "DSCP value", Max: 63, HasMax: true}
+//	_, err := dscp.Parse("64")
+//	fmt.Println(err) // Output: cannot convert string 64 to DSCP value: value exceeds the maximum of the target type
+type ConstrainedUint16 struct {
+	// Name is the name of the domain used in errors. Empty means
+	// "uint16 in [Min, Max]".
+	Name string
+	// Min is the smallest accepted value.
+	Min uint16
+	// Max is the largest accepted value if HasMax is set. Without HasMax
+	// the largest accepted value is math.MaxUint16, so that a Max of 0 is
+	// not mistaken for the absence of a maximum.
+	Max uint16
+	// HasMax reports whether Max is set.
+	HasMax bool
+}
+
+// Check checks that a uint16 value is within the range of c.
+//
+// Parameters:
+//   - value: the value to be checked.
+//
+// Returns:
+//   - uint16: the value.
+//   - error: a *ConversionError wrapping ErrUnderflow or ErrOverflow if the
+//     value is outside the range of c.
+func (c ConstrainedUint16) Check(value uint16) (uint16, error) {
+	return c.check(value, value)
+}
+
+// Parse converts a string value to a uint16 within the range of c.
+//
+// Parse parses the string as a base 10 unsigned integer, as StringToUint16
+// does, and checks it like Check.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - uint16: the converted value.
+//   - error: a *ConversionError wrapping strconv.ErrSyntax if the value is
+//     not a valid integer, or ErrUnderflow or ErrOverflow if it is
+//     outside the range of c.
+func (c ConstrainedUint16) Parse(value string) (uint16, error) {
+	i, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return 0, parseError(err, value, c.name())
+	}
+	return c.check(uint16(i), value)
+}
+
+// Convert converts a value of a basic type to a uint16 within the range of
+// c.
+//
+// Convert converts the value like TryIntoAny[uint16] and checks the result
+// like Check.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - uint16: the converted value.
+//   - error: an error if the value cannot be converted to uint16, or a
+//     *ConversionError wrapping ErrUnderflow or ErrOverflow if it is outside
+//     the range of c.
+func (c ConstrainedUint16) Convert(value any) (uint16, error) {
+	i, err := TryIntoAny[uint16](value)
+	if err != nil {
+		return 0, err
+	}
+	return c.check(i, value)
+}
+
+// check checks that i, converted from value, is within the range of c.
+func (c ConstrainedUint16) check(i uint16, value any) (uint16, error) {
+	switch {
+	case i < c.Min:
+		return 0, underflowError(value, c.name())
+	case i > c.max():
+		return 0, overflowError(value, c.name())
+	}
+	return i, nil
+}
+
+// max returns the largest value accepted by c.
+func (c ConstrainedUint16) max() uint16 {
+	if !c.HasMax {
+		return math.MaxUint16
+	}
+	return c.Max
+}
+
+// name returns the name of c used in errors.
+func (c ConstrainedUint16) name() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return fmt.Sprintf("uint16 in [%d, %d]", c.Min, c.max())
+}
@@ -0,0 +1,121 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestBounded(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   float64
+		min     float64
+		max     float64
+		wantErr error
+	}{
+		{"within", 50, 0, 100, nil},
+		{"min", 0, 0, 100, nil},
+		{"max", 100, 0, 100, nil},
+		{"below", -0.5, 0, 100, ErrUnderflow},
+		{"above", 100.5, 0, 100, ErrOverflow},
+		{"NaN", math.NaN(), 0, 100, ErrNaN},
+		{"empty range", 5, 10, 0, ErrUnderflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Bounded(tt.value, tt.min, tt.max)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("Bounded(%v, %v, %v) error = %v, want %v", tt.value, tt.min, tt.max, err, tt.wantErr)
+			}
+			if err == nil && got != tt.value {
+				t.Errorf("Bounded(%v, %v, %v) = %v, want %v", tt.value, tt.min, tt.max, got, tt.value)
+			}
+		})
+	}
+}
+
+func TestBoundedError(t *testing.T) {
+	_, err := Bounded[uint8](200, 1, 100)
+	want := "cannot convert uint8 200 to uint8 in [1, 100]: value exceeds the maximum of the target type"
+	if err == nil || err.Error() != want {
+		t.Errorf("Bounded error = %v, want %q", err, want)
+	}
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Value != uint8(200) {
+		t.Errorf("Bounded error = %#v, want a *ConversionError with value 200", err)
+	}
+}
+
+func TestConstrainedUint16(t *testing.T) {
+	dscp := ConstrainedUint16{Name: "DSCP value", Max: 63, HasMax: true}
+	version := ConstrainedUint16{Min: 1, Max: 3, HasMax: true}
+	zero := ConstrainedUint16{Max: 0, HasMax: true}
+	minOnly := ConstrainedUint16{Min: 1, Max: 10}
+	tests := []struct {
+		name    string
+		got     func() (uint16, error)
+		want    uint16
+		wantErr error
+	}{
+		{"Check within", func() (uint16, error) { return dscp.Check(46) }, 46, nil},
+		{"Check above", func() (uint16, error) { return dscp.Check(64) }, 0, ErrOverflow},
+		{"Parse within", func() (uint16, error) { return version.Parse("2") }, 2, nil},
+		{"Parse below", func() (uint16, error) { return version.Parse("0") }, 0, ErrUnderflow},
+		{"Parse above", func() (uint16, error) { return version.Parse("4") }, 0, ErrOverflow},
+		{"Parse above uint16", func() (uint16, error) { return version.Parse("70000") }, 0, ErrOverflow},
+		{"Parse negative", func() (uint16, error) { return version.Parse("-1") }, 0, ErrUnderflow},
+		{"Parse syntax", func() (uint16, error) { return version.Parse("v1") }, 0, strconv.ErrSyntax},
+		{"Convert int", func() (uint16, error) { return dscp.Convert(10) }, 10, nil},
+		{"Convert string", func() (uint16, error) { return dscp.Convert("63") }, 63, nil},
+		{"Convert negative", func() (uint16, error) { return dscp.Convert(-1) }, 0, ErrUnderflow},
+		{"Convert above", func() (uint16, error) { return dscp.Convert(100.0) }, 0, ErrOverflow},
+		{"zero value", func() (uint16, error) { return ConstrainedUint16{}.Check(math.MaxUint16) }, math.MaxUint16, nil},
+		{"Max 0 accepts 0", func() (uint16, error) { return zero.Check(0) }, 0, nil},
+		{"Max 0 rejects 1", func() (uint16, error) { return zero.Check(1) }, 0, ErrOverflow},
+		{"Max without HasMax", func() (uint16, error) { return minOnly.Check(11) }, 11, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.got()
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstrainedUint16Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			"named",
+			func() error {
+				_, err := ConstrainedUint16{Name: "DSCP value", Max: 63, HasMax: true}.Parse("64")
+				return err
+			}(),
+			"cannot convert string 64 to DSCP value: value exceeds the maximum of the target type",
+		},
+		{
+			"unnamed",
+			func() error { _, err := ConstrainedUint16{Min: 1, Max: 3, HasMax: true}.Check(0); return err }(),
+			"cannot convert uint16 0 to uint16 in [1, 3]: value is below the minimum of the target type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil || tt.err.Error() != tt.want {
+				t.Errorf("error = %v, want %q", tt.err, tt.want)
+			}
+		})
+	}
+}
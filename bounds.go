@@ -0,0 +1,156 @@
+package into
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"unsafe"
+)
+
+// checkIntRange converts a signed integer value to the integer type T.
+//
+// checkIntRange converts the value and converts the result back; the value
+// is in the range of T iff it survives the round trip with its sign. It
+// returns an error wrapping ErrUnderflow or ErrOverflow naming T otherwise.
+func checkIntRange[T Int | Uint, S Int](value S) (T, error) {
+	result := T(value)
+	if S(result) != value || (result < 0) != (value < 0) {
+		if value < 0 {
+			return 0, underflowError(value, typeName[T]())
+		}
+		return 0, overflowError(value, typeName[T]())
+	}
+	return result, nil
+}
+
+// checkUintRange converts an unsigned integer value to the integer type T,
+// like checkIntRange. It returns an error wrapping ErrOverflow naming T if
+// the value exceeds the maximum of T.
+func checkUintRange[T Int | Uint, S Uint](value S) (T, error) {
+	result := T(value)
+	if S(result) != value || result < 0 {
+		return 0, overflowError(value, typeName[T]())
+	}
+	return result, nil
+}
+
+// checkFloatToInt converts a float value to the integer type T, truncating
+// toward zero.
+//
+// Converting a float to an integer truncates toward zero, so a float value
+// fits in a signed integer type iff lower < value < max+1, where lower is
+// the greatest float whose truncation is below the minimum of the type, and
+// in an unsigned integer type iff -1 < value < max+1. max+1 is a power of
+// two and is exact in any float type, but max and min-1 are not always
+// representable: float64(math.MaxInt64) rounds up to 2^63, so a check such
+// as `value > math.MaxInt64` accepts values that overflow on conversion.
+//
+// Float32 values are promoted to float64 before they are checked. The
+// promotion is exact, so the float64 bounds serve both source types. It
+// returns an error wrapping ErrNaN, ErrUnderflow or ErrOverflow naming T if
+// the value is NaN or out of the range of T.
+func checkFloatToInt[T Int | Uint, F Float](value F) (T, error) {
+	var zero T
+	f := float64(value)
+	if math.IsNaN(f) {
+		return 0, nanError(value, typeName[T]())
+	}
+
+	upper := math.Ldexp(1, int(unsafe.Sizeof(zero))*8)
+	lower := -1.0
+	if zero-1 < 0 {
+		upper /= 2
+		lower = float64Below(-upper)
+	}
+	if f >= upper {
+		return 0, overflowError(value, typeName[T]())
+	}
+	if f <= lower {
+		return 0, underflowError(value, typeName[T]())
+	}
+	return T(f), nil
+}
+
+// typeName returns the name of T as used in conversion errors.
+func typeName[T any]() string {
+	var zero T
+	return reflect.TypeOf(zero).String()
+}
+
+// float64Below returns the greatest float64 whose truncation is below min.
+// Where min-1 rounds to min, it is the float just below min as given by
+// math.Nextafter.
+func float64Below(min float64) float64 {
+	if below := min - 1; below != min {
+		return below
+	}
+	return math.Nextafter(min, math.Inf(-1))
+}
+
+// kindBits returns the size in bits of a numeric kind.
+func kindBits(kind reflect.Kind) int {
+	switch kind {
+	case reflect.Int8, reflect.Uint8:
+		return 8
+	case reflect.Int16, reflect.Uint16:
+		return 16
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 32
+	case reflect.Int, reflect.Uint:
+		return strconv.IntSize
+	}
+	return 64
+}
+
+// fitsKind reports whether the numeric value rv is within the range of
+// kind, by the rules of the checked converters: floats are truncated toward
+// zero before they are checked against an integer kind, NaN fits only in a
+// float kind, and a float64 fits in float32 up to ±math.MaxFloat32.
+func fitsKind(kind reflect.Kind, rv reflect.Value) bool {
+	bits := kindBits(kind)
+	signed := kind >= reflect.Int && kind <= reflect.Int64
+	unsigned := kind >= reflect.Uint && kind <= reflect.Uint64
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		switch {
+		case signed:
+			return bits == 64 || i >= -1<<(bits-1) && i < 1<<(bits-1)
+		case unsigned:
+			return i >= 0 && (bits == 64 || uint64(i) < 1<<bits)
+		}
+		return true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := rv.Uint()
+		switch {
+		case signed:
+			return u < 1<<(bits-1)
+		case unsigned:
+			return bits == 64 || u < 1<<bits
+		}
+		return true
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		switch {
+		case signed:
+			return f > float64Below(-math.Ldexp(1, bits-1)) && f < math.Ldexp(1, bits-1)
+		case unsigned:
+			return f > -1 && f < math.Ldexp(1, bits)
+		case kind == reflect.Float32 && rv.Kind() == reflect.Float64:
+			return math.IsNaN(f) || math.Abs(f) <= math.MaxFloat32
+		}
+		return true
+	}
+	return false
+}
+
+// isExactInt64 reports whether f represents the int64 value v without rounding.
+func isExactInt64(v int64, f float64) bool {
+	return f < 1<<63 && int64(f) == v
+}
+
+// isExactUint64 reports whether f represents the uint64 value v without rounding.
+func isExactUint64(v uint64, f float64) bool {
+	return f < 1<<64 && uint64(f) == v
+}
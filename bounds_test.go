@@ -0,0 +1,217 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFloatToIntBoundaries(t *testing.T) {
+	tests := []struct {
+		name    string
+		bits    int
+		min     float64
+		max     float64
+		convert func(float64) (float64, error)
+	}{
+		{"Float64ToInt", 64, math.MinInt, math.MaxInt, func(v float64) (float64, error) {
+			r, err := Float64ToInt(v)
+			return float64(r), err
+		}},
+		{"Float64ToInt8", 64, math.MinInt8, math.MaxInt8, func(v float64) (float64, error) {
+			r, err := Float64ToInt8(v)
+			return float64(r), err
+		}},
+		{"Float64ToInt16", 64, math.MinInt16, math.MaxInt16, func(v float64) (float64, error) {
+			r, err := Float64ToInt16(v)
+			return float64(r), err
+		}},
+		{"Float64ToInt32", 64, math.MinInt32, math.MaxInt32, func(v float64) (float64, error) {
+			r, err := Float64ToInt32(v)
+			return float64(r), err
+		}},
+		{"Float64ToInt64", 64, math.MinInt64, math.MaxInt64, func(v float64) (float64, error) {
+			r, err := Float64ToInt64(v)
+			return float64(r), err
+		}},
+		{"Float64ToUint", 64, 0, math.MaxUint, func(v float64) (float64, error) {
+			r, err := Float64ToUint(v)
+			return float64(r), err
+		}},
+		{"Float64ToUint8", 64, 0, math.MaxUint8, func(v float64) (float64, error) {
+			r, err := Float64ToUint8(v)
+			return float64(r), err
+		}},
+		{"Float64ToUint16", 64, 0, math.MaxUint16, func(v float64) (float64, error) {
+			r, err := Float64ToUint16(v)
+			return float64(r), err
+		}},
+		{"Float64ToUint32", 64, 0, math.MaxUint32, func(v float64) (float64, error) {
+			r, err := Float64ToUint32(v)
+			return float64(r), err
+		}},
+		{"Float64ToUint64", 64, 0, math.MaxUint64, func(v float64) (float64, error) {
+			r, err := Float64ToUint64(v)
+			return float64(r), err
+		}},
+		{"Float32ToInt", 32, math.MinInt, math.MaxInt, func(v float64) (float64, error) {
+			r, err := Float32ToInt(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToInt8", 32, math.MinInt8, math.MaxInt8, func(v float64) (float64, error) {
+			r, err := Float32ToInt8(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToInt16", 32, math.MinInt16, math.MaxInt16, func(v float64) (float64, error) {
+			r, err := Float32ToInt16(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToInt32", 32, math.MinInt32, math.MaxInt32, func(v float64) (float64, error) {
+			r, err := Float32ToInt32(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToInt64", 32, math.MinInt64, math.MaxInt64, func(v float64) (float64, error) {
+			r, err := Float32ToInt64(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToUint", 32, 0, math.MaxUint, func(v float64) (float64, error) {
+			r, err := Float32ToUint(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToUint8", 32, 0, math.MaxUint8, func(v float64) (float64, error) {
+			r, err := Float32ToUint8(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToUint16", 32, 0, math.MaxUint16, func(v float64) (float64, error) {
+			r, err := Float32ToUint16(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToUint32", 32, 0, math.MaxUint32, func(v float64) (float64, error) {
+			r, err := Float32ToUint32(float32(v))
+			return float64(r), err
+		}},
+		{"Float32ToUint64", 32, 0, math.MaxUint64, func(v float64) (float64, error) {
+			r, err := Float32ToUint64(float32(v))
+			return float64(r), err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// next returns the float of the source precision adjacent to v toward y.
+			next := func(v, y float64) float64 {
+				if tt.bits == 32 {
+					return float64(math.Nextafter32(float32(v), float32(y)))
+				}
+				return math.Nextafter(v, y)
+			}
+			// round rounds v to the source precision.
+			round := func(v float64) float64 {
+				if tt.bits == 32 {
+					return float64(float32(v))
+				}
+				return v
+			}
+
+			// upper is max+1, a power of two exact in both precisions.
+			upper := tt.max + 1
+			lower := round(tt.min - 1)
+			if lower == tt.min {
+				lower = next(tt.min, math.Inf(-1))
+			}
+
+			inRange := []float64{0, round(tt.min), next(upper, 0), next(lower, 0)}
+			if tt.min == 0 {
+				inRange = append(inRange, -0.5, next(-1, 0))
+			} else {
+				inRange = append(inRange, -0.5, round(tt.min+0.5))
+			}
+			for _, v := range inRange {
+				got, err := tt.convert(v)
+				if err != nil {
+					t.Errorf("%s(%v) error = %v", tt.name, v, err)
+					continue
+				}
+				if want := math.Trunc(v); got != want {
+					t.Errorf("%s(%v) = %v, want %v", tt.name, v, got, want)
+				}
+			}
+
+			for _, v := range []float64{upper, next(upper, math.Inf(1)), math.Inf(1)} {
+				if _, err := tt.convert(v); !errors.Is(err, ErrOverflow) {
+					t.Errorf("%s(%v) error = %v, want ErrOverflow", tt.name, v, err)
+				}
+			}
+			for _, v := range []float64{lower, next(lower, math.Inf(-1)), math.Inf(-1)} {
+				if _, err := tt.convert(v); !errors.Is(err, ErrUnderflow) {
+					t.Errorf("%s(%v) error = %v, want ErrUnderflow", tt.name, v, err)
+				}
+			}
+		})
+	}
+}
+
+func TestFloat32ToIntPowerOfTwo(t *testing.T) {
+	// below returns the greatest float32 less than v.
+	below := func(v float32) float32 { return math.Nextafter32(v, float32(math.Inf(-1))) }
+	// above returns the least float32 greater than v.
+	above := func(v float32) float32 { return math.Nextafter32(v, float32(math.Inf(1))) }
+
+	t.Run("Int64", func(t *testing.T) {
+		if got, err := Float32ToInt64(below(1 << 63)); err != nil || got != 9223371487098961920 {
+			t.Errorf("Float32ToInt64(2^63-2^39) = %v, %v, want 9223371487098961920", got, err)
+		}
+		if _, err := Float32ToInt64(1 << 63); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Float32ToInt64(2^63) error = %v, want ErrOverflow", err)
+		}
+		if got, err := Float32ToInt64(-1 << 63); err != nil || got != math.MinInt64 {
+			t.Errorf("Float32ToInt64(-2^63) = %v, %v, want %v", got, err, int64(math.MinInt64))
+		}
+		if _, err := Float32ToInt64(below(-1 << 63)); !errors.Is(err, ErrUnderflow) {
+			t.Errorf("Float32ToInt64(-2^63-2^40) error = %v, want ErrUnderflow", err)
+		}
+	})
+
+	t.Run("Int32", func(t *testing.T) {
+		if got, err := Float32ToInt32(below(1 << 31)); err != nil || got != 2147483520 {
+			t.Errorf("Float32ToInt32(2^31-128) = %v, %v, want 2147483520", got, err)
+		}
+		if _, err := Float32ToInt32(1 << 31); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Float32ToInt32(2^31) error = %v, want ErrOverflow", err)
+		}
+		if got, err := Float32ToInt32(-1 << 31); err != nil || got != math.MinInt32 {
+			t.Errorf("Float32ToInt32(-2^31) = %v, %v, want %v", got, err, math.MinInt32)
+		}
+		if _, err := Float32ToInt32(below(-1 << 31)); !errors.Is(err, ErrUnderflow) {
+			t.Errorf("Float32ToInt32(-2^31-256) error = %v, want ErrUnderflow", err)
+		}
+	})
+
+	t.Run("Uint64", func(t *testing.T) {
+		if got, err := Float32ToUint64(below(1 << 64)); err != nil || got != 18446742974197923840 {
+			t.Errorf("Float32ToUint64(2^64-2^40) = %v, %v, want 18446742974197923840", got, err)
+		}
+		if _, err := Float32ToUint64(1 << 64); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Float32ToUint64(2^64) error = %v, want ErrOverflow", err)
+		}
+		if _, err := Float32ToUint64(above(1 << 64)); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Float32ToUint64(2^64+2^41) error = %v, want ErrOverflow", err)
+		}
+	})
+
+	t.Run("Uint32", func(t *testing.T) {
+		if got, err := Float32ToUint32(below(1 << 32)); err != nil || got != 4294967040 {
+			t.Errorf("Float32ToUint32(2^32-256) = %v, %v, want 4294967040", got, err)
+		}
+		if _, err := Float32ToUint32(1 << 32); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Float32ToUint32(2^32) error = %v, want ErrOverflow", err)
+		}
+	})
+
+	var convErr *ConversionError
+	if _, err := Float32ToInt64(1 << 63); !errors.As(err, &convErr) || convErr.From != "float32" {
+		t.Errorf("Float32ToInt64(2^63) error = %v, want a ConversionError from float32", err)
+	}
+}
@@ -0,0 +1,26 @@
+package into
+
+// TryIntoByte attempts to convert the given value to a byte.
+//
+// TryIntoByte attempts to convert the given value to a byte. byte is an
+// alias for uint8, so the conversion is the one of TryIntoUint8; the name
+// reads better in protocol and encoding code.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - byte: the converted byte value.
+//   - error: an error if the value is out of the range 0 to 255 or the
+//     conversion fails.
+//
+// Example:
+//
+//	result, err := TryIntoByte("255")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 255
+func TryIntoByte[T convertable](value T) (byte, error) {
+	return TryIntoUint8(value)
+}
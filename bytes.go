@@ -0,0 +1,250 @@
+package into
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// StringToBytesEncoding converts a string with the given encoding to a byte slice.
+//
+// StringToBytesEncoding decodes a string written with enc, as produced by
+// BytesToStringEncoding. BytesBase64 and BytesURLBase64 are decoded with
+// StringToBytesBase64, which accepts both alphabets.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//   - enc: the encoding, e.g. BytesBase64 or BytesHex.
+//
+// Returns:
+//   - []byte: the decoded bytes.
+//   - error: an error if the string is not valid in the encoding.
+//
+// Example:
+//
+//	result, err := StringToBytesEncoding("6869", BytesHex)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(string(result)) // Output: hi
+func StringToBytesEncoding(value string, enc BytesEncoding) ([]byte, error) {
+	switch enc {
+	case BytesRaw:
+		return []byte(value), nil
+	case BytesBase64, BytesURLBase64:
+		return StringToBytesBase64(value)
+	case BytesHex:
+		return StringToBytesHex(value)
+	default:
+		return nil, fmt.Errorf("unknown bytes encoding %d", enc)
+	}
+}
+
+// StringToBytesBase64 converts a base64 string to a byte slice.
+//
+// StringToBytesBase64 decodes base64 in the standard or the URL-safe
+// alphabet, with or without padding, for inputs whose producer is not known.
+// Padding is not checked.
+// Use StringToBytesStdBase64 or StringToBytesURLBase64 to accept only one
+// alphabet.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - []byte: the decoded bytes.
+//   - error: an error if the string is not valid base64.
+//
+// Example:
+//
+//	result, err := StringToBytesBase64("aGk")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(string(result)) // Output: hi
+func StringToBytesBase64(value string) ([]byte, error) {
+	s := strings.TrimRight(value, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// StringToBytesStdBase64 converts a padded standard base64 string to a byte slice.
+//
+// Parameters:
+//   - value: the string value to be converted, as in RFC 4648 section 4.
+//
+// Returns:
+//   - []byte: the decoded bytes.
+//   - error: an error if the string is not valid padded standard base64.
+func StringToBytesStdBase64(value string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(value)
+}
+
+// StringToBytesURLBase64 converts a URL-safe base64 string to a byte slice.
+//
+// StringToBytesURLBase64 decodes URL-safe base64 with or without padding, as
+// used in URLs and JSON Web Tokens.
+//
+// Parameters:
+//   - value: the string value to be converted, as in RFC 4648 section 5.
+//
+// Returns:
+//   - []byte: the decoded bytes.
+//   - error: an error if the string is not valid URL-safe base64.
+func StringToBytesURLBase64(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
+
+// StringToBytesHex converts a hexadecimal string to a byte slice.
+//
+// Parameters:
+//   - value: the string value to be converted, in upper or lower case.
+//
+// Returns:
+//   - []byte: the decoded bytes.
+//   - error: an error if the string is not valid hexadecimal.
+func StringToBytesHex(value string) ([]byte, error) {
+	return hex.DecodeString(value)
+}
+
+// Uint16ToBytes converts a uint16 value to a 2-byte slice in the given byte order.
+//
+// Uint16ToBytes is the inverse of BytesToUint16.
+//
+// Parameters:
+//   - value: the uint16 value to be converted.
+//   - order: the byte order, e.g. binary.BigEndian.
+//
+// Returns:
+//   - []byte: a new 2-byte slice.
+//   - error: nil.
+func Uint16ToBytes(value uint16, order binary.ByteOrder) ([]byte, error) {
+	b := make([]byte, 2)
+	order.PutUint16(b, value)
+	return b, nil
+}
+
+// Uint32ToBytes converts a uint32 value to a 4-byte slice in the given byte order.
+//
+// Uint32ToBytes is the inverse of BytesToUint32.
+//
+// Parameters:
+//   - value: the uint32 value to be converted.
+//   - order: the byte order, e.g. binary.BigEndian.
+//
+// Returns:
+//   - []byte: a new 4-byte slice.
+//   - error: nil.
+func Uint32ToBytes(value uint32, order binary.ByteOrder) ([]byte, error) {
+	b := make([]byte, 4)
+	order.PutUint32(b, value)
+	return b, nil
+}
+
+// Uint64ToBytes converts a uint64 value to a 8-byte slice in the given byte order.
+//
+// Uint64ToBytes is the inverse of BytesToUint64.
+//
+// Parameters:
+//   - value: the uint64 value to be converted.
+//   - order: the byte order, e.g. binary.BigEndian.
+//
+// Returns:
+//   - []byte: a new 8-byte slice.
+//   - error: nil.
+func Uint64ToBytes(value uint64, order binary.ByteOrder) ([]byte, error) {
+	b := make([]byte, 8)
+	order.PutUint64(b, value)
+	return b, nil
+}
+
+// BytesToUint16 converts a 2-byte slice to a uint16 value in the given byte order.
+//
+// BytesToUint16 converts a 2-byte slice to a uint16 value, reading it in
+// order, which is usually binary.BigEndian for network protocols or
+// binary.LittleEndian for file formats.
+//
+// Parameters:
+//   - value: the byte slice to be converted. It must have exactly 2 bytes.
+//   - order: the byte order, e.g. binary.BigEndian.
+//
+// Returns:
+//   - uint16: the converted uint16 value.
+//   - error: a *ConversionError wrapping ErrLength if the slice does not have
+//     2 bytes.
+//
+// Example:
+//
+//	result, err := BytesToUint16([]byte{0x01, 0x02}, binary.BigEndian)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 258
+func BytesToUint16(value []byte, order binary.ByteOrder) (uint16, error) {
+	if len(value) != 2 {
+		return 0, lengthError(value, 2, "uint16")
+	}
+	return order.Uint16(value), nil
+}
+
+// BytesToUint32 converts a 4-byte slice to a uint32 value in the given byte order.
+//
+// BytesToUint32 converts a 4-byte slice to a uint32 value, reading it in
+// order, which is usually binary.BigEndian for network protocols or
+// binary.LittleEndian for file formats.
+//
+// Parameters:
+//   - value: the byte slice to be converted. It must have exactly 4 bytes.
+//   - order: the byte order, e.g. binary.BigEndian.
+//
+// Returns:
+//   - uint32: the converted uint32 value.
+//   - error: a *ConversionError wrapping ErrLength if the slice does not have
+//     4 bytes.
+//
+// Example:
+//
+//	result, err := BytesToUint32([]byte{0, 0, 0x01, 0x02}, binary.BigEndian)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 258
+func BytesToUint32(value []byte, order binary.ByteOrder) (uint32, error) {
+	if len(value) != 4 {
+		return 0, lengthError(value, 4, "uint32")
+	}
+	return order.Uint32(value), nil
+}
+
+// BytesToUint64 converts a 8-byte slice to a uint64 value in the given byte order.
+//
+// BytesToUint64 converts a 8-byte slice to a uint64 value, reading it in
+// order, which is usually binary.BigEndian for network protocols or
+// binary.LittleEndian for file formats.
+//
+// Parameters:
+//   - value: the byte slice to be converted. It must have exactly 8 bytes.
+//   - order: the byte order, e.g. binary.BigEndian.
+//
+// Returns:
+//   - uint64: the converted uint64 value.
+//   - error: a *ConversionError wrapping ErrLength if the slice does not have
+//     8 bytes.
+//
+// Example:
+//
+//	result, err := BytesToUint64([]byte{0, 0, 0, 0, 0, 0, 0x01, 0x02}, binary.BigEndian)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 258
+func BytesToUint64(value []byte, order binary.ByteOrder) (uint64, error) {
+	if len(value) != 8 {
+		return 0, lengthError(value, 8, "uint64")
+	}
+	return order.Uint64(value), nil
+}
@@ -0,0 +1,131 @@
+package into_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestBytesEncoding(t *testing.T) {
+	data := []byte{0xfb, 0xff, 0x00, 'h', 'i'}
+	tests := []struct {
+		name string
+		enc  BytesEncoding
+		want string
+	}{
+		{"raw", BytesRaw, string(data)},
+		{"base64", BytesBase64, "+/8AaGk="},
+		{"urlBase64", BytesURLBase64, "-_8AaGk"},
+		{"hex", BytesHex, "fbff006869"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BytesToStringEncoding(data, tt.enc)
+			if err != nil || got != tt.want {
+				t.Errorf("BytesToStringEncoding() = %q, %v, want %q", got, err, tt.want)
+			}
+			back, err := StringToBytesEncoding(got, tt.enc)
+			if err != nil || !bytes.Equal(back, data) {
+				t.Errorf("StringToBytesEncoding(%q) = %v, %v, want %v", got, back, err, data)
+			}
+
+			defer SetDefaults(Defaults())
+			SetDefaults(Options{Bytes: tt.enc})
+			if s, err := TryIntoString(data); err != nil || s != tt.want {
+				t.Errorf("TryIntoString() = %q, %v, want %q", s, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := BytesToStringEncoding(data, BytesEncoding(-1)); err == nil {
+		t.Error("BytesToStringEncoding() with an unknown encoding error = nil")
+	}
+}
+
+func TestStringToBytesBase64(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func(string) ([]byte, error)
+		input   string
+		want    []byte
+		wantErr bool
+	}{
+		{"lenientStd", StringToBytesBase64, "+/8=", []byte{0xfb, 0xff}, false},
+		{"lenientURL", StringToBytesBase64, "-_8", []byte{0xfb, 0xff}, false},
+		{"lenientInvalid", StringToBytesBase64, "a*b", nil, true},
+		{"std", StringToBytesStdBase64, "aGk=", []byte("hi"), false},
+		{"stdUnpadded", StringToBytesStdBase64, "aGk", nil, true},
+		{"stdURLAlphabet", StringToBytesStdBase64, "-_8=", nil, true},
+		{"url", StringToBytesURLBase64, "-_8", []byte{0xfb, 0xff}, false},
+		{"urlPadded", StringToBytesURLBase64, "-_8=", []byte{0xfb, 0xff}, false},
+		{"urlStdAlphabet", StringToBytesURLBase64, "+/8", nil, true},
+		{"hexUpper", StringToBytesHex, "FBFF", []byte{0xfb, 0xff}, false},
+		{"hexOdd", StringToBytesHex, "fbf", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !bytes.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestByteOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		order   binary.ByteOrder
+		want    uint64
+		wantErr error
+	}{
+		{"bigEndian", []byte{1, 2, 3, 4, 5, 6, 7, 8}, binary.BigEndian, 0x0102030405060708, nil},
+		{"littleEndian", []byte{1, 2, 3, 4, 5, 6, 7, 8}, binary.LittleEndian, 0x0807060504030201, nil},
+		{"short", []byte{1, 2, 3}, binary.BigEndian, 0, ErrLength},
+		{"long", make([]byte, 9), binary.BigEndian, 0, ErrLength},
+		{"nil", nil, binary.BigEndian, 0, ErrLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BytesToUint64(tt.input, tt.order)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BytesToUint64() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BytesToUint64() = %#x, want %#x", got, tt.want)
+			}
+			if b, _ := Uint64ToBytes(got, tt.order); !bytes.Equal(b, tt.input) {
+				t.Errorf("Uint64ToBytes(%#x) = %v, want %v", got, b, tt.input)
+			}
+		})
+	}
+
+	if got, err := BytesToUint16([]byte{0x01, 0x02}, binary.BigEndian); err != nil || got != 0x0102 {
+		t.Errorf("BytesToUint16() = %#x, %v", got, err)
+	}
+	if b, _ := Uint16ToBytes(0x0102, binary.LittleEndian); !bytes.Equal(b, []byte{0x02, 0x01}) {
+		t.Errorf("Uint16ToBytes() = %v", b)
+	}
+	if got, err := BytesToUint32([]byte{0x01, 0x02, 0x03, 0x04}, binary.LittleEndian); err != nil || got != 0x04030201 {
+		t.Errorf("BytesToUint32() = %#x, %v", got, err)
+	}
+	if b, _ := Uint32ToBytes(0x01020304, binary.BigEndian); !bytes.Equal(b, []byte{1, 2, 3, 4}) {
+		t.Errorf("Uint32ToBytes() = %v", b)
+	}
+	if _, err := BytesToUint32([]byte{1, 2}, binary.BigEndian); !errors.Is(err, ErrLength) {
+		t.Errorf("BytesToUint32() error = %v, want %v", err, ErrLength)
+	}
+}
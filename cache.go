@@ -0,0 +1,91 @@
+package into
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Cached wraps a conversion function with a memoizing cache of bounded size.
+//
+// Cached returns a function that converts like conv, but remembers the
+// results, including errors, of the size most recently used inputs. It
+// helps workloads that convert the same values many times, such as CSV
+// columns with a few distinct values, where parsing dominates. When the
+// cache is full, the least recently used result is evicted. The returned
+// function is safe for concurrent use; conv must not depend on state that
+// changes between calls, such as the options set by SetDefaults.
+//
+// Parameters:
+//   - conv: the conversion function, e.g. StringToInt64.
+//   - size: the maximum number of cached results. It is at least 1.
+//
+// Returns:
+//   - func(U) (T, error): the caching conversion function.
+//
+// Example:
+//
+//	parse := Cached(StringToFloat64, 1024)
+//	for _, row := range rows {
+//	  price, err := parse(row[2])
+//	  ...
+//	}
+func Cached[U comparable, T any](conv func(U) (T, error), size int) func(U) (T, error) {
+	if size < 1 {
+		size = 1
+	}
+	c := &lruCache[U, T]{size: size, items: make(map[U]*list.Element, size), order: list.New()}
+	return func(value U) (T, error) {
+		if result, err, ok := c.get(value); ok {
+			return result, err
+		}
+		result, err := conv(value)
+		c.put(value, result, err)
+		return result, err
+	}
+}
+
+// lruCache is a least recently used cache of conversion results.
+type lruCache[U comparable, T any] struct {
+	mu    sync.Mutex
+	size  int
+	items map[U]*list.Element
+	// order holds *lruEntry values, the most recently used first.
+	order *list.List
+}
+
+// lruEntry is a cached conversion result.
+type lruEntry[U comparable, T any] struct {
+	key    U
+	result T
+	err    error
+}
+
+// get returns the cached result for key and marks it as recently used.
+func (c *lruCache[U, T]) get(key U) (result T, err error, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return result, nil, false
+	}
+	c.order.MoveToFront(e)
+	entry := e.Value.(*lruEntry[U, T])
+	return entry.result, entry.err, true
+}
+
+// put caches the result for key, evicting the least recently used result if
+// the cache is full.
+func (c *lruCache[U, T]) put(key U, result T, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[U, T]).key)
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[U, T]{key: key, result: result, err: err})
+}
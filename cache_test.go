@@ -0,0 +1,71 @@
+package into_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestCached(t *testing.T) {
+	calls := map[string]int{}
+	var mu sync.Mutex
+	conv := Cached(func(s string) (int64, error) {
+		mu.Lock()
+		calls[s]++
+		mu.Unlock()
+		return StringToInt64(s)
+	}, 2)
+
+	for _, s := range []string{"1", "1", "2", "1", "x", "x"} {
+		want, wantErr := StringToInt64(s)
+		got, err := conv(s)
+		if got != want || (err != nil) != (wantErr != nil) {
+			t.Errorf("conv(%q) = %v, %v, want %v, %v", s, got, err, want, wantErr)
+		}
+	}
+	// "2" is evicted by "x", since "1" was used more recently, while the
+	// error of "x" stays cached.
+	if _, err := conv("2"); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = conv("x")
+
+	want := map[string]int{"1": 1, "2": 2, "x": 1}
+	for s, n := range want {
+		if calls[s] != n {
+			t.Errorf("calls[%q] = %d, want %d", s, calls[s], n)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s := strconv.Itoa((i + j) % 5)
+				if got, err := conv(s); err != nil || strconv.FormatInt(got, 10) != s {
+					t.Errorf("conv(%q) = %v, %v", s, got, err)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func BenchmarkCached(b *testing.B) {
+	values := []string{"3.14159", "2.71828", "1.41421", "1.73205"}
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = StringToFloat64(values[i%len(values)])
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		conv := Cached(StringToFloat64, 16)
+		for i := 0; i < b.N; i++ {
+			_, _ = conv(values[i%len(values)])
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package into
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// CanonicalString returns the canonical string form of a value.
+//
+// CanonicalString formats value so that TryInto[T] converts the result back
+// to value exactly, and equal values always have the same form, as needed
+// for cache keys and content hashes. Integers are formatted in base 10 and
+// bools as "true" or "false". Floats are formatted with the 'g' format and
+// the fewest digits that identify the value at the precision of T, so
+// float32(0.1) is "0.1", 1e21 is "1e+21", and the signed zero, infinities
+// and NaN are "-0", "+Inf", "-Inf" and "NaN". Strings are returned as is. If
+// a function is registered for T to string, as for Float16, it is used
+// instead.
+//
+// Parameters:
+//   - value: the value to be formatted.
+//
+// Returns:
+//   - string: the canonical string form of value.
+//
+// Example:
+//
+//	s := CanonicalString(float32(0.1))
+//	v, _ := TryInto[float32](s)
+//	fmt.Println(s, v == float32(0.1)) // Output: 0.1 true
+func CanonicalString[T convertable](value T) string {
+	if r, ok, err := convertRegistered(value, basicTypes[reflect.String], Defaults()); ok && err == nil {
+		if s, ok := r.(string); ok {
+			return s
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	}
+	return rv.String()
+}
@@ -0,0 +1,72 @@
+package into_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestCanonicalString(t *testing.T) {
+	type celsius float64
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"int", CanonicalString(-42), "-42"},
+		{"int8", CanonicalString(int8(math.MinInt8)), "-128"},
+		{"uint64", CanonicalString(uint64(math.MaxUint64)), "18446744073709551615"},
+		{"bool", CanonicalString(true), "true"},
+		{"string", CanonicalString(" x "), " x "},
+		{"float64", CanonicalString(0.1), "0.1"},
+		{"float64 large", CanonicalString(1e21), "1e+21"},
+		{"float64 small", CanonicalString(5e-324), "5e-324"},
+		{"float32", CanonicalString(float32(0.1)), "0.1"},
+		{"negative zero", CanonicalString(math.Copysign(0, -1)), "-0"},
+		{"infinity", CanonicalString(math.Inf(-1)), "-Inf"},
+		{"NaN", CanonicalString(float32(math.NaN())), "NaN"},
+		{"named", CanonicalString(celsius(21.5)), "21.5"},
+		{"registered", CanonicalString(Float16(0x3e00)), "1.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("CanonicalString() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalStringRoundTrip(t *testing.T) {
+	floats := []float64{0, math.Copysign(0, -1), 0.1, 1.0 / 3, math.Pi, 1e21, 1e-7, math.MaxFloat64,
+		math.SmallestNonzeroFloat64, math.Inf(1), math.Inf(-1), math.NaN(), math.Nextafter(1, 2)}
+	for _, f := range floats {
+		checkCanonical(t, f, func(a, b float64) bool { return math.Float64bits(a) == math.Float64bits(b) || a != a && b != b })
+		f32 := float32(f)
+		checkCanonical(t, f32, func(a, b float32) bool { return math.Float32bits(a) == math.Float32bits(b) || a != a && b != b })
+	}
+	for _, i := range []int64{math.MinInt64, -1, 0, 1, math.MaxInt64} {
+		checkCanonical(t, i, func(a, b int64) bool { return a == b })
+	}
+	for _, u := range []uint{0, math.MaxUint} {
+		checkCanonical(t, u, func(a, b uint) bool { return a == b })
+	}
+	for _, h := range []Float16{0x0001, 0x3555, 0x7bff, 0xfc00} {
+		checkCanonical(t, h, func(a, b Float16) bool { return a == b })
+	}
+	checkCanonical(t, false, func(a, b bool) bool { return a == b })
+	checkCanonical(t, "", func(a, b string) bool { return a == b })
+}
+
+// checkCanonical checks that TryInto converts the canonical string form of
+// value back to value.
+func checkCanonical[T int64 | uint | float32 | float64 | bool | string | Float16](t *testing.T, value T, equal func(a, b T) bool) {
+	t.Helper()
+	s := CanonicalString(value)
+	got, err := TryInto[T](s)
+	if err != nil || !equal(got, value) {
+		t.Errorf("TryInto[%T](%q) = %v, %v, want %v", value, s, got, err, value)
+	}
+}
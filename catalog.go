@@ -0,0 +1,81 @@
+package into
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Catalog translates the messages of conversion errors.
+//
+// Message returns the message of err, which is a *ConversionError or an
+// *UnsupportedTypeError, in the language of the catalog. Implementations
+// select the message by the reason of the failure with errors.Is, such as
+// ErrOverflow or strconv.ErrSyntax for a *ConversionError, and may return
+// "" to fall back to the default English message, so a catalog only needs
+// to translate the reasons it knows. Message must not call err.Error, which
+// calls the catalog.
+type Catalog interface {
+	Message(err error) string
+}
+
+// CatalogFunc adapts a function to the Catalog interface.
+type CatalogFunc func(err error) string
+
+// Message returns f(err).
+func (f CatalogFunc) Message(err error) string {
+	return f(err)
+}
+
+// catalog holds a catalogHolder, since atomic.Value cannot store nil.
+var catalog atomic.Value
+
+type catalogHolder struct{ c Catalog }
+
+// SetCatalog sets the catalog used for the messages of conversion errors.
+//
+// SetCatalog sets c to translate the messages returned by the Error methods
+// of ConversionError and UnsupportedTypeError, so that applications can show
+// them to users in their language. The messages that c does not translate,
+// and all messages if c is nil, are in English. c must be safe for
+// concurrent use. It is safe for concurrent use.
+//
+// Parameters:
+//   - c: the catalog, or nil.
+//
+// Example:
+//
+//	SetCatalog(CatalogFunc(func(err error) string {
+//	  var convErr *ConversionError
+//	  if errors.As(err, &convErr) && errors.Is(convErr, ErrOverflow) {
+//	    return fmt.Sprintf("%v est trop grand pour %s", convErr.Value, convErr.To)
+//	  }
+//	  return ""
+//	}))
+//	_, err := TryInto[int8](300)
+//	fmt.Println(err) // Output: 300 est trop grand pour int8
+func SetCatalog(c Catalog) {
+	catalog.Store(catalogHolder{c})
+}
+
+// message returns the message of err from the catalog set by SetCatalog,
+// or the English message if there is none.
+func message(err error) string {
+	if h, _ := catalog.Load().(catalogHolder); h.c != nil {
+		if msg := h.c.Message(err); msg != "" {
+			return msg
+		}
+	}
+	return englishMessage(err)
+}
+
+// englishMessage returns the default English message of err.
+func englishMessage(err error) string {
+	switch e := err.(type) {
+	case *ConversionError:
+		return fmt.Sprintf("cannot convert %s %v to %s: %v", e.From, e.Value, e.To, e.Err)
+	case *UnsupportedTypeError:
+		return fmt.Sprintf("cannot convert %s to %s", e.From, e.To)
+	default:
+		return err.Error()
+	}
+}
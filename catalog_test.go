@@ -0,0 +1,76 @@
+package into_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+// frenchCatalog translates the overflow and syntax errors into French.
+var frenchCatalog = CatalogFunc(func(err error) string {
+	var convErr *ConversionError
+	if errors.As(err, &convErr) {
+		switch {
+		case errors.Is(convErr, ErrOverflow):
+			return fmt.Sprintf("%v est trop grand pour %s", convErr.Value, convErr.To)
+		case errors.Is(convErr, strconv.ErrSyntax):
+			return fmt.Sprintf("%q n'est pas un nombre", convErr.Value)
+		}
+	}
+	var unsupported *UnsupportedTypeError
+	if errors.As(err, &unsupported) {
+		return fmt.Sprintf("impossible de convertir %s en %s", unsupported.From, unsupported.To)
+	}
+	return ""
+})
+
+func TestSetCatalog(t *testing.T) {
+	defer SetCatalog(nil)
+
+	_, overflow := TryInto[int8](300)
+	_, syntax := TryInto[int]("abc")
+	_, unsupportedStruct := TryIntoAny[int](struct{}{})
+	_, underflow := TryInto[uint](-1)
+	_, unsupported := TryIntoAny[time.Time](true)
+
+	english := map[error]string{
+		overflow:          overflow.Error(),
+		syntax:            syntax.Error(),
+		unsupportedStruct: unsupportedStruct.Error(),
+		underflow:         underflow.Error(),
+		unsupported:       unsupported.Error(),
+	}
+
+	SetCatalog(frenchCatalog)
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"overflow", overflow, "300 est trop grand pour int8"},
+		{"syntax", syntax, `"abc" n'est pas un nombre`},
+		{"unsupported", unsupported, "impossible de convertir bool en time.Time"},
+		{"fallback", underflow, english[underflow]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	SetCatalog(nil)
+	for err, want := range english {
+		if got := err.Error(); got != want {
+			t.Errorf("Error() after SetCatalog(nil) = %q, want %q", got, want)
+		}
+	}
+	if got := overflow.Error(); got != "cannot convert int 300 to int8: value exceeds the maximum of the target type [-128, 127]" {
+		t.Errorf("Error() = %q", got)
+	}
+}
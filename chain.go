@@ -0,0 +1,136 @@
+package into
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Step is a conversion step run by Chain.
+type Step func(value any) (any, error)
+
+// To returns the Step that converts a value to T like TryIntoAny.
+//
+// Parameters:
+//   - T: the target type of the step.
+//
+// Returns:
+//   - Step: the conversion step.
+//
+// Example:
+//
+//	result, err := Chain("1e3", To[float64](), To[int16]())
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1000
+func To[T convertable | time.Time]() Step {
+	return func(value any) (any, error) {
+		return TryIntoAny[T](value)
+	}
+}
+
+// Chain converts a value through a sequence of steps.
+//
+// Chain passes value to the first step, the result of each step to the
+// next one and returns the result of the last step. When a step fails, the
+// error records the original input and every intermediate value, so a
+// failure in a multi-hop conversion such as string to float64 to int8 can
+// be traced back to the input that caused it.
+//
+// Parameters:
+//   - value: the input of the chain.
+//   - steps: the conversion steps, e.g. To[float64]() or a custom Step.
+//
+// Returns:
+//   - any: the result of the last step, or value if there are no steps.
+//   - error: a *ChainError wrapping the error of the failed step.
+//
+// Example:
+//
+//	_, err := Chain("300.5", To[float64](), To[int](), To[int8]())
+//	fmt.Println(err)
+//	// Output: step 2: cannot convert int 300 to int8: value exceeds the
+//	// maximum of the target type [-128, 127] (values: string "300.5",
+//	// float64 300.5, int 300)
+func Chain(value any, steps ...Step) (any, error) {
+	values := make([]any, 1, len(steps)+1)
+	values[0] = value
+	for i, step := range steps {
+		r, err := step(value)
+		if err != nil {
+			return nil, &ChainError{Step: i, Values: values, Err: err}
+		}
+		value = r
+		values = append(values, r)
+	}
+	return value, nil
+}
+
+// TryIntoVia converts a value to T through a sequence of steps.
+//
+// TryIntoVia converts like Chain with a final step To[T](), for
+// conversions that must go through intermediate types, e.g. a string
+// holding a float that is rounded into an integer.
+//
+// Parameters:
+//   - value: the input of the chain.
+//   - steps: the conversion steps before the final conversion to T.
+//
+// Returns:
+//   - T: the converted value.
+//   - error: a *ChainError wrapping the error of the failed step.
+//
+// Example:
+//
+//	result, err := TryIntoVia[uint8]("42.0", To[float64]())
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 42
+func TryIntoVia[T convertable | time.Time](value any, steps ...Step) (T, error) {
+	steps = append(steps[:len(steps):len(steps)], To[T]())
+	r, err := Chain(value, steps...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return r.(T), nil
+}
+
+// ChainError describes a failed step of a conversion chain.
+//
+// ChainError records the input of the chain and the result of every step
+// before the failed one. It wraps the error of the failed step, so errors.Is
+// and errors.As test its reason (e.g. ErrOverflow) as for a single
+// conversion.
+type ChainError struct {
+	// Step is the index of the failed step.
+	Step int
+	// Values holds the input of the chain followed by the result of each
+	// successful step, so Values[Step] is the input of the failed step.
+	Values []any
+	// Err is the error of the failed step.
+	Err error
+}
+
+// Error returns the error message.
+func (e *ChainError) Error() string {
+	var b strings.Builder
+	for i, v := range e.Values {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if s, ok := v.(string); ok {
+			fmt.Fprintf(&b, "string %q", s)
+		} else {
+			fmt.Fprintf(&b, "%T %v", v, v)
+		}
+	}
+	return fmt.Sprintf("step %d: %v (values: %s)", e.Step, e.Err, b.String())
+}
+
+// Unwrap returns the error of the failed step.
+func (e *ChainError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,75 @@
+package into_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestChain(t *testing.T) {
+	got, err := Chain("1e3", To[float64](), To[int16]())
+	if err != nil || got != int16(1000) {
+		t.Errorf("Chain() = %v, %v, want 1000", got, err)
+	}
+	if got, err := Chain(42); err != nil || got != 42 {
+		t.Errorf("Chain() without steps = %v, %v, want 42", got, err)
+	}
+
+	trim := Step(func(value any) (any, error) {
+		return strings.TrimSuffix(value.(string), "px"), nil
+	})
+	if got, err := Chain("12px", trim, To[uint8]()); err != nil || got != uint8(12) {
+		t.Errorf("Chain() with a custom step = %v, %v, want 12", got, err)
+	}
+}
+
+func TestChainError(t *testing.T) {
+	_, err := Chain("300.5", To[float64](), To[int](), To[int8]())
+	if !errors.Is(err, ErrOverflow) {
+		t.Fatalf("Chain() error = %v, want %v", err, ErrOverflow)
+	}
+	var chainErr *ChainError
+	if !errors.As(err, &chainErr) {
+		t.Fatalf("Chain() error = %T, want *ChainError", err)
+	}
+	if chainErr.Step != 2 {
+		t.Errorf("ChainError.Step = %d, want 2", chainErr.Step)
+	}
+	if want := []any{"300.5", 300.5, 300}; !reflect.DeepEqual(chainErr.Values, want) {
+		t.Errorf("ChainError.Values = %v, want %v", chainErr.Values, want)
+	}
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.To != "int8" {
+		t.Errorf("Chain() error does not wrap the *ConversionError of the step: %v", err)
+	}
+	want := `step 2: cannot convert int 300 to int8: value exceeds the maximum of the target type [-128, 127] (values: string "300.5", float64 300.5, int 300)`
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	_, err = Chain("x", To[float64]())
+	if !errors.As(err, &chainErr) || chainErr.Step != 0 || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Chain() error = %v, want a syntax error at step 0", err)
+	}
+}
+
+func TestTryIntoVia(t *testing.T) {
+	got, err := TryIntoVia[uint8]("42.0", To[float64]())
+	if err != nil || got != 42 {
+		t.Errorf("TryIntoVia() = %v, %v, want 42", got, err)
+	}
+	if _, err := TryIntoVia[uint8]("-1.5", To[float64]()); !errors.Is(err, ErrUnderflow) {
+		t.Errorf("TryIntoVia() error = %v, want %v", err, ErrUnderflow)
+	}
+
+	steps := make([]Step, 1, 2)
+	steps[0] = To[float64]()
+	_, _ = TryIntoVia[int8]("1", steps...)
+	if steps[:2][1] != nil {
+		t.Error("TryIntoVia() modified the steps of the caller")
+	}
+}
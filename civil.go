@@ -0,0 +1,313 @@
+package into
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateLayout is the layout of a date without a time, as in ISO 8601.
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date without a time of day or location.
+//
+// Date holds the date part of values such as "2024-05-01", so that a date is
+// not confused with the instant at midnight in some time zone. The zero
+// value is not a valid date.
+type Date struct {
+	// Year is the year, e.g. 2024.
+	Year int
+	// Month is the month of the year.
+	Month time.Month
+	// Day is the day of the month, starting at 1.
+	Day int
+}
+
+// String returns the date in the "2006-01-02" format.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsValid reports whether d is a date that exists in the proleptic
+// Gregorian calendar, e.g. 2024-02-29 but not 2023-02-29.
+func (d Date) IsValid() bool {
+	t := time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+	return t.Year() == d.Year && t.Month() == d.Month && t.Day() == d.Day
+}
+
+// StringToDate converts the given string value to a Date value.
+//
+// StringToDate converts a date in the "2006-01-02" format to a Date value.
+// Strings with a time of day or a time zone are rejected.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - Date: the converted Date value.
+//   - error: an error if the string is not a valid date.
+//
+// Example:
+//
+//	result, err := StringToDate("2024-05-01")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.Month) // Output: May
+func StringToDate(value string) (Date, error) {
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return Date{}, err
+	}
+	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}, nil
+}
+
+// DateToString converts the given Date value to a string value.
+//
+// DateToString converts a Date value to a string in the "2006-01-02" format.
+//
+// Parameters:
+//   - value: the Date value to be converted.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: an error if the date is not valid.
+func DateToString(value Date) (string, error) {
+	if !value.IsValid() {
+		return "", fmt.Errorf("invalid date %s", value)
+	}
+	return value.String(), nil
+}
+
+// DateToTime converts the given Date value to a time.Time value.
+//
+// DateToTime converts a Date value to a time.Time value at midnight UTC of
+// that date.
+//
+// Parameters:
+//   - value: the Date value to be converted.
+//
+// Returns:
+//   - time.Time: the converted time.Time value.
+//   - error: an error if the date is not valid.
+func DateToTime(value Date) (time.Time, error) {
+	if !value.IsValid() {
+		return time.Time{}, fmt.Errorf("invalid date %s", value)
+	}
+	return time.Date(value.Year, value.Month, value.Day, 0, 0, 0, 0, time.UTC), nil
+}
+
+// TimeToDate converts the given time.Time value to a Date value.
+//
+// TimeToDate converts a time.Time value to the Date it falls on in its own
+// location. Use value.UTC() or value.In(loc) first to choose the location.
+//
+// Parameters:
+//   - value: the time.Time value to be converted.
+//
+// Returns:
+//   - Date: the converted Date value.
+//   - error: nil.
+func TimeToDate(value time.Time) (Date, error) {
+	return Date{Year: value.Year(), Month: value.Month(), Day: value.Day()}, nil
+}
+
+// StringToTimeDateOnly converts the given date string to a time.Time value.
+//
+// StringToTimeDateOnly converts a date in the "2006-01-02" format to a
+// time.Time value at midnight UTC. Unlike StringToTime, it rejects strings
+// with a time of day, so a date-only field is never filled from a timestamp
+// by accident.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - time.Time: the converted time.Time value.
+//   - error: an error if the string is not a valid date.
+//
+// Example:
+//
+//	result, err := StringToTimeDateOnly("2024-05-01")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 2024-05-01 00:00:00 +0000 UTC
+func StringToTimeDateOnly(value string) (time.Time, error) {
+	return time.Parse(dateLayout, value)
+}
+
+// StringToTimeOfDay converts the given string value to the time since midnight.
+//
+// StringToTimeOfDay converts a time of day in the "15:04", "15:04:05" or
+// "15:04:05.999999999" format to the time.Duration since midnight. Hours
+// range from 00 to 23; a leap second (60) is rejected.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - time.Duration: the time since midnight, in the range [0, 24h).
+//   - error: an error if the string is not a valid time of day.
+//
+// Example:
+//
+//	result, err := StringToTimeOfDay("15:04:05")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 15h4m5s
+func StringToTimeOfDay(value string) (time.Duration, error) {
+	layout := "15:04:05"
+	switch {
+	case len(value) == len("15:04"):
+		layout = "15:04"
+	case strings.Contains(value, "."):
+		layout = "15:04:05.999999999"
+	}
+
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return 0, err
+	}
+	// time.Parse accepts single-digit fields; require the canonical form.
+	if value[2] != ':' {
+		return 0, fmt.Errorf("invalid time of day %q", value)
+	}
+	return t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)), nil
+}
+
+// TimeOfDayToString converts the given time since midnight to a string value.
+//
+// TimeOfDayToString converts a time.Duration since midnight to a time of day
+// in the "15:04:05" format, with fractional seconds when they are not zero.
+//
+// Parameters:
+//   - value: the time since midnight.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: an error if the value is outside the range [0, 24h).
+//
+// Example:
+//
+//	result, err := TimeOfDayToString(15*time.Hour + 4*time.Minute + 5*time.Second)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 15:04:05
+func TimeOfDayToString(value time.Duration) (string, error) {
+	if value < 0 || value >= 24*time.Hour {
+		return "", fmt.Errorf("time of day %v is outside the range [0, 24h)", value)
+	}
+	return time.Time{}.Add(value).Format("15:04:05.999999999"), nil
+}
+
+// StringToTimeOrder converts the given numeric date string to a time.Time
+// value, reading its fields in the given order.
+//
+// StringToTimeOrder converts dates such as "03/04/05", "3.4.2005" or
+// "2005-04-03" whose fields are separated by "/", "-" or "." to a time.Time
+// value in UTC. The date may be followed by a time of day in the format of
+// StringToTimeOfDay, separated by a space or "T". Years have two or four
+// digits; a two-digit year is mapped into the 100-year window starting at
+// pivot, so with a pivot of 1950, "49" is 2049 and "50" is 1950. A pivot of
+// zero means 1969, as in time.Parse. Strings accepted by StringToTime, such
+// as "2006-01-02" and RFC 3339 timestamps, are converted with it first.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//   - order: the order of the year, month and day fields.
+//   - pivot: the first year of the window for two-digit years.
+//
+// Returns:
+//   - time.Time: the converted time.Time value.
+//   - error: an error if the string is not a valid date in the given order,
+//     or if order is DateOrderReject and the string is ambiguous.
+//
+// Example:
+//
+//	result, err := StringToTimeOrder("03/04/05", DMY, 0)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 2005-04-03 00:00:00 +0000 UTC
+func StringToTimeOrder(value string, order DateOrder, pivot int) (time.Time, error) {
+	t, err := StringToTime(value)
+	if err == nil || !isNumericDate(value) {
+		return t, err
+	}
+
+	date, clock := value, ""
+	if i := strings.IndexAny(value, " T"); i >= 0 {
+		date, clock = value[:i], value[i+1:]
+	}
+	sep := strings.IndexAny(date, "/-.")
+	fields := strings.Split(date, date[sep:sep+1])
+
+	var year, month, day string
+	switch order {
+	case MDY:
+		month, day, year = fields[0], fields[1], fields[2]
+	case DMY:
+		day, month, year = fields[0], fields[1], fields[2]
+	case YMD:
+		year, month, day = fields[0], fields[1], fields[2]
+	default:
+		return time.Time{}, fmt.Errorf("ambiguous date %q: no date order", value)
+	}
+	if len(year) != 2 && len(year) != 4 || len(month) > 2 || len(day) > 2 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+
+	y, _ := strconv.Atoi(year)
+	if len(year) == 2 {
+		if pivot == 0 {
+			pivot = 1969
+		}
+		y += pivot - pivot%100
+		if y < pivot {
+			y += 100
+		}
+	}
+	m, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	result := Date{Year: y, Month: time.Month(m), Day: d}
+	t, err = DateToTime(result)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+
+	if clock != "" {
+		since, err := StringToTimeOfDay(clock)
+		if err != nil {
+			return time.Time{}, err
+		}
+		t = t.Add(since)
+	}
+	return t, nil
+}
+
+// isNumericDate reports whether s starts with three groups of digits
+// separated by the same "/", "-" or "." separator, optionally followed by a
+// space or "T" and more text.
+func isNumericDate(s string) bool {
+	if i := strings.IndexAny(s, " T"); i >= 0 {
+		s = s[:i]
+	}
+	i := strings.IndexAny(s, "/-.")
+	if i < 0 {
+		return false
+	}
+	fields := strings.Split(s, s[i:i+1])
+	if len(fields) != 3 {
+		return false
+	}
+	for _, f := range fields {
+		if !isDigits(f) {
+			return false
+		}
+	}
+	return true
+}
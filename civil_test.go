@@ -0,0 +1,161 @@
+package into_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Date
+		wantErr bool
+	}{
+		{"date", "2024-05-01", Date{2024, time.May, 1}, false},
+		{"leapDay", "2024-02-29", Date{2024, time.February, 29}, false},
+		{"notLeapDay", "2023-02-29", Date{}, true},
+		{"withTime", "2024-05-01T15:04:05Z", Date{}, true},
+		{"otherLayout", "01/05/2024", Date{}, true},
+		{"empty", "", Date{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToDate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToDate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToDate() = %v, want %v", got, tt.want)
+			}
+			if err == nil {
+				if s, err := DateToString(got); err != nil || s != tt.input {
+					t.Errorf("DateToString() = %q, %v, want %q", s, err, tt.input)
+				}
+			}
+		})
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	if got, err := DateToTime(Date{2024, time.May, 1}); err != nil || !got.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("DateToTime() = %v, %v", got, err)
+	}
+	if _, err := DateToTime(Date{}); err == nil {
+		t.Error("DateToTime(Date{}) error = nil")
+	}
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	if got, _ := TimeToDate(time.Date(2024, 4, 30, 20, 0, 0, 0, time.UTC).In(loc)); got != (Date{2024, time.May, 1}) {
+		t.Errorf("TimeToDate() = %v, want 2024-05-01", got)
+	}
+	if got, err := StringToTimeDateOnly("2024-05-01"); err != nil || !got.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("StringToTimeDateOnly() = %v, %v", got, err)
+	}
+	if _, err := StringToTimeDateOnly("2024-05-01 10:00:00"); err == nil {
+		t.Error("StringToTimeDateOnly() with a time error = nil")
+	}
+}
+
+func TestStringToTimeOfDay(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"seconds", "15:04:05", 15*time.Hour + 4*time.Minute + 5*time.Second, false},
+		{"minutes", "09:30", 9*time.Hour + 30*time.Minute, false},
+		{"fraction", "00:00:00.25", 250 * time.Millisecond, false},
+		{"lastSecond", "23:59:59", 24*time.Hour - time.Second, false},
+		{"hour24", "24:00:00", 0, true},
+		{"leapSecond", "23:59:60", 0, true},
+		{"singleDigitHour", "9:30:00", 0, true},
+		{"date", "2024-05-01", 0, true},
+		{"empty", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToTimeOfDay(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToTimeOfDay() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToTimeOfDay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := TimeOfDayToString(15*time.Hour + 4*time.Minute + 5*time.Second + 500*time.Millisecond); err != nil || got != "15:04:05.5" {
+		t.Errorf("TimeOfDayToString() = %q, %v", got, err)
+	}
+	if _, err := TimeOfDayToString(24 * time.Hour); err == nil {
+		t.Error("TimeOfDayToString(24h) error = nil")
+	}
+}
+
+func TestStringToTimeOrder(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name    string
+		input   string
+		order   DateOrder
+		pivot   int
+		want    time.Time
+		wantErr bool
+	}{
+		{"mdy", "03/04/05", MDY, 0, date(2005, 3, 4), false},
+		{"dmy", "03/04/05", DMY, 0, date(2005, 4, 3), false},
+		{"ymd", "03/04/05", YMD, 0, date(2003, 4, 5), false},
+		{"dots", "3.4.2005", DMY, 0, date(2005, 4, 3), false},
+		{"dashes", "04-03-2005", MDY, 0, date(2005, 4, 3), false},
+		{"clock", "03/04/2005 15:04:05", DMY, 0, date(2005, 4, 3).Add(15*time.Hour + 4*time.Minute + 5*time.Second), false},
+		{"defaultPivotLow", "01/01/68", MDY, 0, date(2068, 1, 1), false},
+		{"defaultPivotHigh", "01/01/69", MDY, 0, date(1969, 1, 1), false},
+		{"pivotLow", "01/01/49", MDY, 1950, date(2049, 1, 1), false},
+		{"pivotHigh", "01/01/50", MDY, 1950, date(1950, 1, 1), false},
+		{"iso", "2005-04-03", DMY, 0, date(2005, 4, 3), false},
+		{"rfc3339", "2005-04-03T10:00:00Z", MDY, 0, date(2005, 4, 3).Add(10 * time.Hour), false},
+		{"invalidDay", "31/02/2005", DMY, 0, time.Time{}, true},
+		{"invalidMonth", "13/01/2005", MDY, 0, time.Time{}, true},
+		{"threeDigitYear", "01/01/205", MDY, 0, time.Time{}, true},
+		{"mixedSeparators", "01/01-2005", MDY, 0, time.Time{}, true},
+		{"noOrder", "03/04/05", DateOrderReject, 0, time.Time{}, true},
+		{"badClock", "03/04/05 25:00", MDY, 0, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToTimeOrder(tt.input, tt.order, tt.pivot)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StringToTimeOrder(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("StringToTimeOrder(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateOrderOption(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	if _, err := TryIntoTime("03/04/05"); err == nil {
+		t.Error("TryIntoTime(\"03/04/05\") without DateOrder error = nil")
+	}
+
+	SetDefaults(Options{DateOrder: DMY, YearPivot: 1950})
+	got, err := TryIntoTime("03/04/49")
+	if err != nil || !got.Equal(time.Date(2049, 4, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("TryIntoTime(\"03/04/49\") = %v, %v", got, err)
+	}
+	got, err = TryIntoTime("2005-04-03")
+	if err != nil || !got.Equal(time.Date(2005, 4, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("TryIntoTime(\"2005-04-03\") = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,93 @@
+package into
+
+import (
+	"math"
+	"reflect"
+)
+
+// Clamp converts a numeric value to T, saturating at the bounds of T.
+//
+// Clamp converts a numeric value to T like TryInto with the Saturate
+// overflow policy, but never fails: values above the maximum of T become
+// the maximum, values below the minimum become the minimum, and fractions
+// are truncated toward zero. NaN becomes 0 for integer types and stays NaN
+// for float types.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - T: the converted value, clamped to the range of T.
+//
+// Example:
+//
+//	fmt.Println(Clamp[uint8](300), Clamp[uint8](-5), Clamp[int8](3.9)) // Output: 255 0 3
+func Clamp[T Int | Uint | Float, U Int | Uint | Float](value U) T {
+	var result T
+	dst := reflect.ValueOf(&result).Elem()
+	src := reflect.ValueOf(value)
+	kind := dst.Kind()
+
+	switch {
+	case fitsKind(kind, src):
+		dst.Set(src.Convert(dst.Type()))
+	case src.CanFloat() && math.IsNaN(src.Float()):
+		// Only integer kinds reject NaN; leave the result zero.
+	case isNegative(src):
+		dst.Set(reflect.ValueOf(kindBounds[kind][0]).Convert(dst.Type()))
+	default:
+		dst.Set(reflect.ValueOf(kindBounds[kind][1]).Convert(dst.Type()))
+	}
+	return result
+}
+
+// Normalize maps a value from one numeric range to another.
+//
+// Normalize maps value linearly from the range [fromMin, fromMax] to the
+// range [toMin, toMax], so fromMin becomes toMin and fromMax becomes toMax,
+// and clamps the result to the target range. Either range may be reversed.
+// The value is computed in float64 and rounded to the nearest integer, with
+// halves away from zero, when T is an integer type. If fromMin equals
+// fromMax, the result is toMin, and a NaN value is handled like Clamp.
+//
+// Parameters:
+//   - value: the value to be mapped.
+//   - fromMin: the value mapped to toMin.
+//   - fromMax: the value mapped to toMax.
+//   - toMin: the lower end of the target range.
+//   - toMax: the upper end of the target range.
+//
+// Returns:
+//   - T: the mapped value, within the target range.
+//
+// Example:
+//
+//	fmt.Println(Normalize[uint8](0.5, 0.0, 1.0, 0, 255))    // Output: 128
+//	fmt.Println(Normalize[float64](-40, -40, 60, -1.0, 1.0)) // Output: -1
+func Normalize[T Int | Uint | Float, U Int | Uint | Float](value, fromMin, fromMax U, toMin, toMax T) T {
+	if fromMin == fromMax {
+		return toMin
+	}
+	t := (float64(value) - float64(fromMin)) / (float64(fromMax) - float64(fromMin))
+	lo, hi := float64(toMin), float64(toMax)
+	f := lo + t*(hi-lo)
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	f = math.Max(lo, math.Min(hi, f))
+	if isIntegerKind(reflect.TypeOf(toMin).Kind()) {
+		f = math.Round(f)
+	}
+	return Clamp[T](f)
+}
+
+// isNegative reports whether the numeric value rv is less than zero.
+func isNegative(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() < 0
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package into_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"uint8 above", Clamp[uint8](300), uint8(255)},
+		{"uint8 below", Clamp[uint8](-5), uint8(0)},
+		{"uint8 in range", Clamp[uint8](42), uint8(42)},
+		{"int8 fraction", Clamp[int8](3.9), int8(3)},
+		{"int8 negative fraction", Clamp[int8](-128.9), int8(-128)},
+		{"int8 below", Clamp[int8](-129.0), int8(-128)},
+		{"int64 from uint64 max", Clamp[int64](uint64(math.MaxUint64)), int64(math.MaxInt64)},
+		{"uint64 from +Inf", Clamp[uint64](math.Inf(1)), uint64(math.MaxUint64)},
+		{"int32 from -Inf", Clamp[int32](math.Inf(-1)), int32(math.MinInt32)},
+		{"int from NaN", Clamp[int](math.NaN()), 0},
+		{"float32 above", Clamp[float32](math.MaxFloat64), float32(math.MaxFloat32)},
+		{"float32 below", Clamp[float32](-math.MaxFloat64), float32(-math.MaxFloat32)},
+		{"named type", Clamp[Degrees](int8(-90)), Degrees(-90)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Clamp = %v (%T), want %v (%T)", tt.got, tt.got, tt.want, tt.want)
+			}
+		})
+	}
+
+	if f := Clamp[float64](float32(math.NaN())); !math.IsNaN(f) {
+		t.Errorf("Clamp[float64](NaN) = %v, want NaN", f)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"unit to byte", Normalize[uint8](0.5, 0.0, 1.0, 0, 255), uint8(128)},
+		{"unit to byte max", Normalize[uint8](1.0, 0.0, 1.0, 0, 255), uint8(255)},
+		{"above range", Normalize[uint8](2.0, 0.0, 1.0, 0, 255), uint8(255)},
+		{"below range", Normalize[int8](-1, 0, 10, -100, 100), int8(-100)},
+		{"byte to unit", Normalize[float64](uint8(51), 0, 255, 0.0, 1.0), 0.2},
+		{"reversed source", Normalize[float64](0, 10, 0, 0.0, 1.0), 1.0},
+		{"reversed target", Normalize[int](25, 0, 100, 100, 0), 75},
+		{"temperature", Normalize[float64](-40, -40, 60, -1.0, 1.0), -1.0},
+		{"empty source range", Normalize[int](5, 3, 3, 7, 9), 7},
+		{"NaN to integer", Normalize[int](math.NaN(), 0.0, 1.0, 0, 10), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Normalize = %v (%T), want %v (%T)", tt.got, tt.got, tt.want, tt.want)
+			}
+		})
+	}
+}
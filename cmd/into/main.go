@@ -0,0 +1,298 @@
+// Command into converts values from the shell with the into library.
+//
+// into shows exactly how the library treats a given input, such as the
+// rounding of a float or the bounds of an integer type, before the
+// conversion is written in code.
+//
+// Usage:
+//
+//	into -to type [-from type] [-round mode] [-overflow mode] [-nan mode] [-trim] [-weak] [-strict] value...
+//
+// For example:
+//
+//	$ into -to int32 123.9 -round floor
+//	123
+//	$ into -to int8 300
+//	into: 300: cannot convert int64 300 to int8: value exceeds the maximum of the target type [-128, 127]
+//
+// Flags may appear before or after the values. Each value is first read as
+// the -from type, which is auto by default: integer literals are read as
+// int64 (or uint64 if they are too large), other numbers as float64, and
+// anything else as a string. The result is then converted to the -to type
+// with into.TryIntoWith and printed on its own line. The types are bool,
+// int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64,
+// float32, float64, string, duration and time. A time is printed in
+// RFC 3339 format in UTC, whatever the local time zone.
+//
+// into exits with status 1 if a conversion fails and 2 if the arguments are
+// invalid.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zenless-lab/into"
+)
+
+// converter converts a value read from the command line to a type.
+type converter func(value any, opts into.Options) (any, error)
+
+// converters maps the type names accepted by -to and -from to their
+// converters.
+var converters = map[string]converter{
+	"bool":     convert[bool],
+	"int":      convert[int],
+	"int8":     convert[int8],
+	"int16":    convert[int16],
+	"int32":    convert[int32],
+	"int64":    convert[int64],
+	"uint":     convert[uint],
+	"uint8":    convert[uint8],
+	"uint16":   convert[uint16],
+	"uint32":   convert[uint32],
+	"uint64":   convert[uint64],
+	"float32":  convert[float32],
+	"float64":  convert[float64],
+	"string":   convert[string],
+	"duration": convert[time.Duration],
+	"time":     convert[time.Time],
+}
+
+// convert converts value, which holds one of the types of converters, to T
+// with opts.
+func convert[T bool | int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | string | time.Duration | time.Time](value any, opts into.Options) (any, error) {
+	switch v := value.(type) {
+	case bool:
+		return into.TryIntoWith[T](v, opts)
+	case int:
+		return into.TryIntoWith[T](v, opts)
+	case int8:
+		return into.TryIntoWith[T](v, opts)
+	case int16:
+		return into.TryIntoWith[T](v, opts)
+	case int32:
+		return into.TryIntoWith[T](v, opts)
+	case int64:
+		return into.TryIntoWith[T](v, opts)
+	case uint:
+		return into.TryIntoWith[T](v, opts)
+	case uint8:
+		return into.TryIntoWith[T](v, opts)
+	case uint16:
+		return into.TryIntoWith[T](v, opts)
+	case uint32:
+		return into.TryIntoWith[T](v, opts)
+	case uint64:
+		return into.TryIntoWith[T](v, opts)
+	case float32:
+		return into.TryIntoWith[T](v, opts)
+	case float64:
+		return into.TryIntoWith[T](v, opts)
+	case string:
+		return into.TryIntoWith[T](v, opts)
+	case time.Duration:
+		return into.TryIntoWith[T](v, opts)
+	case time.Time:
+		return into.TryIntoWith[T](v, opts)
+	default:
+		return nil, fmt.Errorf("unsupported source type %T", value)
+	}
+}
+
+// roundings, overflows and nans map the modes accepted by the -round,
+// -overflow and -nan flags to their options.
+var (
+	roundings = map[string]into.Rounding{
+		"truncate": into.Truncate,
+		"halfup":   into.HalfUp,
+		"halfeven": into.HalfEven,
+		"floor":    into.Floor,
+		"ceil":     into.Ceil,
+		"exact":    into.Exact,
+	}
+	overflows = map[string]into.Overflow{
+		"reject":   into.OverflowReject,
+		"saturate": into.Saturate,
+	}
+	nans = map[string]into.NaN{
+		"reject": into.NaNReject,
+		"zero":   into.Zero,
+	}
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command with args and returns its exit status.
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("into", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	to := fs.String("to", "", "target type; must be set")
+	from := fs.String("from", "auto", "source type the values are read as")
+	round := fs.String("round", "truncate", "rounding of floats to integers: "+names(roundings))
+	overflow := fs.String("overflow", "reject", "handling of out-of-range values: "+names(overflows))
+	nan := fs.String("nan", "reject", "handling of NaN: "+names(nans))
+	trim := fs.Bool("trim", false, "trim white space around values")
+	weak := fs.Bool("weak", false, "coerce values like mapstructure's WeaklyTypedInput")
+	strict := fs.Bool("strict", false, "reject implicit conversions between bools and numbers")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: into -to type [flags] value...\n")
+		fmt.Fprintf(stderr, "Types: %s\n", names(converters))
+		fs.PrintDefaults()
+	}
+
+	values, err := parseInterspersed(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if *to == "" || len(values) == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	toConv, ok := converters[*to]
+	if !ok {
+		return usageError(stderr, "unknown type %q", *to)
+	}
+	var fromConv converter
+	if *from != "auto" {
+		if fromConv, ok = converters[*from]; !ok {
+			return usageError(stderr, "unknown type %q", *from)
+		}
+	}
+	opts := into.Options{TrimSpace: *trim, Weak: *weak, Strict: *strict}
+	if opts.Rounding, ok = roundings[*round]; !ok {
+		return usageError(stderr, "unknown rounding %q", *round)
+	}
+	if opts.Overflow, ok = overflows[*overflow]; !ok {
+		return usageError(stderr, "unknown overflow mode %q", *overflow)
+	}
+	if opts.NaN, ok = nans[*nan]; !ok {
+		return usageError(stderr, "unknown NaN mode %q", *nan)
+	}
+
+	status := 0
+	for _, s := range values {
+		var value any = s
+		if fromConv == nil {
+			value = autoValue(s)
+		} else if value, err = fromConv(s, opts); err != nil {
+			fmt.Fprintf(stderr, "into: %s: %v\n", s, err)
+			status = 1
+			continue
+		}
+
+		result, err := toConv(value, opts)
+		if err != nil {
+			fmt.Fprintf(stderr, "into: %s: %v\n", s, err)
+			status = 1
+			continue
+		}
+		fmt.Fprintln(stdout, format(result))
+	}
+	return status
+}
+
+// parseInterspersed parses the flags in args, which may appear before,
+// between or after the values, and returns the values. Negative numbers
+// such as -1 are values, not flags, and the arguments after -- are values.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var flags, values []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			values = append(values, args[i+1:]...)
+			i = len(args)
+		case len(arg) < 2 || arg[0] != '-' || isNegativeNumber(arg):
+			values = append(values, arg)
+		default:
+			flags = append(flags, arg)
+			name := strings.TrimLeft(arg, "-")
+			if strings.Contains(name, "=") {
+				continue
+			}
+			if f := fs.Lookup(name); f != nil && !isBoolFlag(f) && i+1 < len(args) {
+				i++
+				flags = append(flags, args[i])
+			}
+		}
+	}
+	return values, fs.Parse(flags)
+}
+
+// isBoolFlag reports whether f is a boolean flag, which takes no value.
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// isNegativeNumber reports whether s is a negative number such as -1 or
+// -2.5e3.
+func isNegativeNumber(s string) bool {
+	if !strings.HasPrefix(s, "-") {
+		return false
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+// autoValue reads s as an int64, a uint64 or a float64 if it is a number
+// literal, and returns it as is otherwise.
+func autoValue(s string) any {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v
+	}
+	if v, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return v
+	}
+	if v, err := strconv.ParseFloat(s, 64); err == nil && !strings.ContainsAny(s, "xXpP_") {
+		return v
+	}
+	return s
+}
+
+// format returns the text printed for a converted value.
+func format(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// names returns the sorted keys of m separated by commas.
+func names[V any](m map[string]V) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
+
+// usageError prints an error about the arguments and returns the exit
+// status 2.
+func usageError(stderr io.Writer, msg string, args ...any) int {
+	fmt.Fprintf(stderr, "into: "+msg+"\n", args...)
+	return 2
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantOut    string
+		wantErr    string
+		wantStatus int
+	}{
+		{"floor", []string{"-to", "int32", "123.9", "-round", "floor"}, "123\n", "", 0},
+		{"truncateNegative", []string{"-to", "int32", "-123.9"}, "-123\n", "", 0},
+		{"overflow", []string{"-to", "int8", "300"}, "", "exceeds the maximum", 1},
+		{"saturate", []string{"-to", "int8", "-overflow", "saturate", "300", "-300"}, "127\n-128\n", "", 0},
+		{"bigUint", []string{"-to", "uint64", "18446744073709551615"}, "18446744073709551615\n", "", 0},
+		{"string", []string{"-to", "string", "0.1"}, "\"0.1\"\n", "", 0},
+		{"fromString", []string{"-from", "string", "-to", "float64", "1e3"}, "1000\n", "", 0},
+		{"fromFloat32", []string{"-from", "float32", "-to", "float64", "0.1"}, "0.10000000149011612\n", "", 0},
+		{"duration", []string{"-to", "duration", "1m30s"}, "1m30s\n", "", 0},
+		{"time", []string{"-to", "time", "0"}, "1970-01-01T00:00:00Z\n", "", 0},
+		{"timeOffset", []string{"-to", "time", "2024-01-02T10:00:00+05:30"}, "2024-01-02T04:30:00Z\n", "", 0},
+		{"weak", []string{"-weak", "-to", "int", "true"}, "1\n", "", 0},
+		{"strict", []string{"-strict", "-to", "bool", "1"}, "", "implicit conversion", 1},
+		{"terminator", []string{"-to", "int", "--", "-5", "-x"}, "-5\n", "invalid syntax", 1},
+		{"partialFailure", []string{"-to", "uint8", "1", "x", "2"}, "1\n2\n", "into: x:", 1},
+		{"missingTo", []string{"1"}, "", "Usage", 2},
+		{"missingValue", []string{"-to", "int"}, "", "Usage", 2},
+		{"unknownType", []string{"-to", "complex", "1"}, "", "unknown type", 2},
+		{"unknownRounding", []string{"-to", "int", "-round", "up", "1"}, "", "unknown rounding", 2},
+		{"unknownFlag", []string{"-to", "int", "-bogus", "1"}, "", "not defined", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			status := run(tt.args, &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run() = %d, want %d (stderr %q)", status, tt.wantStatus, stderr.String())
+			}
+			if stdout.String() != tt.wantOut {
+				t.Errorf("run() stdout = %q, want %q", stdout.String(), tt.wantOut)
+			}
+			if !strings.Contains(stderr.String(), tt.wantErr) {
+				t.Errorf("run() stderr = %q, want it to contain %q", stderr.String(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAutoValue(t *testing.T) {
+	tests := []struct {
+		input string
+		want  any
+	}{
+		{"42", int64(42)},
+		{"-42", int64(-42)},
+		{"18446744073709551615", uint64(18446744073709551615)},
+		{"2.5", 2.5},
+		{"1e3", 1000.0},
+		{"0x10", "0x10"},
+		{"1_000", "1_000"},
+		{"true", "true"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := autoValue(tt.input); got != tt.want {
+				t.Errorf("autoValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// Command intogen generates direct converters for named types.
+//
+// intogen generates the XxxToYyy direct functions between named types with
+// a basic underlying type (e.g. type Celsius float64 or type UserID int64)
+// and the basic types supported by into, and registers them with
+// into.Register so the generic functions use them as well.
+//
+// Usage:
+//
+//	intogen -type Celsius,UserID [-output file] [dir]
+//
+// It is typically run with go generate:
+//
+//	//go:generate go run github.com/zenless-lab/into/cmd/intogen -type Celsius
+//
+// For each named type N with underlying type B, intogen generates NToX and
+// XToN for every basic type X, delegating to the into.BToX and into.XToB
+// converters, so the range checks are the same as for B itself. The
+// conversions that cannot fail delegate to into.BIntoX and into.XIntoB.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/zenless-lab/into/internal/basictypes"
+)
+
+// basicTypes lists the basic types supported by into in the order in which
+// converters are generated.
+var basicTypes = basictypes.Types
+
+// aliases maps the predeclared aliases to the type they stand for.
+var aliases = map[string]string{"byte": "uint8", "rune": "int32"}
+
+// namedType is a named type with a basic underlying type.
+type namedType struct {
+	// Name is the name of the type.
+	Name string
+	// Underlying is the name used in function names for the underlying type.
+	Underlying string
+}
+
+// To returns the name of the converter from the type to the basic type.
+func (t namedType) To(basic string) string {
+	return t.Name + "To" + basic
+}
+
+// From returns the name of the converter from the basic type to the type.
+// Converters of unexported types are unexported as well.
+func (t namedType) From(basic string) string {
+	if ast.IsExported(t.Name) {
+		return basic + "To" + t.Name
+	}
+	return lowerFirst(basic) + "To" + string(unicode.ToUpper(rune(t.Name[0]))) + t.Name[1:]
+}
+
+// infallible reports whether the conversion between the basic types named
+// from and to cannot fail, so that into has an XxxIntoYyy converter for it.
+func infallible(from, to string) bool {
+	var f, t basictypes.Type
+	for _, b := range basicTypes {
+		switch b.Name {
+		case from:
+			f = b
+		case to:
+			t = b
+		}
+	}
+	return from != to && f.Infallible(t)
+}
+
+// article returns the indefinite article for a value of the type named
+// name, such as "an" for int64 or Age and "a" for uint8 or Celsius.
+func article(name string) string {
+	if strings.ContainsRune("aeioAEIO", rune(name[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// lowerFirst returns s with its first letter in lower case.
+func lowerFirst(s string) string {
+	return string(unicode.ToLower(rune(s[0]))) + s[1:]
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("intogen: ")
+
+	typeNames := flag.String("type", "", "comma-separated list of type names; must be set")
+	output := flag.String("output", "", "output file name; default <type>_into.go")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: intogen -type T[,T...] [-output file] [dir]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	names := strings.Split(*typeNames, ",")
+	pkg, types, err := loadTypes(dir, names)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkg, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *output == "" {
+		*output = strings.ToLower(names[0]) + "_into.go"
+	}
+	if err := os.WriteFile(filepath.Join(dir, *output), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadTypes finds the named types in the Go package in dir.
+//
+// loadTypes parses the non-test Go files in dir and resolves the underlying
+// basic type of each name, following local type definitions such as
+// type Celsius Temperature.
+//
+// Parameters:
+//   - dir: the directory of the package.
+//   - names: the names of the types.
+//
+// Returns:
+//   - string: the name of the package.
+//   - []namedType: the types, in the order of names.
+//   - error: an error if the package cannot be parsed or a type is not
+//     defined with a basic underlying type.
+func loadTypes(dir string, names []string) (string, []namedType, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var pkg string
+	specs := make(map[string]*ast.TypeSpec)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return "", nil, err
+		}
+		pkg = f.Name.Name
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				spec := spec.(*ast.TypeSpec)
+				specs[spec.Name.Name] = spec
+			}
+		}
+	}
+	if pkg == "" {
+		return "", nil, fmt.Errorf("no Go files in %s", dir)
+	}
+
+	types := make([]namedType, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		underlying, err := resolve(specs, name)
+		if err != nil {
+			return "", nil, err
+		}
+		types = append(types, namedType{Name: name, Underlying: underlying})
+	}
+	return pkg, types, nil
+}
+
+// resolve returns the function name of the basic underlying type of name.
+func resolve(specs map[string]*ast.TypeSpec, name string) (string, error) {
+	seen := make(map[string]bool)
+	for typ := name; ; {
+		spec, ok := specs[typ]
+		if !ok {
+			return "", fmt.Errorf("type %s not found", typ)
+		}
+		if spec.Assign.IsValid() {
+			return "", fmt.Errorf("type %s is an alias", typ)
+		}
+		ident, ok := spec.Type.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("type %s does not have a basic underlying type", name)
+		}
+
+		underlying := ident.Name
+		if alias, ok := aliases[underlying]; ok {
+			underlying = alias
+		}
+		for _, basic := range basicTypes {
+			if basic.Type == underlying {
+				return basic.Name, nil
+			}
+		}
+
+		seen[typ] = true
+		if typ = ident.Name; seen[typ] {
+			return "", fmt.Errorf("type %s has a cyclic definition", name)
+		}
+	}
+}
+
+// generate returns the formatted source of the converters for types.
+func generate(pkg string, types []namedType) ([]byte, error) {
+	var buf bytes.Buffer
+	err := converterTemplate.Execute(&buf, struct {
+		Package string
+		Types   []namedType
+		Basics  []basictypes.Type
+	}{pkg, types, basicTypes})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+var converterTemplate = template.Must(template.New("converters").Funcs(template.FuncMap{
+	"article":    article,
+	"lower":      lowerFirst,
+	"infallible": infallible,
+}).Parse(`// Code generated by intogen; DO NOT EDIT.
+
+package {{.Package}}
+
+import "github.com/zenless-lab/into"
+{{range $t := .Types}}{{range $.Basics}}
+// {{$t.To .Name}} converts {{article $t.Name}} {{$t.Name}} value to {{.Type}}.
+func {{$t.To .Name}}(value {{$t.Name}}) ({{.Type}}, error) {
+{{- if infallible $t.Underlying .Name}}
+	return into.{{$t.Underlying}}Into{{.Name}}({{lower $t.Underlying}}(value)), nil
+{{- else}}
+	return into.{{$t.Underlying}}To{{.Name}}({{lower $t.Underlying}}(value))
+{{- end}}
+}
+
+// {{$t.From .Name}} converts {{article .Type}} {{.Type}} value to {{$t.Name}}.
+func {{$t.From .Name}}(value {{.Type}}) ({{$t.Name}}, error) {
+{{- if infallible .Name $t.Underlying}}
+	return {{$t.Name}}(into.{{.Name}}Into{{$t.Underlying}}(value)), nil
+{{- else}}
+	result, err := into.{{.Name}}To{{$t.Underlying}}(value)
+	return {{$t.Name}}(result), err
+{{- end}}
+}
+{{end}}{{end}}
+func init() {
+{{- range $t := .Types}}{{range $.Basics}}
+	into.Register({{$t.To .Name}})
+	into.Register({{$t.From .Name}})
+{{- end}}{{end}}
+}
+`))
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadTypes(t *testing.T) {
+	dir := t.TempDir()
+	src := `package temp
+
+type Temperature float64
+type Celsius Temperature
+type userID int64
+type Code byte
+type Alias = int
+type Point struct{ X, Y int }
+`
+	if err := os.WriteFile(filepath.Join(dir, "temp.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{"Celsius", "Float64", false},
+		{"userID", "Int64", false},
+		{"Code", "Uint8", false},
+		{"Alias", "", true},
+		{"Point", "", true},
+		{"Missing", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg, types, err := loadTypes(dir, []string{tt.name})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("loadTypes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if pkg != "temp" || types[0].Underlying != tt.want {
+				t.Errorf("loadTypes() = %v, %v, want temp, %v", pkg, types[0].Underlying, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := generate("temp", []namedType{{"Celsius", "Float64"}, {"userID", "Int64"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", src, 0); err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+
+	for _, want := range []string{
+		"func CelsiusToInt8(value Celsius) (int8, error) {\n\treturn into.Float64ToInt8(float64(value))",
+		"func CelsiusToString(value Celsius) (string, error) {\n\treturn into.Float64IntoString(float64(value)), nil",
+		"func Int32ToCelsius(value int32) (Celsius, error) {\n\treturn Celsius(into.Int32IntoFloat64(value)), nil",
+		"func StringToCelsius(value string) (Celsius, error) {\n\tresult, err := into.StringToFloat64(value)",
+		"func userIDToUint(value userID) (uint, error) {",
+		"func boolToUserID(value bool) (userID, error) {",
+		"// CelsiusToInt8 converts a Celsius value to int8.",
+		"// Int64ToCelsius converts an int64 value to Celsius.",
+		"// Uint8ToCelsius converts a uint8 value to Celsius.",
+		"into.Register(CelsiusToBool)",
+		"into.Register(stringToUserID)",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	if strings.Contains(string(src), " a int") {
+		t.Error("generated code uses \"a\" before an int type")
+	}
+}
+
+func TestArticle(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"int", "an"},
+		{"int64", "an"},
+		{"uint8", "a"},
+		{"float32", "a"},
+		{"Age", "an"},
+		{"Celsius", "a"},
+		{"userID", "a"},
+	}
+	for _, tt := range tests {
+		if got := article(tt.name); got != tt.want {
+			t.Errorf("article(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestGenerateCompiles checks that the generated code compiles against the
+// module and calls no deprecated converter.
+func TestGenerateCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping build in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := generate("temp", []namedType{{"Celsius", "Float64"}, {"userID", "Int64"}, {"Flag", "Bool"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range deprecated(t, root) {
+		if strings.Contains(string(src), "into."+name+"(") {
+			t.Errorf("generated code calls the deprecated into.%s", name)
+		}
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":       "module temp\n\ngo 1.18\n\nrequire github.com/zenless-lab/into v0.0.0\n\nreplace github.com/zenless-lab/into => " + root + "\n",
+		"temp.go":      "package temp\n\ntype Celsius float64\ntype userID int64\ntype Flag bool\n",
+		"temp_into.go": string(src),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(goTool, "vet", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generated code does not compile: %v\n%s", err, out)
+	}
+}
+
+// deprecated returns the names of the deprecated functions of the package
+// in dir.
+func deprecated(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Doc != nil && strings.Contains(fn.Doc.Text(), "\nDeprecated: ") {
+				names = append(names, fn.Name.Name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		t.Fatal("no deprecated functions found")
+	}
+	return names
+}
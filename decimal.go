@@ -0,0 +1,139 @@
+package into
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+// StringToInt64Decimal converts a decimal number string to int64.
+//
+// StringToInt64Decimal converts a string such as "+42", "1e3" or "2.5e1" to
+// int64. Unlike StringToInt64, it accepts any number syntax of
+// StringToFloat64, including a leading plus sign, a fraction and an exponent.
+// The parsing is exact, so the value must be an integer: "1.5e0" is
+// rejected instead of being rounded.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - int64: the converted int64 value.
+//   - error: ErrLossOfPrecision if the value has a fractional part, a
+//     *ConversionError wrapping ErrOverflow or ErrUnderflow if it is out of
+//     the int64 range, or an error if the string is not a number.
+//
+// Example:
+//
+//	result, err := StringToInt64Decimal("1e3")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1000
+func StringToInt64Decimal(value string) (int64, error) {
+	r, err := parseDecimal(value, "int64")
+	if err != nil {
+		return 0, err
+	}
+	if !r.IsInt() {
+		return 0, ErrLossOfPrecision
+	}
+	if !r.Num().IsInt64() {
+		if r.Sign() < 0 {
+			return 0, underflowError(value, "int64")
+		}
+		return 0, overflowError(value, "int64")
+	}
+	return r.Num().Int64(), nil
+}
+
+// StringToUint64Decimal converts a decimal number string to uint64.
+//
+// StringToUint64Decimal converts a string such as "+42" or "1e3" to uint64,
+// accepting the same syntax as StringToInt64Decimal. The value must be a
+// non-negative integer.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - uint64: the converted uint64 value.
+//   - error: ErrLossOfPrecision if the value has a fractional part, a
+//     *ConversionError wrapping ErrOverflow or ErrUnderflow if it is out of
+//     the uint64 range, or an error if the string is not a number.
+//
+// Example:
+//
+//	result, err := StringToUint64Decimal("+4.2e1")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 42
+func StringToUint64Decimal(value string) (uint64, error) {
+	r, err := parseDecimal(value, "uint64")
+	if err != nil {
+		return 0, err
+	}
+	if !r.IsInt() {
+		return 0, ErrLossOfPrecision
+	}
+	if r.Sign() < 0 {
+		return 0, underflowError(value, "uint64")
+	}
+	if !r.Num().IsUint64() {
+		return 0, overflowError(value, "uint64")
+	}
+	return r.Num().Uint64(), nil
+}
+
+// parseDecimal parses a number string into an exact rational.
+//
+// ParseFloat validates the syntax and bounds the exponent before the exact
+// parse, as in parseUnits, so inputs such as "1e999999999" are rejected
+// cheaply. Infinities and NaN are rejected, as they are not numbers.
+//
+// Parameters:
+//   - value: the string value to be parsed.
+//   - to: the name of the target type, used in errors.
+//
+// Returns:
+//   - *big.Rat: the parsed value.
+//   - error: an error if the string is not a finite number.
+func parseDecimal(value string, to string) (*big.Rat, error) {
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return nil, parseError(err, value, to)
+	}
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, &ConversionError{From: "string", To: to, Value: value, Err: strconv.ErrSyntax}
+	}
+	return r, nil
+}
+
+// decimalValue converts a decimal number string to an integer for toKindWith.
+//
+// decimalValue returns an int64 or uint64 when the value fits, and a float64
+// beyond that range, including an infinity beyond the float64 range, so the
+// overflow policy applies to the result as it does to numeric sources.
+// Values with a fractional part are rejected with ErrLossOfPrecision
+// regardless of the rounding policy.
+func decimalValue(value string, to string) (any, error) {
+	if f, err := strconv.ParseFloat(value, 64); errors.Is(err, strconv.ErrRange) {
+		return f, nil
+	}
+	r, err := parseDecimal(value, to)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsInt() {
+		return nil, ErrLossOfPrecision
+	}
+	if r.Num().IsInt64() {
+		return r.Num().Int64(), nil
+	}
+	if r.Num().IsUint64() {
+		return r.Num().Uint64(), nil
+	}
+	f, _ := r.Float64()
+	return f, nil
+}
@@ -0,0 +1,115 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToInt64Decimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    int64
+		wantErr error
+	}{
+		{"plain", "42", 42, nil},
+		{"plus", "+42", 42, nil},
+		{"exponent", "1e3", 1000, nil},
+		{"fractionExponent", "2.5e1", 25, nil},
+		{"negativeExponent", "-1200e-2", -12, nil},
+		{"trailingZeros", "7.000", 7, nil},
+		{"max", "9223372036854775807", math.MaxInt64, nil},
+		{"fraction", "1.5e0", 0, ErrLossOfPrecision},
+		{"tiny", "1e-400", 0, ErrLossOfPrecision},
+		{"overflow", "9.3e18", 0, ErrOverflow},
+		{"underflow", "-1e19", 0, ErrUnderflow},
+		{"hugeExponent", "1e999999999", 0, ErrOverflow},
+		{"inf", "Inf", 0, strconv.ErrSyntax},
+		{"syntax", "1e", 0, strconv.ErrSyntax},
+		{"ratio", "1/2", 0, strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToInt64Decimal(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("StringToInt64Decimal(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("StringToInt64Decimal(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringToUint64Decimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    uint64
+		wantErr error
+	}{
+		{"plus", "+4.2e1", 42, nil},
+		{"max", "18446744073709551615", math.MaxUint64, nil},
+		{"negativeZero", "-0", 0, nil},
+		{"negative", "-1e0", 0, ErrUnderflow},
+		{"overflow", "2e19", 0, ErrOverflow},
+		{"fraction", "0.5", 0, ErrLossOfPrecision},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToUint64Decimal(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("StringToUint64Decimal(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("StringToUint64Decimal(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalIntegers(t *testing.T) {
+	decimal := Options{DecimalIntegers: true}
+	tests := []struct {
+		name    string
+		input   string
+		opts    Options
+		want    int16
+		wantErr error
+	}{
+		{"plainRejectsExponent", "1e3", Options{}, 0, strconv.ErrSyntax},
+		{"exponent", "1e3", decimal, 1000, nil},
+		{"plus", "+42", decimal, 42, nil},
+		{"fraction", "1.5e0", decimal, 0, ErrLossOfPrecision},
+		{"fractionRounded", "1.5e0", Options{DecimalIntegers: true, Rounding: HalfUp}, 0, ErrLossOfPrecision},
+		{"overflow", "1e5", decimal, 0, ErrOverflow},
+		{"saturate", "1e5", Options{DecimalIntegers: true, Overflow: Saturate}, math.MaxInt16, nil},
+		{"saturateInf", "-1e400", Options{DecimalIntegers: true, Overflow: Saturate}, math.MinInt16, nil},
+		{"trimmed", " +1e2 ", Options{DecimalIntegers: true, TrimSpace: true}, 100, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryIntoWith[int16](tt.input, tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TryIntoWith[int16](%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoWith[int16](%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if got, err := TryIntoWith[uint8]("+2e2", decimal); err != nil || got != 200 {
+		t.Errorf("TryIntoWith[uint8](\"+2e2\") = %v, %v, want 200", got, err)
+	}
+	if got, err := TryIntoWith[float64]("1.5e0", decimal); err != nil || got != 1.5 {
+		t.Errorf("TryIntoWith[float64](\"1.5e0\") = %v, %v, want 1.5", got, err)
+	}
+}
@@ -0,0 +1,1359 @@
+// Code generated by dispatchgen; DO NOT EDIT.
+
+package into_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+// The named types of the basic types, which the dispatchers accept through
+// the ~ terms of their constraints.
+type (
+	dispatchBool    bool
+	dispatchInt     int
+	dispatchInt8    int8
+	dispatchInt16   int16
+	dispatchInt32   int32
+	dispatchInt64   int64
+	dispatchUint    uint
+	dispatchUint8   uint8
+	dispatchUint16  uint16
+	dispatchUint32  uint32
+	dispatchUint64  uint64
+	dispatchFloat32 float32
+	dispatchFloat64 float64
+	dispatchString  string
+)
+
+var dispatchCalls = []struct {
+	name string
+	call func() error
+}{
+	{"TryIntoBool[bool](true)", func() error { _, err := TryIntoBool[bool](true); return err }},
+	{"TryIntoBool[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoBool[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoBool[int](int(1))", func() error { _, err := TryIntoBool[int](int(1)); return err }},
+	{"TryIntoBool[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoBool[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoBool[int8](int8(1))", func() error { _, err := TryIntoBool[int8](int8(1)); return err }},
+	{"TryIntoBool[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoBool[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoBool[int16](int16(1))", func() error { _, err := TryIntoBool[int16](int16(1)); return err }},
+	{"TryIntoBool[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoBool[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoBool[int32](int32(1))", func() error { _, err := TryIntoBool[int32](int32(1)); return err }},
+	{"TryIntoBool[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoBool[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoBool[int64](int64(1))", func() error { _, err := TryIntoBool[int64](int64(1)); return err }},
+	{"TryIntoBool[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoBool[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoBool[uint](uint(1))", func() error { _, err := TryIntoBool[uint](uint(1)); return err }},
+	{"TryIntoBool[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoBool[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoBool[uint8](uint8(1))", func() error { _, err := TryIntoBool[uint8](uint8(1)); return err }},
+	{"TryIntoBool[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoBool[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoBool[uint16](uint16(1))", func() error { _, err := TryIntoBool[uint16](uint16(1)); return err }},
+	{"TryIntoBool[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoBool[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoBool[uint32](uint32(1))", func() error { _, err := TryIntoBool[uint32](uint32(1)); return err }},
+	{"TryIntoBool[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoBool[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoBool[uint64](uint64(1))", func() error { _, err := TryIntoBool[uint64](uint64(1)); return err }},
+	{"TryIntoBool[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoBool[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoBool[float32](float32(1))", func() error { _, err := TryIntoBool[float32](float32(1)); return err }},
+	{"TryIntoBool[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoBool[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoBool[float64](float64(1))", func() error { _, err := TryIntoBool[float64](float64(1)); return err }},
+	{"TryIntoBool[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoBool[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoBool[string](\"1\")", func() error { _, err := TryIntoBool[string]("1"); return err }},
+	{"TryIntoBool[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoBool[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoByte[bool](true)", func() error { _, err := TryIntoByte[bool](true); return err }},
+	{"TryIntoByte[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoByte[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoByte[int](int(1))", func() error { _, err := TryIntoByte[int](int(1)); return err }},
+	{"TryIntoByte[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoByte[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoByte[int8](int8(1))", func() error { _, err := TryIntoByte[int8](int8(1)); return err }},
+	{"TryIntoByte[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoByte[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoByte[int16](int16(1))", func() error { _, err := TryIntoByte[int16](int16(1)); return err }},
+	{"TryIntoByte[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoByte[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoByte[int32](int32(1))", func() error { _, err := TryIntoByte[int32](int32(1)); return err }},
+	{"TryIntoByte[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoByte[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoByte[int64](int64(1))", func() error { _, err := TryIntoByte[int64](int64(1)); return err }},
+	{"TryIntoByte[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoByte[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoByte[uint](uint(1))", func() error { _, err := TryIntoByte[uint](uint(1)); return err }},
+	{"TryIntoByte[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoByte[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoByte[uint8](uint8(1))", func() error { _, err := TryIntoByte[uint8](uint8(1)); return err }},
+	{"TryIntoByte[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoByte[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoByte[uint16](uint16(1))", func() error { _, err := TryIntoByte[uint16](uint16(1)); return err }},
+	{"TryIntoByte[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoByte[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoByte[uint32](uint32(1))", func() error { _, err := TryIntoByte[uint32](uint32(1)); return err }},
+	{"TryIntoByte[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoByte[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoByte[uint64](uint64(1))", func() error { _, err := TryIntoByte[uint64](uint64(1)); return err }},
+	{"TryIntoByte[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoByte[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoByte[float32](float32(1))", func() error { _, err := TryIntoByte[float32](float32(1)); return err }},
+	{"TryIntoByte[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoByte[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoByte[float64](float64(1))", func() error { _, err := TryIntoByte[float64](float64(1)); return err }},
+	{"TryIntoByte[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoByte[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoByte[string](\"1\")", func() error { _, err := TryIntoByte[string]("1"); return err }},
+	{"TryIntoByte[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoByte[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoFloat32[bool](true)", func() error { _, err := TryIntoFloat32[bool](true); return err }},
+	{"TryIntoFloat32[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoFloat32[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoFloat32[int](int(1))", func() error { _, err := TryIntoFloat32[int](int(1)); return err }},
+	{"TryIntoFloat32[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoFloat32[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoFloat32[int8](int8(1))", func() error { _, err := TryIntoFloat32[int8](int8(1)); return err }},
+	{"TryIntoFloat32[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoFloat32[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoFloat32[int16](int16(1))", func() error { _, err := TryIntoFloat32[int16](int16(1)); return err }},
+	{"TryIntoFloat32[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoFloat32[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoFloat32[int32](int32(1))", func() error { _, err := TryIntoFloat32[int32](int32(1)); return err }},
+	{"TryIntoFloat32[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoFloat32[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoFloat32[int64](int64(1))", func() error { _, err := TryIntoFloat32[int64](int64(1)); return err }},
+	{"TryIntoFloat32[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoFloat32[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoFloat32[uint](uint(1))", func() error { _, err := TryIntoFloat32[uint](uint(1)); return err }},
+	{"TryIntoFloat32[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoFloat32[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoFloat32[uint8](uint8(1))", func() error { _, err := TryIntoFloat32[uint8](uint8(1)); return err }},
+	{"TryIntoFloat32[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoFloat32[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoFloat32[uint16](uint16(1))", func() error { _, err := TryIntoFloat32[uint16](uint16(1)); return err }},
+	{"TryIntoFloat32[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoFloat32[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoFloat32[uint32](uint32(1))", func() error { _, err := TryIntoFloat32[uint32](uint32(1)); return err }},
+	{"TryIntoFloat32[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoFloat32[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoFloat32[uint64](uint64(1))", func() error { _, err := TryIntoFloat32[uint64](uint64(1)); return err }},
+	{"TryIntoFloat32[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoFloat32[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoFloat32[float32](float32(1))", func() error { _, err := TryIntoFloat32[float32](float32(1)); return err }},
+	{"TryIntoFloat32[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoFloat32[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoFloat32[float64](float64(1))", func() error { _, err := TryIntoFloat32[float64](float64(1)); return err }},
+	{"TryIntoFloat32[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoFloat32[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoFloat32[string](\"1\")", func() error { _, err := TryIntoFloat32[string]("1"); return err }},
+	{"TryIntoFloat32[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoFloat32[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoFloat64[bool](true)", func() error { _, err := TryIntoFloat64[bool](true); return err }},
+	{"TryIntoFloat64[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoFloat64[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoFloat64[int](int(1))", func() error { _, err := TryIntoFloat64[int](int(1)); return err }},
+	{"TryIntoFloat64[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoFloat64[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoFloat64[int8](int8(1))", func() error { _, err := TryIntoFloat64[int8](int8(1)); return err }},
+	{"TryIntoFloat64[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoFloat64[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoFloat64[int16](int16(1))", func() error { _, err := TryIntoFloat64[int16](int16(1)); return err }},
+	{"TryIntoFloat64[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoFloat64[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoFloat64[int32](int32(1))", func() error { _, err := TryIntoFloat64[int32](int32(1)); return err }},
+	{"TryIntoFloat64[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoFloat64[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoFloat64[int64](int64(1))", func() error { _, err := TryIntoFloat64[int64](int64(1)); return err }},
+	{"TryIntoFloat64[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoFloat64[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoFloat64[uint](uint(1))", func() error { _, err := TryIntoFloat64[uint](uint(1)); return err }},
+	{"TryIntoFloat64[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoFloat64[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoFloat64[uint8](uint8(1))", func() error { _, err := TryIntoFloat64[uint8](uint8(1)); return err }},
+	{"TryIntoFloat64[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoFloat64[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoFloat64[uint16](uint16(1))", func() error { _, err := TryIntoFloat64[uint16](uint16(1)); return err }},
+	{"TryIntoFloat64[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoFloat64[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoFloat64[uint32](uint32(1))", func() error { _, err := TryIntoFloat64[uint32](uint32(1)); return err }},
+	{"TryIntoFloat64[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoFloat64[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoFloat64[uint64](uint64(1))", func() error { _, err := TryIntoFloat64[uint64](uint64(1)); return err }},
+	{"TryIntoFloat64[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoFloat64[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoFloat64[float32](float32(1))", func() error { _, err := TryIntoFloat64[float32](float32(1)); return err }},
+	{"TryIntoFloat64[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoFloat64[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoFloat64[float64](float64(1))", func() error { _, err := TryIntoFloat64[float64](float64(1)); return err }},
+	{"TryIntoFloat64[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoFloat64[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoFloat64[string](\"1\")", func() error { _, err := TryIntoFloat64[string]("1"); return err }},
+	{"TryIntoFloat64[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoFloat64[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoInt[bool](true)", func() error { _, err := TryIntoInt[bool](true); return err }},
+	{"TryIntoInt[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoInt[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoInt[int](int(1))", func() error { _, err := TryIntoInt[int](int(1)); return err }},
+	{"TryIntoInt[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoInt[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoInt[int8](int8(1))", func() error { _, err := TryIntoInt[int8](int8(1)); return err }},
+	{"TryIntoInt[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoInt[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoInt[int16](int16(1))", func() error { _, err := TryIntoInt[int16](int16(1)); return err }},
+	{"TryIntoInt[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoInt[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoInt[int32](int32(1))", func() error { _, err := TryIntoInt[int32](int32(1)); return err }},
+	{"TryIntoInt[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoInt[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoInt[int64](int64(1))", func() error { _, err := TryIntoInt[int64](int64(1)); return err }},
+	{"TryIntoInt[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoInt[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoInt[uint](uint(1))", func() error { _, err := TryIntoInt[uint](uint(1)); return err }},
+	{"TryIntoInt[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoInt[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoInt[uint8](uint8(1))", func() error { _, err := TryIntoInt[uint8](uint8(1)); return err }},
+	{"TryIntoInt[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoInt[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoInt[uint16](uint16(1))", func() error { _, err := TryIntoInt[uint16](uint16(1)); return err }},
+	{"TryIntoInt[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoInt[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoInt[uint32](uint32(1))", func() error { _, err := TryIntoInt[uint32](uint32(1)); return err }},
+	{"TryIntoInt[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoInt[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoInt[uint64](uint64(1))", func() error { _, err := TryIntoInt[uint64](uint64(1)); return err }},
+	{"TryIntoInt[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoInt[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoInt[float32](float32(1))", func() error { _, err := TryIntoInt[float32](float32(1)); return err }},
+	{"TryIntoInt[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoInt[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoInt[float64](float64(1))", func() error { _, err := TryIntoInt[float64](float64(1)); return err }},
+	{"TryIntoInt[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoInt[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoInt[string](\"1\")", func() error { _, err := TryIntoInt[string]("1"); return err }},
+	{"TryIntoInt[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoInt[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoInt16[bool](true)", func() error { _, err := TryIntoInt16[bool](true); return err }},
+	{"TryIntoInt16[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoInt16[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoInt16[int](int(1))", func() error { _, err := TryIntoInt16[int](int(1)); return err }},
+	{"TryIntoInt16[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoInt16[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoInt16[int8](int8(1))", func() error { _, err := TryIntoInt16[int8](int8(1)); return err }},
+	{"TryIntoInt16[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoInt16[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoInt16[int16](int16(1))", func() error { _, err := TryIntoInt16[int16](int16(1)); return err }},
+	{"TryIntoInt16[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoInt16[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoInt16[int32](int32(1))", func() error { _, err := TryIntoInt16[int32](int32(1)); return err }},
+	{"TryIntoInt16[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoInt16[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoInt16[int64](int64(1))", func() error { _, err := TryIntoInt16[int64](int64(1)); return err }},
+	{"TryIntoInt16[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoInt16[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoInt16[uint](uint(1))", func() error { _, err := TryIntoInt16[uint](uint(1)); return err }},
+	{"TryIntoInt16[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoInt16[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoInt16[uint8](uint8(1))", func() error { _, err := TryIntoInt16[uint8](uint8(1)); return err }},
+	{"TryIntoInt16[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoInt16[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoInt16[uint16](uint16(1))", func() error { _, err := TryIntoInt16[uint16](uint16(1)); return err }},
+	{"TryIntoInt16[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoInt16[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoInt16[uint32](uint32(1))", func() error { _, err := TryIntoInt16[uint32](uint32(1)); return err }},
+	{"TryIntoInt16[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoInt16[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoInt16[uint64](uint64(1))", func() error { _, err := TryIntoInt16[uint64](uint64(1)); return err }},
+	{"TryIntoInt16[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoInt16[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoInt16[float32](float32(1))", func() error { _, err := TryIntoInt16[float32](float32(1)); return err }},
+	{"TryIntoInt16[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoInt16[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoInt16[float64](float64(1))", func() error { _, err := TryIntoInt16[float64](float64(1)); return err }},
+	{"TryIntoInt16[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoInt16[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoInt16[string](\"1\")", func() error { _, err := TryIntoInt16[string]("1"); return err }},
+	{"TryIntoInt16[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoInt16[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoInt32[bool](true)", func() error { _, err := TryIntoInt32[bool](true); return err }},
+	{"TryIntoInt32[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoInt32[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoInt32[int](int(1))", func() error { _, err := TryIntoInt32[int](int(1)); return err }},
+	{"TryIntoInt32[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoInt32[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoInt32[int8](int8(1))", func() error { _, err := TryIntoInt32[int8](int8(1)); return err }},
+	{"TryIntoInt32[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoInt32[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoInt32[int16](int16(1))", func() error { _, err := TryIntoInt32[int16](int16(1)); return err }},
+	{"TryIntoInt32[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoInt32[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoInt32[int32](int32(1))", func() error { _, err := TryIntoInt32[int32](int32(1)); return err }},
+	{"TryIntoInt32[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoInt32[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoInt32[int64](int64(1))", func() error { _, err := TryIntoInt32[int64](int64(1)); return err }},
+	{"TryIntoInt32[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoInt32[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoInt32[uint](uint(1))", func() error { _, err := TryIntoInt32[uint](uint(1)); return err }},
+	{"TryIntoInt32[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoInt32[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoInt32[uint8](uint8(1))", func() error { _, err := TryIntoInt32[uint8](uint8(1)); return err }},
+	{"TryIntoInt32[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoInt32[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoInt32[uint16](uint16(1))", func() error { _, err := TryIntoInt32[uint16](uint16(1)); return err }},
+	{"TryIntoInt32[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoInt32[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoInt32[uint32](uint32(1))", func() error { _, err := TryIntoInt32[uint32](uint32(1)); return err }},
+	{"TryIntoInt32[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoInt32[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoInt32[uint64](uint64(1))", func() error { _, err := TryIntoInt32[uint64](uint64(1)); return err }},
+	{"TryIntoInt32[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoInt32[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoInt32[float32](float32(1))", func() error { _, err := TryIntoInt32[float32](float32(1)); return err }},
+	{"TryIntoInt32[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoInt32[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoInt32[float64](float64(1))", func() error { _, err := TryIntoInt32[float64](float64(1)); return err }},
+	{"TryIntoInt32[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoInt32[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoInt32[string](\"1\")", func() error { _, err := TryIntoInt32[string]("1"); return err }},
+	{"TryIntoInt32[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoInt32[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoInt64[bool](true)", func() error { _, err := TryIntoInt64[bool](true); return err }},
+	{"TryIntoInt64[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoInt64[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoInt64[int](int(1))", func() error { _, err := TryIntoInt64[int](int(1)); return err }},
+	{"TryIntoInt64[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoInt64[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoInt64[int8](int8(1))", func() error { _, err := TryIntoInt64[int8](int8(1)); return err }},
+	{"TryIntoInt64[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoInt64[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoInt64[int16](int16(1))", func() error { _, err := TryIntoInt64[int16](int16(1)); return err }},
+	{"TryIntoInt64[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoInt64[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoInt64[int32](int32(1))", func() error { _, err := TryIntoInt64[int32](int32(1)); return err }},
+	{"TryIntoInt64[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoInt64[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoInt64[int64](int64(1))", func() error { _, err := TryIntoInt64[int64](int64(1)); return err }},
+	{"TryIntoInt64[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoInt64[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoInt64[uint](uint(1))", func() error { _, err := TryIntoInt64[uint](uint(1)); return err }},
+	{"TryIntoInt64[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoInt64[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoInt64[uint8](uint8(1))", func() error { _, err := TryIntoInt64[uint8](uint8(1)); return err }},
+	{"TryIntoInt64[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoInt64[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoInt64[uint16](uint16(1))", func() error { _, err := TryIntoInt64[uint16](uint16(1)); return err }},
+	{"TryIntoInt64[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoInt64[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoInt64[uint32](uint32(1))", func() error { _, err := TryIntoInt64[uint32](uint32(1)); return err }},
+	{"TryIntoInt64[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoInt64[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoInt64[uint64](uint64(1))", func() error { _, err := TryIntoInt64[uint64](uint64(1)); return err }},
+	{"TryIntoInt64[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoInt64[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoInt64[float32](float32(1))", func() error { _, err := TryIntoInt64[float32](float32(1)); return err }},
+	{"TryIntoInt64[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoInt64[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoInt64[float64](float64(1))", func() error { _, err := TryIntoInt64[float64](float64(1)); return err }},
+	{"TryIntoInt64[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoInt64[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoInt64[string](\"1\")", func() error { _, err := TryIntoInt64[string]("1"); return err }},
+	{"TryIntoInt64[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoInt64[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoInt8[bool](true)", func() error { _, err := TryIntoInt8[bool](true); return err }},
+	{"TryIntoInt8[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoInt8[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoInt8[int](int(1))", func() error { _, err := TryIntoInt8[int](int(1)); return err }},
+	{"TryIntoInt8[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoInt8[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoInt8[int8](int8(1))", func() error { _, err := TryIntoInt8[int8](int8(1)); return err }},
+	{"TryIntoInt8[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoInt8[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoInt8[int16](int16(1))", func() error { _, err := TryIntoInt8[int16](int16(1)); return err }},
+	{"TryIntoInt8[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoInt8[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoInt8[int32](int32(1))", func() error { _, err := TryIntoInt8[int32](int32(1)); return err }},
+	{"TryIntoInt8[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoInt8[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoInt8[int64](int64(1))", func() error { _, err := TryIntoInt8[int64](int64(1)); return err }},
+	{"TryIntoInt8[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoInt8[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoInt8[uint](uint(1))", func() error { _, err := TryIntoInt8[uint](uint(1)); return err }},
+	{"TryIntoInt8[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoInt8[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoInt8[uint8](uint8(1))", func() error { _, err := TryIntoInt8[uint8](uint8(1)); return err }},
+	{"TryIntoInt8[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoInt8[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoInt8[uint16](uint16(1))", func() error { _, err := TryIntoInt8[uint16](uint16(1)); return err }},
+	{"TryIntoInt8[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoInt8[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoInt8[uint32](uint32(1))", func() error { _, err := TryIntoInt8[uint32](uint32(1)); return err }},
+	{"TryIntoInt8[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoInt8[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoInt8[uint64](uint64(1))", func() error { _, err := TryIntoInt8[uint64](uint64(1)); return err }},
+	{"TryIntoInt8[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoInt8[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoInt8[float32](float32(1))", func() error { _, err := TryIntoInt8[float32](float32(1)); return err }},
+	{"TryIntoInt8[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoInt8[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoInt8[float64](float64(1))", func() error { _, err := TryIntoInt8[float64](float64(1)); return err }},
+	{"TryIntoInt8[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoInt8[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoInt8[string](\"1\")", func() error { _, err := TryIntoInt8[string]("1"); return err }},
+	{"TryIntoInt8[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoInt8[dispatchString](dispatchString("1")); return err }},
+	{"TryInto[bool, bool](true)", func() error { _, err := TryInto[bool, bool](true); return err }},
+	{"TryInto[bool, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[bool, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[bool, int](int(1))", func() error { _, err := TryInto[bool, int](int(1)); return err }},
+	{"TryInto[bool, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[bool, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[bool, int8](int8(1))", func() error { _, err := TryInto[bool, int8](int8(1)); return err }},
+	{"TryInto[bool, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[bool, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[bool, int16](int16(1))", func() error { _, err := TryInto[bool, int16](int16(1)); return err }},
+	{"TryInto[bool, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[bool, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[bool, int32](int32(1))", func() error { _, err := TryInto[bool, int32](int32(1)); return err }},
+	{"TryInto[bool, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[bool, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[bool, int64](int64(1))", func() error { _, err := TryInto[bool, int64](int64(1)); return err }},
+	{"TryInto[bool, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[bool, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[bool, uint](uint(1))", func() error { _, err := TryInto[bool, uint](uint(1)); return err }},
+	{"TryInto[bool, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[bool, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[bool, uint8](uint8(1))", func() error { _, err := TryInto[bool, uint8](uint8(1)); return err }},
+	{"TryInto[bool, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[bool, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[bool, uint16](uint16(1))", func() error { _, err := TryInto[bool, uint16](uint16(1)); return err }},
+	{"TryInto[bool, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[bool, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[bool, uint32](uint32(1))", func() error { _, err := TryInto[bool, uint32](uint32(1)); return err }},
+	{"TryInto[bool, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[bool, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[bool, uint64](uint64(1))", func() error { _, err := TryInto[bool, uint64](uint64(1)); return err }},
+	{"TryInto[bool, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[bool, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[bool, float32](float32(1))", func() error { _, err := TryInto[bool, float32](float32(1)); return err }},
+	{"TryInto[bool, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[bool, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[bool, float64](float64(1))", func() error { _, err := TryInto[bool, float64](float64(1)); return err }},
+	{"TryInto[bool, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[bool, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[bool, string](\"1\")", func() error { _, err := TryInto[bool, string]("1"); return err }},
+	{"TryInto[bool, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[bool, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[int, bool](true)", func() error { _, err := TryInto[int, bool](true); return err }},
+	{"TryInto[int, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[int, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[int, int](int(1))", func() error { _, err := TryInto[int, int](int(1)); return err }},
+	{"TryInto[int, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[int, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[int, int8](int8(1))", func() error { _, err := TryInto[int, int8](int8(1)); return err }},
+	{"TryInto[int, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[int, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[int, int16](int16(1))", func() error { _, err := TryInto[int, int16](int16(1)); return err }},
+	{"TryInto[int, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[int, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[int, int32](int32(1))", func() error { _, err := TryInto[int, int32](int32(1)); return err }},
+	{"TryInto[int, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[int, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[int, int64](int64(1))", func() error { _, err := TryInto[int, int64](int64(1)); return err }},
+	{"TryInto[int, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[int, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[int, uint](uint(1))", func() error { _, err := TryInto[int, uint](uint(1)); return err }},
+	{"TryInto[int, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[int, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[int, uint8](uint8(1))", func() error { _, err := TryInto[int, uint8](uint8(1)); return err }},
+	{"TryInto[int, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[int, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[int, uint16](uint16(1))", func() error { _, err := TryInto[int, uint16](uint16(1)); return err }},
+	{"TryInto[int, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[int, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[int, uint32](uint32(1))", func() error { _, err := TryInto[int, uint32](uint32(1)); return err }},
+	{"TryInto[int, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[int, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[int, uint64](uint64(1))", func() error { _, err := TryInto[int, uint64](uint64(1)); return err }},
+	{"TryInto[int, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[int, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[int, float32](float32(1))", func() error { _, err := TryInto[int, float32](float32(1)); return err }},
+	{"TryInto[int, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[int, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[int, float64](float64(1))", func() error { _, err := TryInto[int, float64](float64(1)); return err }},
+	{"TryInto[int, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[int, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[int, string](\"1\")", func() error { _, err := TryInto[int, string]("1"); return err }},
+	{"TryInto[int, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[int, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[int8, bool](true)", func() error { _, err := TryInto[int8, bool](true); return err }},
+	{"TryInto[int8, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[int8, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[int8, int](int(1))", func() error { _, err := TryInto[int8, int](int(1)); return err }},
+	{"TryInto[int8, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[int8, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[int8, int8](int8(1))", func() error { _, err := TryInto[int8, int8](int8(1)); return err }},
+	{"TryInto[int8, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[int8, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[int8, int16](int16(1))", func() error { _, err := TryInto[int8, int16](int16(1)); return err }},
+	{"TryInto[int8, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[int8, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[int8, int32](int32(1))", func() error { _, err := TryInto[int8, int32](int32(1)); return err }},
+	{"TryInto[int8, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[int8, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[int8, int64](int64(1))", func() error { _, err := TryInto[int8, int64](int64(1)); return err }},
+	{"TryInto[int8, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[int8, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[int8, uint](uint(1))", func() error { _, err := TryInto[int8, uint](uint(1)); return err }},
+	{"TryInto[int8, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[int8, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[int8, uint8](uint8(1))", func() error { _, err := TryInto[int8, uint8](uint8(1)); return err }},
+	{"TryInto[int8, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[int8, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[int8, uint16](uint16(1))", func() error { _, err := TryInto[int8, uint16](uint16(1)); return err }},
+	{"TryInto[int8, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[int8, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[int8, uint32](uint32(1))", func() error { _, err := TryInto[int8, uint32](uint32(1)); return err }},
+	{"TryInto[int8, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[int8, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[int8, uint64](uint64(1))", func() error { _, err := TryInto[int8, uint64](uint64(1)); return err }},
+	{"TryInto[int8, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[int8, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[int8, float32](float32(1))", func() error { _, err := TryInto[int8, float32](float32(1)); return err }},
+	{"TryInto[int8, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[int8, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[int8, float64](float64(1))", func() error { _, err := TryInto[int8, float64](float64(1)); return err }},
+	{"TryInto[int8, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[int8, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[int8, string](\"1\")", func() error { _, err := TryInto[int8, string]("1"); return err }},
+	{"TryInto[int8, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[int8, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[int16, bool](true)", func() error { _, err := TryInto[int16, bool](true); return err }},
+	{"TryInto[int16, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[int16, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[int16, int](int(1))", func() error { _, err := TryInto[int16, int](int(1)); return err }},
+	{"TryInto[int16, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[int16, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[int16, int8](int8(1))", func() error { _, err := TryInto[int16, int8](int8(1)); return err }},
+	{"TryInto[int16, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[int16, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[int16, int16](int16(1))", func() error { _, err := TryInto[int16, int16](int16(1)); return err }},
+	{"TryInto[int16, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[int16, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[int16, int32](int32(1))", func() error { _, err := TryInto[int16, int32](int32(1)); return err }},
+	{"TryInto[int16, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[int16, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[int16, int64](int64(1))", func() error { _, err := TryInto[int16, int64](int64(1)); return err }},
+	{"TryInto[int16, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[int16, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[int16, uint](uint(1))", func() error { _, err := TryInto[int16, uint](uint(1)); return err }},
+	{"TryInto[int16, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[int16, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[int16, uint8](uint8(1))", func() error { _, err := TryInto[int16, uint8](uint8(1)); return err }},
+	{"TryInto[int16, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[int16, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[int16, uint16](uint16(1))", func() error { _, err := TryInto[int16, uint16](uint16(1)); return err }},
+	{"TryInto[int16, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[int16, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[int16, uint32](uint32(1))", func() error { _, err := TryInto[int16, uint32](uint32(1)); return err }},
+	{"TryInto[int16, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[int16, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[int16, uint64](uint64(1))", func() error { _, err := TryInto[int16, uint64](uint64(1)); return err }},
+	{"TryInto[int16, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[int16, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[int16, float32](float32(1))", func() error { _, err := TryInto[int16, float32](float32(1)); return err }},
+	{"TryInto[int16, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[int16, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[int16, float64](float64(1))", func() error { _, err := TryInto[int16, float64](float64(1)); return err }},
+	{"TryInto[int16, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[int16, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[int16, string](\"1\")", func() error { _, err := TryInto[int16, string]("1"); return err }},
+	{"TryInto[int16, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[int16, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[int32, bool](true)", func() error { _, err := TryInto[int32, bool](true); return err }},
+	{"TryInto[int32, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[int32, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[int32, int](int(1))", func() error { _, err := TryInto[int32, int](int(1)); return err }},
+	{"TryInto[int32, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[int32, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[int32, int8](int8(1))", func() error { _, err := TryInto[int32, int8](int8(1)); return err }},
+	{"TryInto[int32, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[int32, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[int32, int16](int16(1))", func() error { _, err := TryInto[int32, int16](int16(1)); return err }},
+	{"TryInto[int32, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[int32, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[int32, int32](int32(1))", func() error { _, err := TryInto[int32, int32](int32(1)); return err }},
+	{"TryInto[int32, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[int32, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[int32, int64](int64(1))", func() error { _, err := TryInto[int32, int64](int64(1)); return err }},
+	{"TryInto[int32, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[int32, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[int32, uint](uint(1))", func() error { _, err := TryInto[int32, uint](uint(1)); return err }},
+	{"TryInto[int32, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[int32, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[int32, uint8](uint8(1))", func() error { _, err := TryInto[int32, uint8](uint8(1)); return err }},
+	{"TryInto[int32, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[int32, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[int32, uint16](uint16(1))", func() error { _, err := TryInto[int32, uint16](uint16(1)); return err }},
+	{"TryInto[int32, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[int32, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[int32, uint32](uint32(1))", func() error { _, err := TryInto[int32, uint32](uint32(1)); return err }},
+	{"TryInto[int32, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[int32, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[int32, uint64](uint64(1))", func() error { _, err := TryInto[int32, uint64](uint64(1)); return err }},
+	{"TryInto[int32, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[int32, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[int32, float32](float32(1))", func() error { _, err := TryInto[int32, float32](float32(1)); return err }},
+	{"TryInto[int32, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[int32, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[int32, float64](float64(1))", func() error { _, err := TryInto[int32, float64](float64(1)); return err }},
+	{"TryInto[int32, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[int32, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[int32, string](\"1\")", func() error { _, err := TryInto[int32, string]("1"); return err }},
+	{"TryInto[int32, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[int32, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[int64, bool](true)", func() error { _, err := TryInto[int64, bool](true); return err }},
+	{"TryInto[int64, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[int64, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[int64, int](int(1))", func() error { _, err := TryInto[int64, int](int(1)); return err }},
+	{"TryInto[int64, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[int64, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[int64, int8](int8(1))", func() error { _, err := TryInto[int64, int8](int8(1)); return err }},
+	{"TryInto[int64, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[int64, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[int64, int16](int16(1))", func() error { _, err := TryInto[int64, int16](int16(1)); return err }},
+	{"TryInto[int64, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[int64, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[int64, int32](int32(1))", func() error { _, err := TryInto[int64, int32](int32(1)); return err }},
+	{"TryInto[int64, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[int64, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[int64, int64](int64(1))", func() error { _, err := TryInto[int64, int64](int64(1)); return err }},
+	{"TryInto[int64, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[int64, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[int64, uint](uint(1))", func() error { _, err := TryInto[int64, uint](uint(1)); return err }},
+	{"TryInto[int64, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[int64, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[int64, uint8](uint8(1))", func() error { _, err := TryInto[int64, uint8](uint8(1)); return err }},
+	{"TryInto[int64, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[int64, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[int64, uint16](uint16(1))", func() error { _, err := TryInto[int64, uint16](uint16(1)); return err }},
+	{"TryInto[int64, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[int64, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[int64, uint32](uint32(1))", func() error { _, err := TryInto[int64, uint32](uint32(1)); return err }},
+	{"TryInto[int64, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[int64, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[int64, uint64](uint64(1))", func() error { _, err := TryInto[int64, uint64](uint64(1)); return err }},
+	{"TryInto[int64, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[int64, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[int64, float32](float32(1))", func() error { _, err := TryInto[int64, float32](float32(1)); return err }},
+	{"TryInto[int64, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[int64, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[int64, float64](float64(1))", func() error { _, err := TryInto[int64, float64](float64(1)); return err }},
+	{"TryInto[int64, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[int64, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[int64, string](\"1\")", func() error { _, err := TryInto[int64, string]("1"); return err }},
+	{"TryInto[int64, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[int64, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[uint, bool](true)", func() error { _, err := TryInto[uint, bool](true); return err }},
+	{"TryInto[uint, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[uint, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[uint, int](int(1))", func() error { _, err := TryInto[uint, int](int(1)); return err }},
+	{"TryInto[uint, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[uint, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[uint, int8](int8(1))", func() error { _, err := TryInto[uint, int8](int8(1)); return err }},
+	{"TryInto[uint, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[uint, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[uint, int16](int16(1))", func() error { _, err := TryInto[uint, int16](int16(1)); return err }},
+	{"TryInto[uint, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[uint, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[uint, int32](int32(1))", func() error { _, err := TryInto[uint, int32](int32(1)); return err }},
+	{"TryInto[uint, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[uint, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[uint, int64](int64(1))", func() error { _, err := TryInto[uint, int64](int64(1)); return err }},
+	{"TryInto[uint, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[uint, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[uint, uint](uint(1))", func() error { _, err := TryInto[uint, uint](uint(1)); return err }},
+	{"TryInto[uint, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[uint, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[uint, uint8](uint8(1))", func() error { _, err := TryInto[uint, uint8](uint8(1)); return err }},
+	{"TryInto[uint, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[uint, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[uint, uint16](uint16(1))", func() error { _, err := TryInto[uint, uint16](uint16(1)); return err }},
+	{"TryInto[uint, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[uint, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[uint, uint32](uint32(1))", func() error { _, err := TryInto[uint, uint32](uint32(1)); return err }},
+	{"TryInto[uint, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[uint, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[uint, uint64](uint64(1))", func() error { _, err := TryInto[uint, uint64](uint64(1)); return err }},
+	{"TryInto[uint, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[uint, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[uint, float32](float32(1))", func() error { _, err := TryInto[uint, float32](float32(1)); return err }},
+	{"TryInto[uint, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[uint, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[uint, float64](float64(1))", func() error { _, err := TryInto[uint, float64](float64(1)); return err }},
+	{"TryInto[uint, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[uint, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[uint, string](\"1\")", func() error { _, err := TryInto[uint, string]("1"); return err }},
+	{"TryInto[uint, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[uint, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[uint8, bool](true)", func() error { _, err := TryInto[uint8, bool](true); return err }},
+	{"TryInto[uint8, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[uint8, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[uint8, int](int(1))", func() error { _, err := TryInto[uint8, int](int(1)); return err }},
+	{"TryInto[uint8, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[uint8, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[uint8, int8](int8(1))", func() error { _, err := TryInto[uint8, int8](int8(1)); return err }},
+	{"TryInto[uint8, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[uint8, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[uint8, int16](int16(1))", func() error { _, err := TryInto[uint8, int16](int16(1)); return err }},
+	{"TryInto[uint8, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[uint8, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[uint8, int32](int32(1))", func() error { _, err := TryInto[uint8, int32](int32(1)); return err }},
+	{"TryInto[uint8, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[uint8, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[uint8, int64](int64(1))", func() error { _, err := TryInto[uint8, int64](int64(1)); return err }},
+	{"TryInto[uint8, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[uint8, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[uint8, uint](uint(1))", func() error { _, err := TryInto[uint8, uint](uint(1)); return err }},
+	{"TryInto[uint8, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[uint8, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[uint8, uint8](uint8(1))", func() error { _, err := TryInto[uint8, uint8](uint8(1)); return err }},
+	{"TryInto[uint8, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[uint8, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[uint8, uint16](uint16(1))", func() error { _, err := TryInto[uint8, uint16](uint16(1)); return err }},
+	{"TryInto[uint8, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[uint8, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[uint8, uint32](uint32(1))", func() error { _, err := TryInto[uint8, uint32](uint32(1)); return err }},
+	{"TryInto[uint8, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[uint8, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[uint8, uint64](uint64(1))", func() error { _, err := TryInto[uint8, uint64](uint64(1)); return err }},
+	{"TryInto[uint8, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[uint8, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[uint8, float32](float32(1))", func() error { _, err := TryInto[uint8, float32](float32(1)); return err }},
+	{"TryInto[uint8, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[uint8, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[uint8, float64](float64(1))", func() error { _, err := TryInto[uint8, float64](float64(1)); return err }},
+	{"TryInto[uint8, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[uint8, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[uint8, string](\"1\")", func() error { _, err := TryInto[uint8, string]("1"); return err }},
+	{"TryInto[uint8, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[uint8, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[uint16, bool](true)", func() error { _, err := TryInto[uint16, bool](true); return err }},
+	{"TryInto[uint16, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[uint16, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[uint16, int](int(1))", func() error { _, err := TryInto[uint16, int](int(1)); return err }},
+	{"TryInto[uint16, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[uint16, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[uint16, int8](int8(1))", func() error { _, err := TryInto[uint16, int8](int8(1)); return err }},
+	{"TryInto[uint16, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[uint16, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[uint16, int16](int16(1))", func() error { _, err := TryInto[uint16, int16](int16(1)); return err }},
+	{"TryInto[uint16, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[uint16, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[uint16, int32](int32(1))", func() error { _, err := TryInto[uint16, int32](int32(1)); return err }},
+	{"TryInto[uint16, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[uint16, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[uint16, int64](int64(1))", func() error { _, err := TryInto[uint16, int64](int64(1)); return err }},
+	{"TryInto[uint16, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[uint16, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[uint16, uint](uint(1))", func() error { _, err := TryInto[uint16, uint](uint(1)); return err }},
+	{"TryInto[uint16, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[uint16, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[uint16, uint8](uint8(1))", func() error { _, err := TryInto[uint16, uint8](uint8(1)); return err }},
+	{"TryInto[uint16, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[uint16, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[uint16, uint16](uint16(1))", func() error { _, err := TryInto[uint16, uint16](uint16(1)); return err }},
+	{"TryInto[uint16, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[uint16, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[uint16, uint32](uint32(1))", func() error { _, err := TryInto[uint16, uint32](uint32(1)); return err }},
+	{"TryInto[uint16, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[uint16, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[uint16, uint64](uint64(1))", func() error { _, err := TryInto[uint16, uint64](uint64(1)); return err }},
+	{"TryInto[uint16, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[uint16, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[uint16, float32](float32(1))", func() error { _, err := TryInto[uint16, float32](float32(1)); return err }},
+	{"TryInto[uint16, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[uint16, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[uint16, float64](float64(1))", func() error { _, err := TryInto[uint16, float64](float64(1)); return err }},
+	{"TryInto[uint16, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[uint16, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[uint16, string](\"1\")", func() error { _, err := TryInto[uint16, string]("1"); return err }},
+	{"TryInto[uint16, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[uint16, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[uint32, bool](true)", func() error { _, err := TryInto[uint32, bool](true); return err }},
+	{"TryInto[uint32, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[uint32, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[uint32, int](int(1))", func() error { _, err := TryInto[uint32, int](int(1)); return err }},
+	{"TryInto[uint32, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[uint32, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[uint32, int8](int8(1))", func() error { _, err := TryInto[uint32, int8](int8(1)); return err }},
+	{"TryInto[uint32, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[uint32, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[uint32, int16](int16(1))", func() error { _, err := TryInto[uint32, int16](int16(1)); return err }},
+	{"TryInto[uint32, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[uint32, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[uint32, int32](int32(1))", func() error { _, err := TryInto[uint32, int32](int32(1)); return err }},
+	{"TryInto[uint32, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[uint32, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[uint32, int64](int64(1))", func() error { _, err := TryInto[uint32, int64](int64(1)); return err }},
+	{"TryInto[uint32, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[uint32, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[uint32, uint](uint(1))", func() error { _, err := TryInto[uint32, uint](uint(1)); return err }},
+	{"TryInto[uint32, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[uint32, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[uint32, uint8](uint8(1))", func() error { _, err := TryInto[uint32, uint8](uint8(1)); return err }},
+	{"TryInto[uint32, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[uint32, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[uint32, uint16](uint16(1))", func() error { _, err := TryInto[uint32, uint16](uint16(1)); return err }},
+	{"TryInto[uint32, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[uint32, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[uint32, uint32](uint32(1))", func() error { _, err := TryInto[uint32, uint32](uint32(1)); return err }},
+	{"TryInto[uint32, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[uint32, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[uint32, uint64](uint64(1))", func() error { _, err := TryInto[uint32, uint64](uint64(1)); return err }},
+	{"TryInto[uint32, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[uint32, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[uint32, float32](float32(1))", func() error { _, err := TryInto[uint32, float32](float32(1)); return err }},
+	{"TryInto[uint32, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[uint32, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[uint32, float64](float64(1))", func() error { _, err := TryInto[uint32, float64](float64(1)); return err }},
+	{"TryInto[uint32, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[uint32, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[uint32, string](\"1\")", func() error { _, err := TryInto[uint32, string]("1"); return err }},
+	{"TryInto[uint32, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[uint32, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[uint64, bool](true)", func() error { _, err := TryInto[uint64, bool](true); return err }},
+	{"TryInto[uint64, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[uint64, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[uint64, int](int(1))", func() error { _, err := TryInto[uint64, int](int(1)); return err }},
+	{"TryInto[uint64, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[uint64, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[uint64, int8](int8(1))", func() error { _, err := TryInto[uint64, int8](int8(1)); return err }},
+	{"TryInto[uint64, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[uint64, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[uint64, int16](int16(1))", func() error { _, err := TryInto[uint64, int16](int16(1)); return err }},
+	{"TryInto[uint64, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[uint64, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[uint64, int32](int32(1))", func() error { _, err := TryInto[uint64, int32](int32(1)); return err }},
+	{"TryInto[uint64, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[uint64, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[uint64, int64](int64(1))", func() error { _, err := TryInto[uint64, int64](int64(1)); return err }},
+	{"TryInto[uint64, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[uint64, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[uint64, uint](uint(1))", func() error { _, err := TryInto[uint64, uint](uint(1)); return err }},
+	{"TryInto[uint64, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[uint64, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[uint64, uint8](uint8(1))", func() error { _, err := TryInto[uint64, uint8](uint8(1)); return err }},
+	{"TryInto[uint64, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[uint64, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[uint64, uint16](uint16(1))", func() error { _, err := TryInto[uint64, uint16](uint16(1)); return err }},
+	{"TryInto[uint64, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[uint64, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[uint64, uint32](uint32(1))", func() error { _, err := TryInto[uint64, uint32](uint32(1)); return err }},
+	{"TryInto[uint64, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[uint64, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[uint64, uint64](uint64(1))", func() error { _, err := TryInto[uint64, uint64](uint64(1)); return err }},
+	{"TryInto[uint64, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[uint64, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[uint64, float32](float32(1))", func() error { _, err := TryInto[uint64, float32](float32(1)); return err }},
+	{"TryInto[uint64, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[uint64, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[uint64, float64](float64(1))", func() error { _, err := TryInto[uint64, float64](float64(1)); return err }},
+	{"TryInto[uint64, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[uint64, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[uint64, string](\"1\")", func() error { _, err := TryInto[uint64, string]("1"); return err }},
+	{"TryInto[uint64, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[uint64, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[float32, bool](true)", func() error { _, err := TryInto[float32, bool](true); return err }},
+	{"TryInto[float32, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[float32, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[float32, int](int(1))", func() error { _, err := TryInto[float32, int](int(1)); return err }},
+	{"TryInto[float32, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[float32, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[float32, int8](int8(1))", func() error { _, err := TryInto[float32, int8](int8(1)); return err }},
+	{"TryInto[float32, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[float32, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[float32, int16](int16(1))", func() error { _, err := TryInto[float32, int16](int16(1)); return err }},
+	{"TryInto[float32, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[float32, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[float32, int32](int32(1))", func() error { _, err := TryInto[float32, int32](int32(1)); return err }},
+	{"TryInto[float32, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[float32, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[float32, int64](int64(1))", func() error { _, err := TryInto[float32, int64](int64(1)); return err }},
+	{"TryInto[float32, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[float32, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[float32, uint](uint(1))", func() error { _, err := TryInto[float32, uint](uint(1)); return err }},
+	{"TryInto[float32, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[float32, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[float32, uint8](uint8(1))", func() error { _, err := TryInto[float32, uint8](uint8(1)); return err }},
+	{"TryInto[float32, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[float32, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[float32, uint16](uint16(1))", func() error { _, err := TryInto[float32, uint16](uint16(1)); return err }},
+	{"TryInto[float32, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[float32, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[float32, uint32](uint32(1))", func() error { _, err := TryInto[float32, uint32](uint32(1)); return err }},
+	{"TryInto[float32, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[float32, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[float32, uint64](uint64(1))", func() error { _, err := TryInto[float32, uint64](uint64(1)); return err }},
+	{"TryInto[float32, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[float32, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[float32, float32](float32(1))", func() error { _, err := TryInto[float32, float32](float32(1)); return err }},
+	{"TryInto[float32, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[float32, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[float32, float64](float64(1))", func() error { _, err := TryInto[float32, float64](float64(1)); return err }},
+	{"TryInto[float32, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[float32, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[float32, string](\"1\")", func() error { _, err := TryInto[float32, string]("1"); return err }},
+	{"TryInto[float32, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[float32, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[float64, bool](true)", func() error { _, err := TryInto[float64, bool](true); return err }},
+	{"TryInto[float64, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[float64, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[float64, int](int(1))", func() error { _, err := TryInto[float64, int](int(1)); return err }},
+	{"TryInto[float64, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[float64, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[float64, int8](int8(1))", func() error { _, err := TryInto[float64, int8](int8(1)); return err }},
+	{"TryInto[float64, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[float64, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[float64, int16](int16(1))", func() error { _, err := TryInto[float64, int16](int16(1)); return err }},
+	{"TryInto[float64, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[float64, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[float64, int32](int32(1))", func() error { _, err := TryInto[float64, int32](int32(1)); return err }},
+	{"TryInto[float64, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[float64, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[float64, int64](int64(1))", func() error { _, err := TryInto[float64, int64](int64(1)); return err }},
+	{"TryInto[float64, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[float64, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[float64, uint](uint(1))", func() error { _, err := TryInto[float64, uint](uint(1)); return err }},
+	{"TryInto[float64, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[float64, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[float64, uint8](uint8(1))", func() error { _, err := TryInto[float64, uint8](uint8(1)); return err }},
+	{"TryInto[float64, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[float64, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[float64, uint16](uint16(1))", func() error { _, err := TryInto[float64, uint16](uint16(1)); return err }},
+	{"TryInto[float64, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[float64, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[float64, uint32](uint32(1))", func() error { _, err := TryInto[float64, uint32](uint32(1)); return err }},
+	{"TryInto[float64, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[float64, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[float64, uint64](uint64(1))", func() error { _, err := TryInto[float64, uint64](uint64(1)); return err }},
+	{"TryInto[float64, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[float64, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[float64, float32](float32(1))", func() error { _, err := TryInto[float64, float32](float32(1)); return err }},
+	{"TryInto[float64, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[float64, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[float64, float64](float64(1))", func() error { _, err := TryInto[float64, float64](float64(1)); return err }},
+	{"TryInto[float64, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[float64, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[float64, string](\"1\")", func() error { _, err := TryInto[float64, string]("1"); return err }},
+	{"TryInto[float64, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[float64, dispatchString](dispatchString("1")); return err }},
+	{"TryInto[string, bool](true)", func() error { _, err := TryInto[string, bool](true); return err }},
+	{"TryInto[string, dispatchBool](dispatchBool(true))", func() error { _, err := TryInto[string, dispatchBool](dispatchBool(true)); return err }},
+	{"TryInto[string, int](int(1))", func() error { _, err := TryInto[string, int](int(1)); return err }},
+	{"TryInto[string, dispatchInt](dispatchInt(1))", func() error { _, err := TryInto[string, dispatchInt](dispatchInt(1)); return err }},
+	{"TryInto[string, int8](int8(1))", func() error { _, err := TryInto[string, int8](int8(1)); return err }},
+	{"TryInto[string, dispatchInt8](dispatchInt8(1))", func() error { _, err := TryInto[string, dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryInto[string, int16](int16(1))", func() error { _, err := TryInto[string, int16](int16(1)); return err }},
+	{"TryInto[string, dispatchInt16](dispatchInt16(1))", func() error { _, err := TryInto[string, dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryInto[string, int32](int32(1))", func() error { _, err := TryInto[string, int32](int32(1)); return err }},
+	{"TryInto[string, dispatchInt32](dispatchInt32(1))", func() error { _, err := TryInto[string, dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryInto[string, int64](int64(1))", func() error { _, err := TryInto[string, int64](int64(1)); return err }},
+	{"TryInto[string, dispatchInt64](dispatchInt64(1))", func() error { _, err := TryInto[string, dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryInto[string, uint](uint(1))", func() error { _, err := TryInto[string, uint](uint(1)); return err }},
+	{"TryInto[string, dispatchUint](dispatchUint(1))", func() error { _, err := TryInto[string, dispatchUint](dispatchUint(1)); return err }},
+	{"TryInto[string, uint8](uint8(1))", func() error { _, err := TryInto[string, uint8](uint8(1)); return err }},
+	{"TryInto[string, dispatchUint8](dispatchUint8(1))", func() error { _, err := TryInto[string, dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryInto[string, uint16](uint16(1))", func() error { _, err := TryInto[string, uint16](uint16(1)); return err }},
+	{"TryInto[string, dispatchUint16](dispatchUint16(1))", func() error { _, err := TryInto[string, dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryInto[string, uint32](uint32(1))", func() error { _, err := TryInto[string, uint32](uint32(1)); return err }},
+	{"TryInto[string, dispatchUint32](dispatchUint32(1))", func() error { _, err := TryInto[string, dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryInto[string, uint64](uint64(1))", func() error { _, err := TryInto[string, uint64](uint64(1)); return err }},
+	{"TryInto[string, dispatchUint64](dispatchUint64(1))", func() error { _, err := TryInto[string, dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryInto[string, float32](float32(1))", func() error { _, err := TryInto[string, float32](float32(1)); return err }},
+	{"TryInto[string, dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryInto[string, dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryInto[string, float64](float64(1))", func() error { _, err := TryInto[string, float64](float64(1)); return err }},
+	{"TryInto[string, dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryInto[string, dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryInto[string, string](\"1\")", func() error { _, err := TryInto[string, string]("1"); return err }},
+	{"TryInto[string, dispatchString](dispatchString(\"1\"))", func() error { _, err := TryInto[string, dispatchString](dispatchString("1")); return err }},
+	{"TryIntoWith[bool, bool](true, Options{})", func() error { _, err := TryIntoWith[bool, bool](true, Options{}); return err }},
+	{"TryIntoWith[bool, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[bool, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[bool, int](int(1), Options{})", func() error { _, err := TryIntoWith[bool, int](int(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[bool, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[bool, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[bool, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[bool, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[bool, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[bool, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[bool, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[bool, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[bool, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[bool, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[bool, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[bool, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[bool, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[bool, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[bool, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[bool, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[bool, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[bool, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[bool, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[bool, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchFloat32](dispatchFloat32(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchFloat32](dispatchFloat32(1), Options{}); return err }},
+	{"TryIntoWith[bool, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[bool, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[bool, dispatchFloat64](dispatchFloat64(1), Options{})", func() error { _, err := TryIntoWith[bool, dispatchFloat64](dispatchFloat64(1), Options{}); return err }},
+	{"TryIntoWith[bool, string](\"1\", Options{})", func() error { _, err := TryIntoWith[bool, string]("1", Options{}); return err }},
+	{"TryIntoWith[bool, dispatchString](dispatchString(\"1\"), Options{})", func() error { _, err := TryIntoWith[bool, dispatchString](dispatchString("1"), Options{}); return err }},
+	{"TryIntoWith[int, bool](true, Options{})", func() error { _, err := TryIntoWith[int, bool](true, Options{}); return err }},
+	{"TryIntoWith[int, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[int, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[int, int](int(1), Options{})", func() error { _, err := TryIntoWith[int, int](int(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[int, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[int, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[int, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[int, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[int, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[int, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[int, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[int, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[int, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[int, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[int, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[int, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[int, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[int, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[int, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[int, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[int, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[int, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[int, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[int, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchFloat32](dispatchFloat32(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchFloat32](dispatchFloat32(1), Options{}); return err }},
+	{"TryIntoWith[int, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[int, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[int, dispatchFloat64](dispatchFloat64(1), Options{})", func() error { _, err := TryIntoWith[int, dispatchFloat64](dispatchFloat64(1), Options{}); return err }},
+	{"TryIntoWith[int, string](\"1\", Options{})", func() error { _, err := TryIntoWith[int, string]("1", Options{}); return err }},
+	{"TryIntoWith[int, dispatchString](dispatchString(\"1\"), Options{})", func() error { _, err := TryIntoWith[int, dispatchString](dispatchString("1"), Options{}); return err }},
+	{"TryIntoWith[int8, bool](true, Options{})", func() error { _, err := TryIntoWith[int8, bool](true, Options{}); return err }},
+	{"TryIntoWith[int8, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[int8, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[int8, int](int(1), Options{})", func() error { _, err := TryIntoWith[int8, int](int(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[int8, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[int8, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[int8, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[int8, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[int8, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[int8, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[int8, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[int8, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[int8, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[int8, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[int8, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[int8, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[int8, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[int8, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[int8, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[int8, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[int8, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[int8, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[int8, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[int8, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchFloat32](dispatchFloat32(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchFloat32](dispatchFloat32(1), Options{}); return err }},
+	{"TryIntoWith[int8, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[int8, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[int8, dispatchFloat64](dispatchFloat64(1), Options{})", func() error { _, err := TryIntoWith[int8, dispatchFloat64](dispatchFloat64(1), Options{}); return err }},
+	{"TryIntoWith[int8, string](\"1\", Options{})", func() error { _, err := TryIntoWith[int8, string]("1", Options{}); return err }},
+	{"TryIntoWith[int8, dispatchString](dispatchString(\"1\"), Options{})", func() error { _, err := TryIntoWith[int8, dispatchString](dispatchString("1"), Options{}); return err }},
+	{"TryIntoWith[int16, bool](true, Options{})", func() error { _, err := TryIntoWith[int16, bool](true, Options{}); return err }},
+	{"TryIntoWith[int16, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[int16, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[int16, int](int(1), Options{})", func() error { _, err := TryIntoWith[int16, int](int(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[int16, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[int16, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[int16, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[int16, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[int16, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[int16, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[int16, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[int16, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[int16, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[int16, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[int16, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[int16, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[int16, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[int16, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[int16, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[int16, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[int16, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[int16, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[int16, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[int16, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchFloat32](dispatchFloat32(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchFloat32](dispatchFloat32(1), Options{}); return err }},
+	{"TryIntoWith[int16, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[int16, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[int16, dispatchFloat64](dispatchFloat64(1), Options{})", func() error { _, err := TryIntoWith[int16, dispatchFloat64](dispatchFloat64(1), Options{}); return err }},
+	{"TryIntoWith[int16, string](\"1\", Options{})", func() error { _, err := TryIntoWith[int16, string]("1", Options{}); return err }},
+	{"TryIntoWith[int16, dispatchString](dispatchString(\"1\"), Options{})", func() error { _, err := TryIntoWith[int16, dispatchString](dispatchString("1"), Options{}); return err }},
+	{"TryIntoWith[int32, bool](true, Options{})", func() error { _, err := TryIntoWith[int32, bool](true, Options{}); return err }},
+	{"TryIntoWith[int32, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[int32, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[int32, int](int(1), Options{})", func() error { _, err := TryIntoWith[int32, int](int(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[int32, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[int32, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[int32, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[int32, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[int32, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[int32, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[int32, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[int32, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[int32, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[int32, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[int32, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[int32, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[int32, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[int32, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[int32, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[int32, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[int32, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[int32, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[int32, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[int32, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchFloat32](dispatchFloat32(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchFloat32](dispatchFloat32(1), Options{}); return err }},
+	{"TryIntoWith[int32, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[int32, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[int32, dispatchFloat64](dispatchFloat64(1), Options{})", func() error { _, err := TryIntoWith[int32, dispatchFloat64](dispatchFloat64(1), Options{}); return err }},
+	{"TryIntoWith[int32, string](\"1\", Options{})", func() error { _, err := TryIntoWith[int32, string]("1", Options{}); return err }},
+	{"TryIntoWith[int32, dispatchString](dispatchString(\"1\"), Options{})", func() error { _, err := TryIntoWith[int32, dispatchString](dispatchString("1"), Options{}); return err }},
+	{"TryIntoWith[int64, bool](true, Options{})", func() error { _, err := TryIntoWith[int64, bool](true, Options{}); return err }},
+	{"TryIntoWith[int64, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[int64, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[int64, int](int(1), Options{})", func() error { _, err := TryIntoWith[int64, int](int(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[int64, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[int64, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[int64, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[int64, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[int64, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[int64, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[int64, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[int64, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[int64, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[int64, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[int64, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[int64, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[int64, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[int64, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[int64, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[int64, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[int64, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[int64, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[int64, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[int64, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchFloat32](dispatchFloat32(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchFloat32](dispatchFloat32(1), Options{}); return err }},
+	{"TryIntoWith[int64, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[int64, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[int64, dispatchFloat64](dispatchFloat64(1), Options{})", func() error { _, err := TryIntoWith[int64, dispatchFloat64](dispatchFloat64(1), Options{}); return err }},
+	{"TryIntoWith[int64, string](\"1\", Options{})", func() error { _, err := TryIntoWith[int64, string]("1", Options{}); return err }},
+	{"TryIntoWith[int64, dispatchString](dispatchString(\"1\"), Options{})", func() error { _, err := TryIntoWith[int64, dispatchString](dispatchString("1"), Options{}); return err }},
+	{"TryIntoWith[uint, bool](true, Options{})", func() error { _, err := TryIntoWith[uint, bool](true, Options{}); return err }},
+	{"TryIntoWith[uint, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[uint, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[uint, int](int(1), Options{})", func() error { _, err := TryIntoWith[uint, int](int(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[uint, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[uint, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[uint, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[uint, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[uint, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[uint, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[uint, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[uint, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[uint, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[uint, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[uint, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[uint, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[uint, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[uint, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[uint, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[uint, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[uint, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[uint, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[uint, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[uint, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchFloat32](dispatchFloat32(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchFloat32](dispatchFloat32(1), Options{}); return err }},
+	{"TryIntoWith[uint, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[uint, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[uint, dispatchFloat64](dispatchFloat64(1), Options{})", func() error { _, err := TryIntoWith[uint, dispatchFloat64](dispatchFloat64(1), Options{}); return err }},
+	{"TryIntoWith[uint, string](\"1\", Options{})", func() error { _, err := TryIntoWith[uint, string]("1", Options{}); return err }},
+	{"TryIntoWith[uint, dispatchString](dispatchString(\"1\"), Options{})", func() error { _, err := TryIntoWith[uint, dispatchString](dispatchString("1"), Options{}); return err }},
+	{"TryIntoWith[uint8, bool](true, Options{})", func() error { _, err := TryIntoWith[uint8, bool](true, Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[uint8, int](int(1), Options{})", func() error { _, err := TryIntoWith[uint8, int](int(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[uint8, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[uint8, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[uint8, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[uint8, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[uint8, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[uint8, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[uint8, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[uint8, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[uint8, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[uint8, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[uint8, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[uint8, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[uint8, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[uint8, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[uint8, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[uint8, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[uint8, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[uint8, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[uint8, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[uint8, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchFloat32](dispatchFloat32(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchFloat32](dispatchFloat32(1), Options{}); return err }},
+	{"TryIntoWith[uint8, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[uint8, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchFloat64](dispatchFloat64(1), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchFloat64](dispatchFloat64(1), Options{}); return err }},
+	{"TryIntoWith[uint8, string](\"1\", Options{})", func() error { _, err := TryIntoWith[uint8, string]("1", Options{}); return err }},
+	{"TryIntoWith[uint8, dispatchString](dispatchString(\"1\"), Options{})", func() error { _, err := TryIntoWith[uint8, dispatchString](dispatchString("1"), Options{}); return err }},
+	{"TryIntoWith[uint16, bool](true, Options{})", func() error { _, err := TryIntoWith[uint16, bool](true, Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[uint16, int](int(1), Options{})", func() error { _, err := TryIntoWith[uint16, int](int(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[uint16, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[uint16, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[uint16, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[uint16, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[uint16, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[uint16, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[uint16, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[uint16, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[uint16, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[uint16, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[uint16, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[uint16, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[uint16, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[uint16, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[uint16, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[uint16, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[uint16, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[uint16, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[uint16, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[uint16, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[uint16, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchFloat32](dispatchFloat32(1), Options{})", func() error {
+		_, err := TryIntoWith[uint16, dispatchFloat32](dispatchFloat32(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[uint16, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[uint16, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchFloat64](dispatchFloat64(1), Options{})", func() error {
+		_, err := TryIntoWith[uint16, dispatchFloat64](dispatchFloat64(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[uint16, string](\"1\", Options{})", func() error { _, err := TryIntoWith[uint16, string]("1", Options{}); return err }},
+	{"TryIntoWith[uint16, dispatchString](dispatchString(\"1\"), Options{})", func() error {
+		_, err := TryIntoWith[uint16, dispatchString](dispatchString("1"), Options{})
+		return err
+	}},
+	{"TryIntoWith[uint32, bool](true, Options{})", func() error { _, err := TryIntoWith[uint32, bool](true, Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[uint32, int](int(1), Options{})", func() error { _, err := TryIntoWith[uint32, int](int(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[uint32, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[uint32, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[uint32, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[uint32, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[uint32, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[uint32, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[uint32, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[uint32, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[uint32, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[uint32, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[uint32, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[uint32, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[uint32, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[uint32, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[uint32, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[uint32, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[uint32, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[uint32, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[uint32, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[uint32, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[uint32, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchFloat32](dispatchFloat32(1), Options{})", func() error {
+		_, err := TryIntoWith[uint32, dispatchFloat32](dispatchFloat32(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[uint32, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[uint32, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchFloat64](dispatchFloat64(1), Options{})", func() error {
+		_, err := TryIntoWith[uint32, dispatchFloat64](dispatchFloat64(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[uint32, string](\"1\", Options{})", func() error { _, err := TryIntoWith[uint32, string]("1", Options{}); return err }},
+	{"TryIntoWith[uint32, dispatchString](dispatchString(\"1\"), Options{})", func() error {
+		_, err := TryIntoWith[uint32, dispatchString](dispatchString("1"), Options{})
+		return err
+	}},
+	{"TryIntoWith[uint64, bool](true, Options{})", func() error { _, err := TryIntoWith[uint64, bool](true, Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[uint64, int](int(1), Options{})", func() error { _, err := TryIntoWith[uint64, int](int(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[uint64, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[uint64, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[uint64, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[uint64, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[uint64, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[uint64, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[uint64, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[uint64, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[uint64, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[uint64, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[uint64, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[uint64, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[uint64, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[uint64, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[uint64, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[uint64, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[uint64, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[uint64, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[uint64, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[uint64, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[uint64, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchFloat32](dispatchFloat32(1), Options{})", func() error {
+		_, err := TryIntoWith[uint64, dispatchFloat32](dispatchFloat32(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[uint64, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[uint64, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchFloat64](dispatchFloat64(1), Options{})", func() error {
+		_, err := TryIntoWith[uint64, dispatchFloat64](dispatchFloat64(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[uint64, string](\"1\", Options{})", func() error { _, err := TryIntoWith[uint64, string]("1", Options{}); return err }},
+	{"TryIntoWith[uint64, dispatchString](dispatchString(\"1\"), Options{})", func() error {
+		_, err := TryIntoWith[uint64, dispatchString](dispatchString("1"), Options{})
+		return err
+	}},
+	{"TryIntoWith[float32, bool](true, Options{})", func() error { _, err := TryIntoWith[float32, bool](true, Options{}); return err }},
+	{"TryIntoWith[float32, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[float32, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[float32, int](int(1), Options{})", func() error { _, err := TryIntoWith[float32, int](int(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[float32, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[float32, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[float32, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[float32, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[float32, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[float32, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[float32, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[float32, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[float32, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[float32, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[float32, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[float32, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[float32, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[float32, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[float32, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[float32, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[float32, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[float32, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[float32, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[float32, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[float32, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchFloat32](dispatchFloat32(1), Options{})", func() error {
+		_, err := TryIntoWith[float32, dispatchFloat32](dispatchFloat32(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[float32, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[float32, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[float32, dispatchFloat64](dispatchFloat64(1), Options{})", func() error {
+		_, err := TryIntoWith[float32, dispatchFloat64](dispatchFloat64(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[float32, string](\"1\", Options{})", func() error { _, err := TryIntoWith[float32, string]("1", Options{}); return err }},
+	{"TryIntoWith[float32, dispatchString](dispatchString(\"1\"), Options{})", func() error {
+		_, err := TryIntoWith[float32, dispatchString](dispatchString("1"), Options{})
+		return err
+	}},
+	{"TryIntoWith[float64, bool](true, Options{})", func() error { _, err := TryIntoWith[float64, bool](true, Options{}); return err }},
+	{"TryIntoWith[float64, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[float64, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[float64, int](int(1), Options{})", func() error { _, err := TryIntoWith[float64, int](int(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[float64, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[float64, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[float64, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[float64, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[float64, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[float64, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[float64, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[float64, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[float64, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[float64, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[float64, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[float64, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[float64, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[float64, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[float64, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[float64, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[float64, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[float64, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[float64, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[float64, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[float64, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchFloat32](dispatchFloat32(1), Options{})", func() error {
+		_, err := TryIntoWith[float64, dispatchFloat32](dispatchFloat32(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[float64, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[float64, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[float64, dispatchFloat64](dispatchFloat64(1), Options{})", func() error {
+		_, err := TryIntoWith[float64, dispatchFloat64](dispatchFloat64(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[float64, string](\"1\", Options{})", func() error { _, err := TryIntoWith[float64, string]("1", Options{}); return err }},
+	{"TryIntoWith[float64, dispatchString](dispatchString(\"1\"), Options{})", func() error {
+		_, err := TryIntoWith[float64, dispatchString](dispatchString("1"), Options{})
+		return err
+	}},
+	{"TryIntoWith[string, bool](true, Options{})", func() error { _, err := TryIntoWith[string, bool](true, Options{}); return err }},
+	{"TryIntoWith[string, dispatchBool](dispatchBool(true), Options{})", func() error { _, err := TryIntoWith[string, dispatchBool](dispatchBool(true), Options{}); return err }},
+	{"TryIntoWith[string, int](int(1), Options{})", func() error { _, err := TryIntoWith[string, int](int(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchInt](dispatchInt(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchInt](dispatchInt(1), Options{}); return err }},
+	{"TryIntoWith[string, int8](int8(1), Options{})", func() error { _, err := TryIntoWith[string, int8](int8(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchInt8](dispatchInt8(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchInt8](dispatchInt8(1), Options{}); return err }},
+	{"TryIntoWith[string, int16](int16(1), Options{})", func() error { _, err := TryIntoWith[string, int16](int16(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchInt16](dispatchInt16(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchInt16](dispatchInt16(1), Options{}); return err }},
+	{"TryIntoWith[string, int32](int32(1), Options{})", func() error { _, err := TryIntoWith[string, int32](int32(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchInt32](dispatchInt32(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchInt32](dispatchInt32(1), Options{}); return err }},
+	{"TryIntoWith[string, int64](int64(1), Options{})", func() error { _, err := TryIntoWith[string, int64](int64(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchInt64](dispatchInt64(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchInt64](dispatchInt64(1), Options{}); return err }},
+	{"TryIntoWith[string, uint](uint(1), Options{})", func() error { _, err := TryIntoWith[string, uint](uint(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchUint](dispatchUint(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchUint](dispatchUint(1), Options{}); return err }},
+	{"TryIntoWith[string, uint8](uint8(1), Options{})", func() error { _, err := TryIntoWith[string, uint8](uint8(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchUint8](dispatchUint8(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchUint8](dispatchUint8(1), Options{}); return err }},
+	{"TryIntoWith[string, uint16](uint16(1), Options{})", func() error { _, err := TryIntoWith[string, uint16](uint16(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchUint16](dispatchUint16(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchUint16](dispatchUint16(1), Options{}); return err }},
+	{"TryIntoWith[string, uint32](uint32(1), Options{})", func() error { _, err := TryIntoWith[string, uint32](uint32(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchUint32](dispatchUint32(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchUint32](dispatchUint32(1), Options{}); return err }},
+	{"TryIntoWith[string, uint64](uint64(1), Options{})", func() error { _, err := TryIntoWith[string, uint64](uint64(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchUint64](dispatchUint64(1), Options{})", func() error { _, err := TryIntoWith[string, dispatchUint64](dispatchUint64(1), Options{}); return err }},
+	{"TryIntoWith[string, float32](float32(1), Options{})", func() error { _, err := TryIntoWith[string, float32](float32(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchFloat32](dispatchFloat32(1), Options{})", func() error {
+		_, err := TryIntoWith[string, dispatchFloat32](dispatchFloat32(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[string, float64](float64(1), Options{})", func() error { _, err := TryIntoWith[string, float64](float64(1), Options{}); return err }},
+	{"TryIntoWith[string, dispatchFloat64](dispatchFloat64(1), Options{})", func() error {
+		_, err := TryIntoWith[string, dispatchFloat64](dispatchFloat64(1), Options{})
+		return err
+	}},
+	{"TryIntoWith[string, string](\"1\", Options{})", func() error { _, err := TryIntoWith[string, string]("1", Options{}); return err }},
+	{"TryIntoWith[string, dispatchString](dispatchString(\"1\"), Options{})", func() error {
+		_, err := TryIntoWith[string, dispatchString](dispatchString("1"), Options{})
+		return err
+	}},
+	{"TryIntoRune[bool](true)", func() error { _, err := TryIntoRune[bool](true); return err }},
+	{"TryIntoRune[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoRune[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoRune[int](int(1))", func() error { _, err := TryIntoRune[int](int(1)); return err }},
+	{"TryIntoRune[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoRune[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoRune[int8](int8(1))", func() error { _, err := TryIntoRune[int8](int8(1)); return err }},
+	{"TryIntoRune[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoRune[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoRune[int16](int16(1))", func() error { _, err := TryIntoRune[int16](int16(1)); return err }},
+	{"TryIntoRune[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoRune[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoRune[int32](int32(1))", func() error { _, err := TryIntoRune[int32](int32(1)); return err }},
+	{"TryIntoRune[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoRune[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoRune[int64](int64(1))", func() error { _, err := TryIntoRune[int64](int64(1)); return err }},
+	{"TryIntoRune[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoRune[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoRune[uint](uint(1))", func() error { _, err := TryIntoRune[uint](uint(1)); return err }},
+	{"TryIntoRune[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoRune[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoRune[uint8](uint8(1))", func() error { _, err := TryIntoRune[uint8](uint8(1)); return err }},
+	{"TryIntoRune[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoRune[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoRune[uint16](uint16(1))", func() error { _, err := TryIntoRune[uint16](uint16(1)); return err }},
+	{"TryIntoRune[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoRune[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoRune[uint32](uint32(1))", func() error { _, err := TryIntoRune[uint32](uint32(1)); return err }},
+	{"TryIntoRune[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoRune[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoRune[uint64](uint64(1))", func() error { _, err := TryIntoRune[uint64](uint64(1)); return err }},
+	{"TryIntoRune[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoRune[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoRune[float32](float32(1))", func() error { _, err := TryIntoRune[float32](float32(1)); return err }},
+	{"TryIntoRune[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoRune[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoRune[float64](float64(1))", func() error { _, err := TryIntoRune[float64](float64(1)); return err }},
+	{"TryIntoRune[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoRune[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoRune[string](\"1\")", func() error { _, err := TryIntoRune[string]("1"); return err }},
+	{"TryIntoRune[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoRune[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoString[bool](true)", func() error { _, err := TryIntoString[bool](true); return err }},
+	{"TryIntoString[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoString[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoString[int](int(1))", func() error { _, err := TryIntoString[int](int(1)); return err }},
+	{"TryIntoString[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoString[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoString[int8](int8(1))", func() error { _, err := TryIntoString[int8](int8(1)); return err }},
+	{"TryIntoString[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoString[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoString[int16](int16(1))", func() error { _, err := TryIntoString[int16](int16(1)); return err }},
+	{"TryIntoString[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoString[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoString[int32](int32(1))", func() error { _, err := TryIntoString[int32](int32(1)); return err }},
+	{"TryIntoString[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoString[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoString[int64](int64(1))", func() error { _, err := TryIntoString[int64](int64(1)); return err }},
+	{"TryIntoString[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoString[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoString[uint](uint(1))", func() error { _, err := TryIntoString[uint](uint(1)); return err }},
+	{"TryIntoString[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoString[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoString[uint8](uint8(1))", func() error { _, err := TryIntoString[uint8](uint8(1)); return err }},
+	{"TryIntoString[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoString[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoString[uint16](uint16(1))", func() error { _, err := TryIntoString[uint16](uint16(1)); return err }},
+	{"TryIntoString[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoString[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoString[uint32](uint32(1))", func() error { _, err := TryIntoString[uint32](uint32(1)); return err }},
+	{"TryIntoString[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoString[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoString[uint64](uint64(1))", func() error { _, err := TryIntoString[uint64](uint64(1)); return err }},
+	{"TryIntoString[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoString[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoString[float32](float32(1))", func() error { _, err := TryIntoString[float32](float32(1)); return err }},
+	{"TryIntoString[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoString[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoString[float64](float64(1))", func() error { _, err := TryIntoString[float64](float64(1)); return err }},
+	{"TryIntoString[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoString[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoString[string](\"1\")", func() error { _, err := TryIntoString[string]("1"); return err }},
+	{"TryIntoString[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoString[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoTime[int](int(1))", func() error { _, err := TryIntoTime[int](int(1)); return err }},
+	{"TryIntoTime[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoTime[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoTime[int8](int8(1))", func() error { _, err := TryIntoTime[int8](int8(1)); return err }},
+	{"TryIntoTime[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoTime[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoTime[int16](int16(1))", func() error { _, err := TryIntoTime[int16](int16(1)); return err }},
+	{"TryIntoTime[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoTime[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoTime[int32](int32(1))", func() error { _, err := TryIntoTime[int32](int32(1)); return err }},
+	{"TryIntoTime[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoTime[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoTime[int64](int64(1))", func() error { _, err := TryIntoTime[int64](int64(1)); return err }},
+	{"TryIntoTime[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoTime[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoTime[uint](uint(1))", func() error { _, err := TryIntoTime[uint](uint(1)); return err }},
+	{"TryIntoTime[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoTime[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoTime[uint8](uint8(1))", func() error { _, err := TryIntoTime[uint8](uint8(1)); return err }},
+	{"TryIntoTime[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoTime[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoTime[uint16](uint16(1))", func() error { _, err := TryIntoTime[uint16](uint16(1)); return err }},
+	{"TryIntoTime[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoTime[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoTime[uint32](uint32(1))", func() error { _, err := TryIntoTime[uint32](uint32(1)); return err }},
+	{"TryIntoTime[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoTime[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoTime[uint64](uint64(1))", func() error { _, err := TryIntoTime[uint64](uint64(1)); return err }},
+	{"TryIntoTime[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoTime[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoTime[float32](float32(1))", func() error { _, err := TryIntoTime[float32](float32(1)); return err }},
+	{"TryIntoTime[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoTime[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoTime[float64](float64(1))", func() error { _, err := TryIntoTime[float64](float64(1)); return err }},
+	{"TryIntoTime[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoTime[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoTime[string](\"1\")", func() error { _, err := TryIntoTime[string]("1"); return err }},
+	{"TryIntoTime[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoTime[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoUint[bool](true)", func() error { _, err := TryIntoUint[bool](true); return err }},
+	{"TryIntoUint[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoUint[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoUint[int](int(1))", func() error { _, err := TryIntoUint[int](int(1)); return err }},
+	{"TryIntoUint[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoUint[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoUint[int8](int8(1))", func() error { _, err := TryIntoUint[int8](int8(1)); return err }},
+	{"TryIntoUint[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoUint[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoUint[int16](int16(1))", func() error { _, err := TryIntoUint[int16](int16(1)); return err }},
+	{"TryIntoUint[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoUint[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoUint[int32](int32(1))", func() error { _, err := TryIntoUint[int32](int32(1)); return err }},
+	{"TryIntoUint[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoUint[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoUint[int64](int64(1))", func() error { _, err := TryIntoUint[int64](int64(1)); return err }},
+	{"TryIntoUint[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoUint[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoUint[uint](uint(1))", func() error { _, err := TryIntoUint[uint](uint(1)); return err }},
+	{"TryIntoUint[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoUint[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoUint[uint8](uint8(1))", func() error { _, err := TryIntoUint[uint8](uint8(1)); return err }},
+	{"TryIntoUint[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoUint[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoUint[uint16](uint16(1))", func() error { _, err := TryIntoUint[uint16](uint16(1)); return err }},
+	{"TryIntoUint[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoUint[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoUint[uint32](uint32(1))", func() error { _, err := TryIntoUint[uint32](uint32(1)); return err }},
+	{"TryIntoUint[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoUint[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoUint[uint64](uint64(1))", func() error { _, err := TryIntoUint[uint64](uint64(1)); return err }},
+	{"TryIntoUint[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoUint[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoUint[float32](float32(1))", func() error { _, err := TryIntoUint[float32](float32(1)); return err }},
+	{"TryIntoUint[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoUint[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoUint[float64](float64(1))", func() error { _, err := TryIntoUint[float64](float64(1)); return err }},
+	{"TryIntoUint[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoUint[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoUint[string](\"1\")", func() error { _, err := TryIntoUint[string]("1"); return err }},
+	{"TryIntoUint[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoUint[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoUint16[bool](true)", func() error { _, err := TryIntoUint16[bool](true); return err }},
+	{"TryIntoUint16[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoUint16[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoUint16[int](int(1))", func() error { _, err := TryIntoUint16[int](int(1)); return err }},
+	{"TryIntoUint16[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoUint16[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoUint16[int8](int8(1))", func() error { _, err := TryIntoUint16[int8](int8(1)); return err }},
+	{"TryIntoUint16[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoUint16[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoUint16[int16](int16(1))", func() error { _, err := TryIntoUint16[int16](int16(1)); return err }},
+	{"TryIntoUint16[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoUint16[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoUint16[int32](int32(1))", func() error { _, err := TryIntoUint16[int32](int32(1)); return err }},
+	{"TryIntoUint16[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoUint16[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoUint16[int64](int64(1))", func() error { _, err := TryIntoUint16[int64](int64(1)); return err }},
+	{"TryIntoUint16[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoUint16[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoUint16[uint](uint(1))", func() error { _, err := TryIntoUint16[uint](uint(1)); return err }},
+	{"TryIntoUint16[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoUint16[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoUint16[uint8](uint8(1))", func() error { _, err := TryIntoUint16[uint8](uint8(1)); return err }},
+	{"TryIntoUint16[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoUint16[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoUint16[uint16](uint16(1))", func() error { _, err := TryIntoUint16[uint16](uint16(1)); return err }},
+	{"TryIntoUint16[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoUint16[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoUint16[uint32](uint32(1))", func() error { _, err := TryIntoUint16[uint32](uint32(1)); return err }},
+	{"TryIntoUint16[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoUint16[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoUint16[uint64](uint64(1))", func() error { _, err := TryIntoUint16[uint64](uint64(1)); return err }},
+	{"TryIntoUint16[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoUint16[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoUint16[float32](float32(1))", func() error { _, err := TryIntoUint16[float32](float32(1)); return err }},
+	{"TryIntoUint16[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoUint16[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoUint16[float64](float64(1))", func() error { _, err := TryIntoUint16[float64](float64(1)); return err }},
+	{"TryIntoUint16[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoUint16[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoUint16[string](\"1\")", func() error { _, err := TryIntoUint16[string]("1"); return err }},
+	{"TryIntoUint16[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoUint16[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoUint32[bool](true)", func() error { _, err := TryIntoUint32[bool](true); return err }},
+	{"TryIntoUint32[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoUint32[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoUint32[int](int(1))", func() error { _, err := TryIntoUint32[int](int(1)); return err }},
+	{"TryIntoUint32[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoUint32[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoUint32[int8](int8(1))", func() error { _, err := TryIntoUint32[int8](int8(1)); return err }},
+	{"TryIntoUint32[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoUint32[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoUint32[int16](int16(1))", func() error { _, err := TryIntoUint32[int16](int16(1)); return err }},
+	{"TryIntoUint32[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoUint32[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoUint32[int32](int32(1))", func() error { _, err := TryIntoUint32[int32](int32(1)); return err }},
+	{"TryIntoUint32[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoUint32[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoUint32[int64](int64(1))", func() error { _, err := TryIntoUint32[int64](int64(1)); return err }},
+	{"TryIntoUint32[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoUint32[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoUint32[uint](uint(1))", func() error { _, err := TryIntoUint32[uint](uint(1)); return err }},
+	{"TryIntoUint32[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoUint32[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoUint32[uint8](uint8(1))", func() error { _, err := TryIntoUint32[uint8](uint8(1)); return err }},
+	{"TryIntoUint32[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoUint32[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoUint32[uint16](uint16(1))", func() error { _, err := TryIntoUint32[uint16](uint16(1)); return err }},
+	{"TryIntoUint32[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoUint32[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoUint32[uint32](uint32(1))", func() error { _, err := TryIntoUint32[uint32](uint32(1)); return err }},
+	{"TryIntoUint32[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoUint32[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoUint32[uint64](uint64(1))", func() error { _, err := TryIntoUint32[uint64](uint64(1)); return err }},
+	{"TryIntoUint32[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoUint32[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoUint32[float32](float32(1))", func() error { _, err := TryIntoUint32[float32](float32(1)); return err }},
+	{"TryIntoUint32[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoUint32[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoUint32[float64](float64(1))", func() error { _, err := TryIntoUint32[float64](float64(1)); return err }},
+	{"TryIntoUint32[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoUint32[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoUint32[string](\"1\")", func() error { _, err := TryIntoUint32[string]("1"); return err }},
+	{"TryIntoUint32[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoUint32[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoUint64[bool](true)", func() error { _, err := TryIntoUint64[bool](true); return err }},
+	{"TryIntoUint64[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoUint64[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoUint64[int](int(1))", func() error { _, err := TryIntoUint64[int](int(1)); return err }},
+	{"TryIntoUint64[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoUint64[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoUint64[int8](int8(1))", func() error { _, err := TryIntoUint64[int8](int8(1)); return err }},
+	{"TryIntoUint64[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoUint64[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoUint64[int16](int16(1))", func() error { _, err := TryIntoUint64[int16](int16(1)); return err }},
+	{"TryIntoUint64[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoUint64[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoUint64[int32](int32(1))", func() error { _, err := TryIntoUint64[int32](int32(1)); return err }},
+	{"TryIntoUint64[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoUint64[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoUint64[int64](int64(1))", func() error { _, err := TryIntoUint64[int64](int64(1)); return err }},
+	{"TryIntoUint64[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoUint64[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoUint64[uint](uint(1))", func() error { _, err := TryIntoUint64[uint](uint(1)); return err }},
+	{"TryIntoUint64[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoUint64[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoUint64[uint8](uint8(1))", func() error { _, err := TryIntoUint64[uint8](uint8(1)); return err }},
+	{"TryIntoUint64[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoUint64[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoUint64[uint16](uint16(1))", func() error { _, err := TryIntoUint64[uint16](uint16(1)); return err }},
+	{"TryIntoUint64[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoUint64[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoUint64[uint32](uint32(1))", func() error { _, err := TryIntoUint64[uint32](uint32(1)); return err }},
+	{"TryIntoUint64[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoUint64[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoUint64[uint64](uint64(1))", func() error { _, err := TryIntoUint64[uint64](uint64(1)); return err }},
+	{"TryIntoUint64[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoUint64[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoUint64[float32](float32(1))", func() error { _, err := TryIntoUint64[float32](float32(1)); return err }},
+	{"TryIntoUint64[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoUint64[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoUint64[float64](float64(1))", func() error { _, err := TryIntoUint64[float64](float64(1)); return err }},
+	{"TryIntoUint64[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoUint64[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoUint64[string](\"1\")", func() error { _, err := TryIntoUint64[string]("1"); return err }},
+	{"TryIntoUint64[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoUint64[dispatchString](dispatchString("1")); return err }},
+	{"TryIntoUint8[bool](true)", func() error { _, err := TryIntoUint8[bool](true); return err }},
+	{"TryIntoUint8[dispatchBool](dispatchBool(true))", func() error { _, err := TryIntoUint8[dispatchBool](dispatchBool(true)); return err }},
+	{"TryIntoUint8[int](int(1))", func() error { _, err := TryIntoUint8[int](int(1)); return err }},
+	{"TryIntoUint8[dispatchInt](dispatchInt(1))", func() error { _, err := TryIntoUint8[dispatchInt](dispatchInt(1)); return err }},
+	{"TryIntoUint8[int8](int8(1))", func() error { _, err := TryIntoUint8[int8](int8(1)); return err }},
+	{"TryIntoUint8[dispatchInt8](dispatchInt8(1))", func() error { _, err := TryIntoUint8[dispatchInt8](dispatchInt8(1)); return err }},
+	{"TryIntoUint8[int16](int16(1))", func() error { _, err := TryIntoUint8[int16](int16(1)); return err }},
+	{"TryIntoUint8[dispatchInt16](dispatchInt16(1))", func() error { _, err := TryIntoUint8[dispatchInt16](dispatchInt16(1)); return err }},
+	{"TryIntoUint8[int32](int32(1))", func() error { _, err := TryIntoUint8[int32](int32(1)); return err }},
+	{"TryIntoUint8[dispatchInt32](dispatchInt32(1))", func() error { _, err := TryIntoUint8[dispatchInt32](dispatchInt32(1)); return err }},
+	{"TryIntoUint8[int64](int64(1))", func() error { _, err := TryIntoUint8[int64](int64(1)); return err }},
+	{"TryIntoUint8[dispatchInt64](dispatchInt64(1))", func() error { _, err := TryIntoUint8[dispatchInt64](dispatchInt64(1)); return err }},
+	{"TryIntoUint8[uint](uint(1))", func() error { _, err := TryIntoUint8[uint](uint(1)); return err }},
+	{"TryIntoUint8[dispatchUint](dispatchUint(1))", func() error { _, err := TryIntoUint8[dispatchUint](dispatchUint(1)); return err }},
+	{"TryIntoUint8[uint8](uint8(1))", func() error { _, err := TryIntoUint8[uint8](uint8(1)); return err }},
+	{"TryIntoUint8[dispatchUint8](dispatchUint8(1))", func() error { _, err := TryIntoUint8[dispatchUint8](dispatchUint8(1)); return err }},
+	{"TryIntoUint8[uint16](uint16(1))", func() error { _, err := TryIntoUint8[uint16](uint16(1)); return err }},
+	{"TryIntoUint8[dispatchUint16](dispatchUint16(1))", func() error { _, err := TryIntoUint8[dispatchUint16](dispatchUint16(1)); return err }},
+	{"TryIntoUint8[uint32](uint32(1))", func() error { _, err := TryIntoUint8[uint32](uint32(1)); return err }},
+	{"TryIntoUint8[dispatchUint32](dispatchUint32(1))", func() error { _, err := TryIntoUint8[dispatchUint32](dispatchUint32(1)); return err }},
+	{"TryIntoUint8[uint64](uint64(1))", func() error { _, err := TryIntoUint8[uint64](uint64(1)); return err }},
+	{"TryIntoUint8[dispatchUint64](dispatchUint64(1))", func() error { _, err := TryIntoUint8[dispatchUint64](dispatchUint64(1)); return err }},
+	{"TryIntoUint8[float32](float32(1))", func() error { _, err := TryIntoUint8[float32](float32(1)); return err }},
+	{"TryIntoUint8[dispatchFloat32](dispatchFloat32(1))", func() error { _, err := TryIntoUint8[dispatchFloat32](dispatchFloat32(1)); return err }},
+	{"TryIntoUint8[float64](float64(1))", func() error { _, err := TryIntoUint8[float64](float64(1)); return err }},
+	{"TryIntoUint8[dispatchFloat64](dispatchFloat64(1))", func() error { _, err := TryIntoUint8[dispatchFloat64](dispatchFloat64(1)); return err }},
+	{"TryIntoUint8[string](\"1\")", func() error { _, err := TryIntoUint8[string]("1"); return err }},
+	{"TryIntoUint8[dispatchString](dispatchString(\"1\"))", func() error { _, err := TryIntoUint8[dispatchString](dispatchString("1")); return err }},
+}
+
+// TestDispatchConsistency checks that every type accepted by the constraint
+// of a dispatcher is also accepted by its dispatch switch at run time.
+func TestDispatchConsistency(t *testing.T) {
+	for _, tt := range dispatchCalls {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); errors.Is(err, ErrUnsupportedType) {
+				t.Errorf("error = %v; the constraint accepts a type the dispatch rejects", err)
+			}
+		})
+	}
+}
@@ -21,6 +21,25 @@ Basic Usage:
 	// Direct conversion (faster, no reflection)
 	val, err := into.Float64ToInt32(someFloat64)
 
+	// Conversion that cannot fail, without an error to handle
+	wide := into.Int32IntoInt64(someInt32)
+
+Conversions that cannot fail, such as widening an integer or converting a
+bool or a number to a string, have an XxxIntoYyy function that returns a
+single value. Their XxxToYyy functions, whose error is always nil, are kept
+for compatibility and deprecated.
+
+Errors:
+
+Range failures are reported as a *ConversionError that records the source
+and target types and wraps ErrOverflow or ErrUnderflow, so callers can tell
+the direction apart with errors.Is:
+
+	_, err := into.IntToInt8(300)
+	if errors.Is(err, into.ErrOverflow) {
+	    // Handle the too large value
+	}
+
 File Structure:
 
 Each file is named after its target type (e.g., int32.go contains conversions to int32)
@@ -34,3 +53,7 @@ performance-critical code, use the direct conversion functions (e.g., Float64ToI
 For more information and examples, see: https://github.com/zenless-lab/into
 */
 package into
+
+//go:generate go run ./internal/convgen
+//go:generate go run ./internal/matrixgen -output matrix_test.go
+//go:generate go run ./internal/dispatchgen -output dispatch_test.go
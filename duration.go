@@ -0,0 +1,318 @@
+package into
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationIntoSecondsFloat64 converts a time.Duration value to seconds as a float64.
+//
+// DurationIntoSecondsFloat64 converts a time.Duration value to a float64
+// number of seconds, with the sub-second part as the fraction. Every
+// time.Duration value has a float64 number of seconds, so the conversion
+// cannot fail.
+//
+// Parameters:
+//   - value: the time.Duration value to be converted.
+//
+// Returns:
+//   - float64: the number of seconds.
+//
+// Example:
+//
+//	fmt.Println(DurationIntoSecondsFloat64(1500 * time.Millisecond)) // Output: 1.5
+func DurationIntoSecondsFloat64(value time.Duration) float64 {
+	return value.Seconds()
+}
+
+// DurationToSecondsFloat64 converts a time.Duration value to seconds as a float64.
+//
+// DurationToSecondsFloat64 converts a time.Duration value to seconds like
+// DurationIntoSecondsFloat64. The conversion cannot fail, so the error is
+// always nil.
+//
+// Parameters:
+//   - value: the time.Duration value to be converted.
+//
+// Returns:
+//   - float64: the number of seconds.
+//   - error: nil.
+//
+// Deprecated: Use DurationIntoSecondsFloat64, which has no error to handle.
+func DurationToSecondsFloat64(value time.Duration) (float64, error) {
+	return DurationIntoSecondsFloat64(value), nil
+}
+
+// SecondsFloat64ToDuration converts a float64 number of seconds to a time.Duration value.
+//
+// SecondsFloat64ToDuration converts a float64 number of seconds to a
+// time.Duration value, rounding to the nearest nanosecond.
+//
+// Parameters:
+//   - value: the number of seconds.
+//
+// Returns:
+//   - time.Duration: the converted time.Duration value.
+//   - error: a *ConversionError wrapping ErrNaN if the value is NaN, or
+//     ErrOverflow or ErrUnderflow if the value is out of the time.Duration
+//     range, which is approximately ±292 years.
+//
+// Example:
+//
+//	result, err := SecondsFloat64ToDuration(1.5)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1.5s
+func SecondsFloat64ToDuration(value float64) (time.Duration, error) {
+	if math.IsNaN(value) {
+		return 0, nanError(value, "time.Duration")
+	}
+	nanos := math.Round(value * float64(time.Second))
+	if nanos >= math.MaxInt64+1 {
+		return 0, overflowError(value, "time.Duration")
+	}
+	if nanos < math.MinInt64 {
+		return 0, underflowError(value, "time.Duration")
+	}
+	return time.Duration(nanos), nil
+}
+
+// DurationIntoMillisInt64 converts a time.Duration value to milliseconds as an int64.
+//
+// DurationIntoMillisInt64 converts a time.Duration value to an int64 number
+// of milliseconds, truncating the sub-millisecond part toward zero. The
+// conversion cannot fail.
+//
+// Parameters:
+//   - value: the time.Duration value to be converted.
+//
+// Returns:
+//   - int64: the number of milliseconds.
+//
+// Example:
+//
+//	fmt.Println(DurationIntoMillisInt64(1500 * time.Millisecond)) // Output: 1500
+func DurationIntoMillisInt64(value time.Duration) int64 {
+	return value.Milliseconds()
+}
+
+// DurationToMillisInt64 converts a time.Duration value to milliseconds as an int64.
+//
+// DurationToMillisInt64 converts a time.Duration value to milliseconds like
+// DurationIntoMillisInt64. The conversion cannot fail, so the error is always
+// nil.
+//
+// Parameters:
+//   - value: the time.Duration value to be converted.
+//
+// Returns:
+//   - int64: the number of milliseconds.
+//   - error: nil.
+//
+// Deprecated: Use DurationIntoMillisInt64, which has no error to handle.
+func DurationToMillisInt64(value time.Duration) (int64, error) {
+	return DurationIntoMillisInt64(value), nil
+}
+
+// MillisInt64ToDuration converts an int64 number of milliseconds to a time.Duration value.
+//
+// MillisInt64ToDuration converts an int64 number of milliseconds to a
+// time.Duration value.
+//
+// Parameters:
+//   - value: the number of milliseconds.
+//
+// Returns:
+//   - time.Duration: the converted time.Duration value.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if the
+//     value is out of the time.Duration range.
+//
+// Example:
+//
+//	result, err := MillisInt64ToDuration(1500)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1.5s
+func MillisInt64ToDuration(value int64) (time.Duration, error) {
+	if value > math.MaxInt64/int64(time.Millisecond) {
+		return 0, overflowError(value, "time.Duration")
+	}
+	if value < math.MinInt64/int64(time.Millisecond) {
+		return 0, underflowError(value, "time.Duration")
+	}
+	return time.Duration(value) * time.Millisecond, nil
+}
+
+// ISO8601StringToDuration converts an ISO 8601 duration string to a time.Duration value.
+//
+// ISO8601StringToDuration converts an ISO 8601 duration such as "PT1H30M",
+// "P2DT3H" or "PT0.5S" to a time.Duration value. Weeks (W) count as 7 days
+// and days (D) as 24 hours. Years and months are rejected, as their length
+// depends on the calendar. The last component may have a decimal fraction,
+// written with "." or ","; digits beyond nanoseconds are truncated. A leading
+// "-" negates the duration.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - time.Duration: the converted time.Duration value.
+//   - error: an error if the string is not a valid ISO 8601 duration, or a
+//     *ConversionError wrapping ErrOverflow or ErrUnderflow if it is out of
+//     the time.Duration range.
+//
+// Example:
+//
+//	result, err := ISO8601StringToDuration("P1DT1H30M")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 25h30m0s
+func ISO8601StringToDuration(value string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid ISO 8601 duration %q", value)
+
+	s := value
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	if len(s) < 2 || s[0] != 'P' {
+		return 0, invalid
+	}
+	s = s[1:]
+
+	total := new(big.Rat)
+	units, next, components, inTime := iso8601DateUnits, 0, 0, false
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return 0, invalid
+			}
+			units, next, inTime, s = iso8601TimeUnits, 0, true, s[1:]
+			continue
+		}
+
+		i := 0
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.' || s[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, invalid
+		}
+		number := strings.Replace(s[:i], ",", ".", 1)
+		if number[0] == '.' || number[len(number)-1] == '.' || strings.Count(number, ".") > 1 {
+			return 0, invalid
+		}
+
+		// Designators must appear in order, each at most once.
+		j := next
+		for j < len(units) && units[j].designator != s[i] {
+			j++
+		}
+		if j == len(units) {
+			return 0, invalid
+		}
+		if units[j].unit == 0 {
+			return 0, fmt.Errorf("ISO 8601 duration %q: years and months have no fixed length", value)
+		}
+		next, s, components = j+1, s[i+1:], components+1
+
+		r, ok := new(big.Rat).SetString(number)
+		if !ok {
+			return 0, invalid
+		}
+		if strings.Contains(number, ".") && s != "" {
+			return 0, invalid
+		}
+		total.Add(total, r.Mul(r, new(big.Rat).SetInt64(int64(units[j].unit))))
+	}
+	if components == 0 {
+		return 0, invalid
+	}
+
+	nanos := new(big.Int).Quo(total.Num(), total.Denom())
+	if negative {
+		nanos.Neg(nanos)
+	}
+	if !nanos.IsInt64() {
+		if negative {
+			return 0, underflowError(value, "time.Duration")
+		}
+		return 0, overflowError(value, "time.Duration")
+	}
+	return time.Duration(nanos.Int64()), nil
+}
+
+// DurationToISO8601String converts a time.Duration value to an ISO 8601 duration string.
+//
+// DurationToISO8601String converts a time.Duration value to an ISO 8601
+// duration using hours, minutes and seconds, e.g. "PT1H30M" or "PT0.5S".
+// Days are not used, since a calendar day is not always 24 hours long.
+// The zero duration is "PT0S" and negative durations have a leading "-".
+//
+// Parameters:
+//   - value: the time.Duration value to be converted.
+//
+// Returns:
+//   - string: the ISO 8601 duration.
+//   - error: nil.
+//
+// Example:
+//
+//	result, err := DurationToISO8601String(90 * time.Minute)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: PT1H30M
+func DurationToISO8601String(value time.Duration) (string, error) {
+	if value == 0 {
+		return "PT0S", nil
+	}
+
+	var b strings.Builder
+	// The magnitude is computed as uint64 so math.MinInt64 does not overflow.
+	u := uint64(value)
+	if value < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+
+	hours, u := u/uint64(time.Hour), u%uint64(time.Hour)
+	minutes, u := u/uint64(time.Minute), u%uint64(time.Minute)
+	seconds, nanos := u/uint64(time.Second), u%uint64(time.Second)
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if seconds > 0 || nanos > 0 {
+		b.WriteString(strconv.FormatUint(seconds, 10))
+		if nanos > 0 {
+			b.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0"))
+		}
+		b.WriteByte('S')
+	}
+	return b.String(), nil
+}
+
+// iso8601Unit is a designator of an ISO 8601 duration and its length.
+type iso8601Unit struct {
+	designator byte
+	// unit is zero for designators without a fixed length.
+	unit time.Duration
+}
+
+var (
+	// iso8601DateUnits are the designators before "T", in order.
+	iso8601DateUnits = []iso8601Unit{{'Y', 0}, {'M', 0}, {'W', 7 * 24 * time.Hour}, {'D', 24 * time.Hour}}
+	// iso8601TimeUnits are the designators after "T", in order.
+	iso8601TimeUnits = []iso8601Unit{{'H', time.Hour}, {'M', time.Minute}, {'S', time.Second}}
+)
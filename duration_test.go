@@ -0,0 +1,143 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestDurationUnits(t *testing.T) {
+	if got := DurationIntoSecondsFloat64(1500 * time.Millisecond); got != 1.5 {
+		t.Errorf("DurationIntoSecondsFloat64(1.5s) = %v, want 1.5", got)
+	}
+	if got := DurationIntoMillisInt64(-1999 * time.Microsecond); got != -1 {
+		t.Errorf("DurationIntoMillisInt64(-1.999ms) = %v, want -1", got)
+	}
+
+	tests := []struct {
+		name    string
+		convert func() (time.Duration, error)
+		want    time.Duration
+		wantErr error
+	}{
+		{"Seconds", func() (time.Duration, error) { return SecondsFloat64ToDuration(1.5) }, 1500 * time.Millisecond, nil},
+		{"SecondsNanos", func() (time.Duration, error) { return SecondsFloat64ToDuration(1e-9) }, 1, nil},
+		{"SecondsNaN", func() (time.Duration, error) { return SecondsFloat64ToDuration(math.NaN()) }, 0, ErrNaN},
+		{"SecondsOverflow", func() (time.Duration, error) { return SecondsFloat64ToDuration(1e10) }, 0, ErrOverflow},
+		{"SecondsUnderflow", func() (time.Duration, error) { return SecondsFloat64ToDuration(-1e10) }, 0, ErrUnderflow},
+		{"Millis", func() (time.Duration, error) { return MillisInt64ToDuration(-1500) }, -1500 * time.Millisecond, nil},
+		{"MillisMax", func() (time.Duration, error) { return MillisInt64ToDuration(math.MaxInt64 / int64(time.Millisecond)) }, time.Duration(math.MaxInt64 / int64(time.Millisecond) * int64(time.Millisecond)), nil},
+		{"MillisOverflow", func() (time.Duration, error) { return MillisInt64ToDuration(math.MaxInt64/int64(time.Millisecond) + 1) }, 0, ErrOverflow},
+		{"MillisUnderflow", func() (time.Duration, error) { return MillisInt64ToDuration(math.MinInt64/int64(time.Millisecond) - 1) }, 0, ErrUnderflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var convErr *ConversionError
+	if _, err := SecondsFloat64ToDuration(math.NaN()); !errors.As(err, &convErr) || convErr.From != "float64" || convErr.To != "time.Duration" {
+		t.Errorf("SecondsFloat64ToDuration(NaN) error = %v, want a ConversionError from float64 to time.Duration", err)
+	}
+}
+
+func TestDurationDispatch(t *testing.T) {
+	if got, err := TryInto[string](90 * time.Minute); err != nil || got != "1h30m0s" {
+		t.Errorf("TryInto[string](90m) = %q, %v", got, err)
+	}
+	if got, err := TryInto[time.Duration]("1h30m"); err != nil || got != 90*time.Minute {
+		t.Errorf("TryInto[time.Duration](\"1h30m\") = %v, %v", got, err)
+	}
+	if got, err := TryInto[int64](time.Second); err != nil || got != 1e9 {
+		t.Errorf("TryInto[int64](1s) = %v, %v", got, err)
+	}
+	if got, err := TryInto[time.Duration](uint16(500)); err != nil || got != 500 {
+		t.Errorf("TryInto[time.Duration](500) = %v, %v", got, err)
+	}
+	if _, err := TryInto[time.Duration](uint64(math.MaxUint64)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TryInto[time.Duration](MaxUint64) error = %v, want ErrOverflow", err)
+	}
+	if got, err := TryInto[int8](time.Duration(100)); err != nil || got != 100 {
+		t.Errorf("TryInto[int8](100ns) = %v, %v", got, err)
+	}
+	if got, err := TryIntoAny[string](time.Second); err != nil || got != "1s" {
+		t.Errorf("TryIntoAny[string](1s) = %q, %v", got, err)
+	}
+	if got, err := TryIntoWith[time.Duration](" ", Options{TrimSpace: true, Empty: EmptyZero}); err != nil || got != 0 {
+		t.Errorf("TryIntoWith[time.Duration](\" \") = %v, %v", got, err)
+	}
+
+	var d time.Duration
+	if err := TryIntoValue(reflect.ValueOf(&d).Elem(), reflect.ValueOf("2s")); err != nil || d != 2*time.Second {
+		t.Errorf("TryIntoValue(time.Duration, \"2s\") = %v, %v", d, err)
+	}
+}
+
+func TestISO8601Duration(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"hoursMinutes", "PT1H30M", 90 * time.Minute, false},
+		{"daysHours", "P2DT3H", 51 * time.Hour, false},
+		{"weeks", "P1W", 7 * 24 * time.Hour, false},
+		{"fractionSeconds", "PT0.5S", 500 * time.Millisecond, false},
+		{"fractionComma", "PT1,25H", 75 * time.Minute, false},
+		{"nanos", "PT0.000000001S", 1, false},
+		{"truncated", "PT0.0000000019S", 1, false},
+		{"negative", "-PT1M", -time.Minute, false},
+		{"zero", "PT0S", 0, false},
+		{"empty", "P", 0, true},
+		{"emptyTime", "P1DT", 0, true},
+		{"years", "P1Y", 0, true},
+		{"months", "P1M", 0, true},
+		{"outOfOrder", "PT1M1H", 0, true},
+		{"repeated", "PT1H1H", 0, true},
+		{"fractionNotLast", "PT1.5H30M", 0, true},
+		{"missingDesignator", "PT15", 0, true},
+		{"goSyntax", "1h30m", 0, true},
+		{"overflow", "PT2562048H", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ISO8601StringToDuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ISO8601StringToDuration() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ISO8601StringToDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, d := range []time.Duration{0, 1, time.Second, 90 * time.Minute, 51*time.Hour + 500*time.Millisecond, -time.Minute, math.MaxInt64, math.MinInt64} {
+		s, err := DurationToISO8601String(d)
+		if err != nil {
+			t.Errorf("DurationToISO8601String(%v) error = %v", d, err)
+			continue
+		}
+		if back, err := StringToDuration(s); err != nil || back != d {
+			t.Errorf("StringToDuration(%q) = %v, %v, want %v", s, back, err, d)
+		}
+	}
+	if got, _ := DurationToISO8601String(90*time.Minute + 1500*time.Millisecond); got != "PT1H30M1.5S" {
+		t.Errorf("DurationToISO8601String(1h30m1.5s) = %q, want PT1H30M1.5S", got)
+	}
+}
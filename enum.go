@@ -0,0 +1,165 @@
+package into
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Enum maps the names of a set of integer constants to their values.
+//
+// Enum converts API payloads such as "active" to typed constants declared
+// with iota, and back. It is created with NewEnum and is safe for concurrent
+// use once all aliases have been added.
+type Enum[T Int | Uint] struct {
+	names  map[string]T
+	folded map[string]T
+	values map[T]string
+}
+
+// NewEnum creates an Enum from the canonical names of its values.
+//
+// NewEnum creates an Enum with the given canonical names and registers its
+// StringToEnum and EnumToString methods with Register, so TryInto and the
+// other generic conversion functions convert between strings and T. The
+// methods are not registered if T is a predeclared type such as int. Names
+// are matched exactly first and then case-insensitively. Use Alias to accept
+// other names for a value.
+//
+// NewEnum panics if two names have the same value or only differ in case,
+// since the mapping would then be ambiguous. It is meant to be called when
+// a package is initialized.
+//
+// Parameters:
+//   - names: the canonical name of each value.
+//
+// Returns:
+//   - *Enum[T]: the enum.
+//
+// Example:
+//
+//	type Status int
+//
+//	const (
+//	  Active Status = iota
+//	  Suspended
+//	)
+//
+//	var statuses = NewEnum(map[string]Status{"active": Active, "suspended": Suspended}).
+//	  Alias("enabled", Active)
+//
+//	result, _ := TryInto[Status]("Enabled")
+//	fmt.Println(result == Active) // Output: true
+func NewEnum[T Int | Uint](names map[string]T) *Enum[T] {
+	e := newEnum(names)
+	// Registering a predeclared type such as int would change the conversion
+	// of every int, so only defined types are registered.
+	if typeOf[T]().PkgPath() != "" {
+		Register(e.StringToEnum)
+		Register(e.EnumToString)
+	}
+	return e
+}
+
+// newEnum creates an Enum without registering it.
+func newEnum[T Int | Uint](names map[string]T) *Enum[T] {
+	e := &Enum[T]{
+		names:  make(map[string]T, len(names)),
+		folded: make(map[string]T, len(names)),
+		values: make(map[T]string, len(names)),
+	}
+	for name, value := range names {
+		if other, ok := e.values[value]; ok {
+			panic(fmt.Sprintf("into: enum names %q and %q have the same value %v", other, name, value))
+		}
+		e.values[value] = name
+		e.add(name, value)
+	}
+	return e
+}
+
+// Alias adds another name for a value of the enum.
+//
+// Alias adds name as an alternative spelling of value, accepted by
+// StringToEnum but never returned by EnumToString. It panics if value has no
+// canonical name or if name is already used. It returns e so that calls can
+// be chained.
+//
+// Parameters:
+//   - name: the alternative name.
+//   - value: the value the name stands for.
+//
+// Returns:
+//   - *Enum[T]: the enum.
+func (e *Enum[T]) Alias(name string, value T) *Enum[T] {
+	if _, ok := e.values[value]; !ok {
+		panic(fmt.Sprintf("into: enum alias %q has the unknown value %v", name, value))
+	}
+	e.add(name, value)
+	return e
+}
+
+// add adds name for value, panicking if it is already used.
+func (e *Enum[T]) add(name string, value T) {
+	folded := strings.ToLower(name)
+	if _, ok := e.folded[folded]; ok {
+		panic(fmt.Sprintf("into: enum name %q is used twice", name))
+	}
+	e.names[name] = value
+	e.folded[folded] = value
+}
+
+// StringToEnum converts the given name to the value of the enum.
+//
+// StringToEnum converts a canonical name or an alias to its value. An exact
+// match is tried first, then a case-insensitive one.
+//
+// Parameters:
+//   - value: the name to be converted.
+//
+// Returns:
+//   - T: the value of the name.
+//   - error: a *ConversionError wrapping ErrUnknownEnum if the name is not
+//     defined.
+//
+// Example:
+//
+//	result, err := statuses.StringToEnum("SUSPENDED")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result == Suspended) // Output: true
+func (e *Enum[T]) StringToEnum(value string) (T, error) {
+	if v, ok := e.names[value]; ok {
+		return v, nil
+	}
+	if v, ok := e.folded[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+	return 0, &ConversionError{From: "string", To: typeOf[T]().String(), Value: value, Err: ErrUnknownEnum}
+}
+
+// EnumToString converts the given value of the enum to its canonical name.
+//
+// EnumToString converts a value to the canonical name given to NewEnum.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - string: the canonical name of the value.
+//   - error: a *ConversionError wrapping ErrUnknownEnum if the value has no
+//     name.
+//
+// Example:
+//
+//	result, err := statuses.EnumToString(Active)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: active
+func (e *Enum[T]) EnumToString(value T) (string, error) {
+	if name, ok := e.values[value]; ok {
+		return name, nil
+	}
+	return "", &ConversionError{From: typeOf[T]().String(), To: "string", Value: value, Err: ErrUnknownEnum}
+}
@@ -0,0 +1,87 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+type status uint8
+
+const (
+	active status = iota
+	suspended
+	deleted
+)
+
+// level is registered by the enums of TestNewEnumPanics, so that they do
+// not replace the converters of statuses.
+type level int
+
+var statuses = NewEnum(map[string]status{"active": active, "suspended": suspended}).
+	Alias("enabled", active)
+
+func TestEnum(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    status
+		wantErr error
+	}{
+		{"exact", "suspended", suspended, nil},
+		{"caseInsensitive", "Suspended", suspended, nil},
+		{"alias", "ENABLED", active, nil},
+		{"unknown", "archived", 0, ErrUnknownEnum},
+		{"empty", "", 0, ErrUnknownEnum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := statuses.StringToEnum(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StringToEnum() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToEnum() = %v, want %v", got, tt.want)
+			}
+
+			// The registered converter must agree with the method.
+			got, err = TryInto[status](tt.input)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("TryInto() = %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+
+	if got, err := TryInto[string](active); err != nil || got != "active" {
+		t.Errorf("TryInto[string](active) = %q, %v, want %q", got, err, "active")
+	}
+	if _, err := statuses.EnumToString(deleted); !errors.Is(err, ErrUnknownEnum) {
+		t.Errorf("EnumToString(deleted) error = %v, want %v", err, ErrUnknownEnum)
+	}
+}
+
+func TestNewEnumPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"sameValue", func() { NewEnum(map[string]level{"a": 1, "b": 1}) }},
+		{"caseOnly", func() { NewEnum(map[string]level{"a": 1, "A": 2}) }},
+		{"aliasUsed", func() { NewEnum(map[string]level{"a": 1, "b": 2}).Alias("B", 1) }},
+		{"aliasUnknownValue", func() { NewEnum(map[string]level{"a": 1}).Alias("c", 3) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
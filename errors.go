@@ -0,0 +1,198 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrImplicitCoercion is wrapped by a ConversionError when the Strict option
+// rejects an implicit conversion between bools and numbers, such as true
+// converted to an int.
+var ErrImplicitCoercion = errors.New("implicit conversion between bool and number")
+
+// ErrInvalidRune is wrapped by a ConversionError when a value is not a
+// legal Unicode code point, such as a surrogate half or a value above
+// U+10FFFF.
+var ErrInvalidRune = errors.New("invalid Unicode code point")
+
+// ErrInvalidUTF8 is wrapped by a ConversionError when a string or a byte
+// slice is not valid UTF-8 and invalid sequences are rejected.
+var ErrInvalidUTF8 = errors.New("invalid UTF-8")
+
+// ErrLength is wrapped by a ConversionError when a byte slice does not have
+// the length of the target type, such as 3 bytes converted to a uint32.
+var ErrLength = errors.New("byte slice has the wrong length")
+
+// ErrLossOfPrecision is returned by the exact converters when the value
+// cannot be represented in the target type without losing information,
+// such as a float with a fractional component converted to an integer.
+//
+// Use errors.Is to test for it:
+//
+//	if errors.Is(err, ErrLossOfPrecision) {
+//	  // Handle the lossy input
+//	}
+var ErrLossOfPrecision = errors.New("loss of precision")
+
+// ErrMissing is returned when a value to be converted is absent, such as a
+// header that is not present in an http.Header.
+var ErrMissing = errors.New("missing value")
+
+// ErrNaN is returned, or wrapped by a ConversionError, when a NaN float is
+// converted to a type that cannot represent it, such as an integer type.
+var ErrNaN = errors.New("NaN cannot be converted")
+
+// ErrNil is returned when a nil value or a nil pointer is converted by a
+// function that accepts values of any type, such as TryIntoAny.
+var ErrNil = errors.New("nil value cannot be converted")
+
+// ErrOverflow is wrapped by a ConversionError when a value is greater than
+// the maximum value of the target type.
+var ErrOverflow = errors.New("value exceeds the maximum of the target type")
+
+// ErrUnknownEnum is wrapped by a ConversionError when a name or a value is
+// not defined by an Enum.
+var ErrUnknownEnum = errors.New("unknown enum name or value")
+
+// ErrUnderflow is wrapped by a ConversionError when a value is less than
+// the minimum value of the target type, such as a negative value converted
+// to an unsigned type.
+var ErrUnderflow = errors.New("value is below the minimum of the target type")
+
+// ErrUnsupportedType is wrapped by an UnsupportedTypeError when no
+// conversion exists between the source and target types, such as a bool
+// converted to a time.Time.
+var ErrUnsupportedType = errors.New("unsupported type")
+
+// ConversionError describes a failed conversion.
+//
+// ConversionError records the source and target types, the value that
+// failed to convert and the reason. Use errors.Is on the error to test the
+// reason (e.g. ErrOverflow or ErrUnderflow), or errors.As to inspect it:
+//
+//	var convErr *ConversionError
+//	if errors.As(err, &convErr) {
+//	  fmt.Println(convErr.From, convErr.To) // Output: int int8
+//	}
+type ConversionError struct {
+	// From is the name of the source type.
+	From string
+	// To is the name of the target type.
+	To string
+	// Value is the value that failed to convert.
+	Value any
+	// Err is the reason of the failure.
+	Err error
+}
+
+// Error returns the error message, translated by the catalog set by
+// SetCatalog.
+func (e *ConversionError) Error() string {
+	return message(e)
+}
+
+// Unwrap returns the reason of the failure.
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// UnsupportedTypeError describes a conversion between two types that are
+// not convertible to each other.
+//
+// UnsupportedTypeError names both types, as in "cannot convert bool to
+// time.Time". It wraps ErrUnsupportedType, so errors.Is can test for it
+// regardless of the types involved.
+type UnsupportedTypeError struct {
+	// From is the name of the source type.
+	From string
+	// To is the name of the target type.
+	To string
+}
+
+// Error returns the error message, translated by the catalog set by
+// SetCatalog.
+func (e *UnsupportedTypeError) Error() string {
+	return message(e)
+}
+
+// Unwrap returns ErrUnsupportedType.
+func (e *UnsupportedTypeError) Unwrap() error {
+	return ErrUnsupportedType
+}
+
+// unsupportedError returns an UnsupportedTypeError for the type of value.
+func unsupportedError(value any, to string) error {
+	return &UnsupportedTypeError{From: fmt.Sprintf("%T", value), To: to}
+}
+
+// overflowError returns a ConversionError wrapping ErrOverflow, with the
+// range of the target type if it is a numeric basic type.
+func overflowError(value any, to string) error {
+	return &ConversionError{From: reflect.TypeOf(value).String(), To: to, Value: value, Err: rangeError(ErrOverflow, to)}
+}
+
+// nanError returns a ConversionError wrapping ErrNaN.
+func nanError(value any, to string) error {
+	return &ConversionError{From: reflect.TypeOf(value).String(), To: to, Value: value, Err: ErrNaN}
+}
+
+// underflowError returns a ConversionError wrapping ErrUnderflow, with the
+// range of the target type if it is a numeric basic type.
+func underflowError(value any, to string) error {
+	return &ConversionError{From: reflect.TypeOf(value).String(), To: to, Value: value, Err: rangeError(ErrUnderflow, to)}
+}
+
+// rangeError returns reason with the range of the basic type named to, as in
+// "value exceeds the maximum of the target type [-128, 127]" for int8, or
+// reason itself if to does not name a numeric basic type.
+func rangeError(reason error, to string) error {
+	for kind, bounds := range kindBounds {
+		if kind.String() == to {
+			return fmt.Errorf("%w [%v, %v]", reason, bounds[0], bounds[1])
+		}
+	}
+	return reason
+}
+
+// coercionError returns a ConversionError wrapping ErrImplicitCoercion.
+func coercionError(value any, to string) error {
+	return &ConversionError{From: reflect.TypeOf(value).String(), To: to, Value: value, Err: ErrImplicitCoercion}
+}
+
+// isNegativeInteger reports whether s is a decimal integer below zero, such
+// as "-5".
+func isNegativeInteger(s string) bool {
+	return strings.HasPrefix(s, "-") && isDigits(s[1:]) && strings.Trim(s[1:], "0") != ""
+}
+
+// lengthError returns a ConversionError wrapping ErrLength.
+func lengthError(value []byte, want int, to string) error {
+	return &ConversionError{From: "[]byte", To: to, Value: value, Err: fmt.Errorf("%w: got %d, want %d", ErrLength, len(value), want)}
+}
+
+// parseError converts an error returned by a strconv parser into a
+// ConversionError to the type named to.
+//
+// A value out of range wraps ErrOverflow or ErrUnderflow, depending on its
+// sign, and states the range of the target type. strconv.ParseUint rejects
+// negative integers such as "-5" as a syntax error; they wrap ErrUnderflow
+// as well, so the Saturate policy clamps them to 0. Any other failure wraps
+// the reason of the strconv error, such as strconv.ErrSyntax.
+func parseError(err error, value string, to string) error {
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		return err
+	}
+	if !errors.Is(numErr.Err, strconv.ErrRange) && !(numErr.Func == "ParseUint" && isNegativeInteger(value)) {
+		return &ConversionError{From: "string", To: to, Value: value, Err: numErr.Err}
+	}
+
+	reason := ErrOverflow
+	if strings.HasPrefix(value, "-") {
+		reason = ErrUnderflow
+	}
+	return &ConversionError{From: "string", To: to, Value: value, Err: rangeError(reason, to)}
+}
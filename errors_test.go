@@ -0,0 +1,166 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestConversionErrorDirection(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() error
+		want    error
+		from    string
+		to      string
+	}{
+		{"IntToInt8Overflow", func() error { _, err := IntToInt8(300); return err }, ErrOverflow, "int", "int8"},
+		{"IntToInt8Underflow", func() error { _, err := IntToInt8(-300); return err }, ErrUnderflow, "int", "int8"},
+		{"Int8ToUint8Negative", func() error { _, err := Int8ToUint8(-1); return err }, ErrUnderflow, "int8", "uint8"},
+		{"Uint64ToInt64Overflow", func() error { _, err := Uint64ToInt64(math.MaxUint64); return err }, ErrOverflow, "uint64", "int64"},
+		{"Float64ToUint32Negative", func() error { _, err := Float64ToUint32(-0.5e10); return err }, ErrUnderflow, "float64", "uint32"},
+		{"Float64ToFloat32Overflow", func() error { _, err := Float64ToFloat32(math.MaxFloat64); return err }, ErrOverflow, "float64", "float32"},
+		{"Float64ToFloat32Underflow", func() error { _, err := Float64ToFloat32(-math.MaxFloat64); return err }, ErrUnderflow, "float64", "float32"},
+		{"Uint64ToTimeOverflow", func() error { _, err := Uint64ToTime(math.MaxUint64); return err }, ErrOverflow, "uint64", "time.Time"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.convert()
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+			var convErr *ConversionError
+			if !errors.As(err, &convErr) {
+				t.Fatalf("error = %T, want *ConversionError", err)
+			}
+			if convErr.From != tt.from || convErr.To != tt.to {
+				t.Errorf("ConversionError = %s to %s, want %s to %s", convErr.From, convErr.To, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+func TestRangeErrorMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() error
+		want    string
+	}{
+		{"Int64ToInt8", func() error { _, err := Int64ToInt8(300); return err },
+			"cannot convert int64 300 to int8: value exceeds the maximum of the target type [-128, 127]"},
+		{"IntToUint16", func() error { _, err := IntToUint16(-1); return err },
+			"cannot convert int -1 to uint16: value is below the minimum of the target type [0, 65535]"},
+		{"Float64ToInt32", func() error { _, err := Float64ToInt32(-3e9); return err },
+			"cannot convert float64 -3e+09 to int32: value is below the minimum of the target type [-2147483648, 2147483647]"},
+		{"TryIntoInt8", func() error { _, err := TryIntoInt8(uint64(200)); return err },
+			"cannot convert uint64 200 to int8: value exceeds the maximum of the target type [-128, 127]"},
+		{"Uint64ToTime", func() error { _, err := Uint64ToTime(math.MaxUint64); return err },
+			"cannot convert uint64 18446744073709551615 to time.Time: value exceeds the maximum of the target type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.convert(); err == nil || err.Error() != tt.want {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnsupportedTypeError(t *testing.T) {
+	var (
+		when   time.Time
+		count  int
+		degree celsius
+	)
+	tests := []struct {
+		name string
+		dst  any
+		src  any
+		want string
+	}{
+		{"BoolToTime", &when, true, "cannot convert bool to time.Time"},
+		{"TimeToInt", &count, time.Unix(0, 0), "cannot convert time.Time to int"},
+		{"TimeToNamed", &degree, time.Unix(0, 0), "cannot convert time.Time to into_test.celsius"},
+		{"SliceToInt", &count, []int{1}, "cannot convert []int to int"},
+		{"PointerToSliceToTime", &when, &[]string{}, "cannot convert []string to time.Time"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := TryIntoValue(reflect.ValueOf(tt.dst).Elem(), reflect.ValueOf(tt.src))
+			if !errors.Is(err, ErrUnsupportedType) {
+				t.Fatalf("error = %v, want ErrUnsupportedType", err)
+			}
+			var unsupported *UnsupportedTypeError
+			if !errors.As(err, &unsupported) {
+				t.Fatalf("error = %T, want *UnsupportedTypeError", err)
+			}
+			if err.Error() != tt.want {
+				t.Errorf("error = %q, want %q", err.Error(), tt.want)
+			}
+		})
+	}
+
+	var unsupported *UnsupportedTypeError
+	if _, err := TryIntoAny[int]([]int{1}); !errors.As(err, &unsupported) || unsupported.From != "[]int" || unsupported.To != "int" {
+		t.Errorf("TryIntoAny([]int) error = %#v, want an *UnsupportedTypeError from []int to int", err)
+	}
+	if _, err := TryIntoNullTime(struct{}{}); !errors.As(err, &unsupported) || unsupported.To != "time.Time" {
+		t.Errorf("TryIntoNullTime(struct{}{}) error = %#v, want an *UnsupportedTypeError to time.Time", err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (any, error)
+		to      string
+		wantErr error
+		want    string
+	}{
+		{"Int8Overflow", func() (any, error) { return StringToInt8("300") }, "int8", ErrOverflow,
+			"cannot convert string 300 to int8: value exceeds the maximum of the target type [-128, 127]"},
+		{"Int16Underflow", func() (any, error) { return StringToInt16("-40000") }, "int16", ErrUnderflow,
+			"cannot convert string -40000 to int16: value is below the minimum of the target type [-32768, 32767]"},
+		{"Uint8Syntax", func() (any, error) { return StringToUint8("abc") }, "uint8", strconv.ErrSyntax,
+			"cannot convert string abc to uint8: invalid syntax"},
+		{"Uint8Negative", func() (any, error) { return StringToUint8("-5") }, "uint8", ErrUnderflow,
+			"cannot convert string -5 to uint8: value is below the minimum of the target type [0, 255]"},
+		{"UintNegative", func() (any, error) { return StringToUint("-1") }, "uint", ErrUnderflow, ""},
+		{"Uint16Negative", func() (any, error) { return StringToUint16("-70000") }, "uint16", ErrUnderflow, ""},
+		{"Uint32Negative", func() (any, error) { return StringToUint32("-1") }, "uint32", ErrUnderflow, ""},
+		{"Uint64Negative", func() (any, error) { return StringToUint64("-18446744073709551616") }, "uint64", ErrUnderflow, ""},
+		{"Uint8NegativeZero", func() (any, error) { return StringToUint8("-0") }, "uint8", strconv.ErrSyntax, ""},
+		{"Uint8NegativeFraction", func() (any, error) { return StringToUint8("-1.5") }, "uint8", strconv.ErrSyntax, ""},
+		{"Int64Overflow", func() (any, error) { return StringToInt64("9223372036854775808") }, "int64", ErrOverflow, ""},
+		{"Uint64Overflow", func() (any, error) { return StringToUint64("18446744073709551616") }, "uint64", ErrOverflow, ""},
+		{"IntSyntax", func() (any, error) { return StringToInt("1.5") }, "int", strconv.ErrSyntax, ""},
+		{"Float32Overflow", func() (any, error) { return StringToFloat32("1e39") }, "float32", ErrOverflow, ""},
+		{"Float64Underflow", func() (any, error) { return StringToFloat64("-1e400") }, "float64", ErrUnderflow, ""},
+		{"BoolSyntax", func() (any, error) { return StringToBool("maybe") }, "bool", strconv.ErrSyntax,
+			"cannot convert string maybe to bool: invalid syntax"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.convert()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			var convErr *ConversionError
+			if !errors.As(err, &convErr) || convErr.From != "string" || convErr.To != tt.to {
+				t.Fatalf("error = %#v, want a ConversionError from string to %s", err, tt.to)
+			}
+			if tt.want != "" && err.Error() != tt.want {
+				t.Errorf("error = %q, want %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFloatToIntExact(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     float64
+		want      int32
+		wantErr   bool
+		wantLossy bool
+	}{
+		{"integral", 123, 123, false, false},
+		{"negativeIntegral", -123, -123, false, false},
+		{"zero", 0, 0, false, false},
+		{"fraction", 123.456, 0, true, true},
+		{"negativeFraction", -0.5, 0, true, true},
+		{"nan", math.NaN(), 0, true, true},
+		{"maxTarget", math.MaxInt32, math.MaxInt32, false, false},
+		{"positiveInf", math.Inf(1), 0, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run("Float64"+tt.name, func(t *testing.T) {
+			got, err := Float64ToInt32Exact(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Float64ToInt32Exact() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrLossOfPrecision) != tt.wantLossy {
+				t.Errorf("Float64ToInt32Exact() error = %v, wantLossy %v", err, tt.wantLossy)
+			}
+			if got != tt.want {
+				t.Errorf("Float64ToInt32Exact() = %v, want %v", got, tt.want)
+			}
+		})
+		t.Run("Float32"+tt.name, func(t *testing.T) {
+			if float64(float32(tt.input)) != tt.input && !math.IsNaN(tt.input) {
+				t.Skip("input is not representable as float32")
+			}
+			got, err := Float32ToInt32Exact(float32(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Float32ToInt32Exact() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrLossOfPrecision) != tt.wantLossy {
+				t.Errorf("Float32ToInt32Exact() error = %v, wantLossy %v", err, tt.wantLossy)
+			}
+			if got != tt.want {
+				t.Errorf("Float32ToInt32Exact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Float64ToUint8Exact(-1); err == nil || errors.Is(err, ErrLossOfPrecision) {
+		t.Errorf("Float64ToUint8Exact(-1) error = %v, want range error", err)
+	}
+	if got, err := Float64ToUint64Exact(1 << 40); err != nil || got != 1<<40 {
+		t.Errorf("Float64ToUint64Exact(1<<40) = %v, %v", got, err)
+	}
+}
+
+func TestIntToFloatExact(t *testing.T) {
+	tests := []struct {
+		name      string
+		convert   func() (float64, error)
+		want      float64
+		wantLossy bool
+	}{
+		{"Int64Small", func() (float64, error) { return Int64ToFloat64Exact(123) }, 123, false},
+		{"Int64MaxSafe", func() (float64, error) { return Int64ToFloat64Exact(1 << 53) }, 1 << 53, false},
+		{"Int64MaxSafe+1", func() (float64, error) { return Int64ToFloat64Exact(1<<53 + 1) }, 0, true},
+		{"Int64Max", func() (float64, error) { return Int64ToFloat64Exact(math.MaxInt64) }, 0, true},
+		{"Int64Min", func() (float64, error) { return Int64ToFloat64Exact(math.MinInt64) }, math.MinInt64, false},
+		{"Uint64Max", func() (float64, error) { return Uint64ToFloat64Exact(math.MaxUint64) }, 0, true},
+		{"Uint64Pow63", func() (float64, error) { return Uint64ToFloat64Exact(1 << 63) }, 1 << 63, false},
+		{"Int32ToFloat32Max", func() (float64, error) {
+			f, err := Int32ToFloat32Exact(math.MaxInt32)
+			return float64(f), err
+		}, 0, true},
+		{"Uint64ToFloat32Pow24+1", func() (float64, error) {
+			f, err := Uint64ToFloat32Exact(1<<24 + 1)
+			return float64(f), err
+		}, 0, true},
+		{"Uint64ToFloat32Pow24", func() (float64, error) {
+			f, err := Uint64ToFloat32Exact(1 << 24)
+			return float64(f), err
+		}, 1 << 24, false},
+		{"Float64ToFloat32Tenth", func() (float64, error) {
+			f, err := Float64ToFloat32Exact(0.1)
+			return float64(f), err
+		}, 0, true},
+		{"Float64ToFloat32Half", func() (float64, error) {
+			f, err := Float64ToFloat32Exact(0.5)
+			return float64(f), err
+		}, 0.5, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if errors.Is(err, ErrLossOfPrecision) != tt.wantLossy {
+				t.Errorf("error = %v, wantLossy %v", err, tt.wantLossy)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
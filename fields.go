@@ -0,0 +1,107 @@
+package into
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Fields converts a list of source values into a list of destinations.
+//
+// Fields converts srcs[i] and stores it in the value pointed to by dsts[i]
+// for every i, like sql.Rows.Scan does for the columns of a row, so generic
+// row-mapping utilities can be built on the checked converters. Each value
+// is converted with TryIntoValue, so functions added with Register and the
+// options set by SetDefaults apply. In addition:
+//   - a destination of type *any receives the source value as is.
+//   - a nil source sets a pointer destination (e.g. **int) to nil, and is
+//     rejected with ErrNil for any other destination.
+//   - a []byte source is converted as a string, as database drivers return
+//     text columns as []byte, unless the destination is a []byte, which
+//     receives a copy.
+//
+// Fields stops at the first failure. The destinations before it keep their
+// converted values and the failing one is left unchanged.
+//
+// Parameters:
+//   - dsts: non-nil pointers to the destinations.
+//   - srcs: the values to be converted, one per destination.
+//
+// Returns:
+//   - error: an error if the lengths of dsts and srcs differ, or an
+//     *IndexError naming the position of the first failed conversion.
+//
+// Example:
+//
+//	var id int64
+//	var name string
+//	var score *float64
+//	err := Fields([]any{&id, &name, &score}, []any{"42", []byte("gopher"), nil})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(id, name, score) // Output: 42 gopher <nil>
+func Fields(dsts []any, srcs []any) error {
+	if len(dsts) != len(srcs) {
+		return fmt.Errorf("expected %d destinations, got %d", len(srcs), len(dsts))
+	}
+	for i, dst := range dsts {
+		if err := field(dst, srcs[i]); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
+
+// IndexError describes a failed conversion of a list element, such as a
+// column converted by Fields.
+type IndexError struct {
+	// Index is the position of the element in the list.
+	Index int
+	// Err is the reason of the failure.
+	Err error
+}
+
+// Error returns the error message.
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the reason of the failure.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// bytesType is the type of a byte slice, which Fields stores as is.
+var bytesType = reflect.TypeOf([]byte(nil))
+
+// field converts src and stores it in the value pointed to by dst.
+func field(dst any, src any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("destination must be a non-nil pointer, got %T", dst)
+	}
+	elem := rv.Elem()
+
+	switch {
+	case elem.Kind() == reflect.Interface && elem.NumMethod() == 0:
+		if src == nil {
+			elem.Set(reflect.Zero(elem.Type()))
+		} else {
+			elem.Set(reflect.ValueOf(src))
+		}
+		return nil
+	case src == nil && elem.Kind() == reflect.Ptr:
+		elem.Set(reflect.Zero(elem.Type()))
+		return nil
+	case src == nil:
+		return fmt.Errorf("%w into %s", ErrNil, elem.Type())
+	}
+	if b, ok := src.([]byte); ok {
+		if elem.Type() == bytesType {
+			elem.SetBytes(append([]byte(nil), b...))
+			return nil
+		}
+		src = string(b)
+	}
+	return TryIntoValue(elem, reflect.ValueOf(src))
+}
@@ -0,0 +1,69 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFields(t *testing.T) {
+	var (
+		id      int64
+		name    string
+		score   *float64
+		active  bool
+		created time.Time
+		raw     any
+		blob    []byte
+	)
+	score = new(float64)
+	src := []byte("data")
+	srcs := []any{[]byte("42"), []byte("gopher"), nil, int64(1), int64(1700000000), uint8(7), src}
+	if err := Fields([]any{&id, &name, &score, &active, &created, &raw, &blob}, srcs); err != nil {
+		t.Fatalf("Fields() error = %v", err)
+	}
+	if id != 42 || name != "gopher" || score != nil || !active || !created.Equal(time.Unix(1700000000, 0)) || raw != uint8(7) {
+		t.Errorf("Fields() = %v, %q, %v, %v, %v, %v", id, name, score, active, created, raw)
+	}
+	if src[0] = 'x'; string(blob) != "data" {
+		t.Errorf("Fields() []byte = %q, want a copy of %q", blob, "data")
+	}
+
+	var port uint16
+	var count int8 = 3
+	err := Fields([]any{&port, &count}, []any{"8080", 300})
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 || !errors.Is(err, ErrOverflow) {
+		t.Fatalf("Fields() error = %v, want an IndexError at 1 wrapping ErrOverflow", err)
+	}
+	if port != 8080 || count != 3 {
+		t.Errorf("Fields() = %v, %v, want 8080, 3", port, count)
+	}
+
+	tests := []struct {
+		name    string
+		dsts    []any
+		srcs    []any
+		wantErr error
+	}{
+		{"lengthMismatch", []any{&port}, []any{1, 2}, nil},
+		{"nonPointer", []any{port}, []any{1}, nil},
+		{"nilPointer", []any{(*int)(nil)}, []any{1}, nil},
+		{"nilIntoValue", []any{&port}, []any{nil}, ErrNil},
+		{"unsupported", []any{&created}, []any{true}, ErrUnsupportedType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Fields(tt.dsts, tt.srcs)
+			if err == nil {
+				t.Fatal("Fields() error = nil, want an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Fields() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package into
+
+import "math"
+
+// StringToFloat32Finite converts a string value to a finite float32.
+//
+// StringToFloat32Finite converts a string value to float32 like
+// StringToFloat32, but rejects "NaN", "Inf" and the other spellings of
+// non-finite values accepted by strconv.ParseFloat.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - float32: the converted float32 value.
+//   - error: ErrNaN for NaN, ErrOverflow or ErrUnderflow for an infinity, or
+//     an error if the value cannot be converted.
+//
+// Example:
+//
+//	_, err := StringToFloat32Finite("NaN")
+//	fmt.Println(errors.Is(err, ErrNaN)) // Output: true
+func StringToFloat32Finite(value string) (float32, error) {
+	f, err := StringToFloat32(value)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkFinite(float64(f), value, "float32"); err != nil {
+		return 0, err
+	}
+	return f, nil
+}
+
+// StringToFloat64Finite converts a string value to a finite float64.
+//
+// StringToFloat64Finite converts a string value to float64 like
+// StringToFloat64, but rejects the strings that strconv.ParseFloat accepts
+// as NaN or an infinity, such as "NaN", "inf" and "-Infinity", for values
+// where they make no sense, such as prices or sensor readings.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - float64: the converted float64 value.
+//   - error: ErrNaN for NaN, ErrOverflow or ErrUnderflow for an infinity, or
+//     an error if the value cannot be converted.
+//
+// Example:
+//
+//	_, err := StringToFloat64Finite("Inf")
+//	fmt.Println(errors.Is(err, ErrOverflow)) // Output: true
+func StringToFloat64Finite(value string) (float64, error) {
+	f, err := StringToFloat64(value)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkFinite(f, value, "float64"); err != nil {
+		return 0, err
+	}
+	return f, nil
+}
+
+// checkFinite returns an error naming value if f is NaN or an infinity.
+func checkFinite(f float64, value string, to string) error {
+	switch {
+	case math.IsNaN(f):
+		return nanError(value, to)
+	case math.IsInf(f, 1):
+		return overflowError(value, to)
+	case math.IsInf(f, -1):
+		return underflowError(value, to)
+	}
+	return nil
+}
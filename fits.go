@@ -0,0 +1,54 @@
+package into
+
+import "reflect"
+
+// FitsIn reports whether a numeric value is within the range of T.
+//
+// FitsIn reports whether converting value to T with TryInto succeeds
+// without reaching the overflow policy, that is, without returning
+// ErrOverflow, ErrUnderflow or ErrNaN, but it compares the value against
+// the bounds of T instead of converting it, so validators can check ranges
+// cheaply before choosing a path. Floats are checked like the checked
+// converters check them: a fraction is truncated toward zero, so 2.5 fits
+// in int and -0.5 fits in uint, and NaN fits only in a float type.
+//
+// Parameters:
+//   - value: the value to be checked.
+//
+// Returns:
+//   - bool: true if value is within the range of T.
+//
+// Example:
+//
+//	fmt.Println(FitsIn[int8](127), FitsIn[int8](128)) // Output: true false
+//	fmt.Println(FitsIn[uint16](-1.5))                 // Output: false
+func FitsIn[T Int | Uint | Float, U Int | Uint | Float](value U) bool {
+	var zero T
+	return fitsKind(reflect.TypeOf(zero).Kind(), reflect.ValueOf(value))
+}
+
+// Bounds returns the smallest and the largest value of a numeric type.
+//
+// Bounds returns the range the checked converters accept for T, so
+// validators need not hard-code constants such as math.MaxInt16. The
+// minimum of an unsigned type is 0, the bounds of int and uint depend on
+// the platform, and the bounds of a float type are its largest finite
+// values, ±math.MaxFloat32 or ±math.MaxFloat64. Named types are supported
+// through their underlying type.
+//
+// Returns:
+//   - T: the minimum of T.
+//   - T: the maximum of T.
+//
+// Example:
+//
+//	min, max := Bounds[int16]()
+//	fmt.Println(min, max) // Output: -32768 32767
+func Bounds[T Int | Uint | Float]() (T, T) {
+	var zero T
+	t := reflect.TypeOf(zero)
+	bounds := kindBounds[t.Kind()]
+	min := reflect.ValueOf(bounds[0]).Convert(t).Interface().(T)
+	max := reflect.ValueOf(bounds[1]).Convert(t).Interface().(T)
+	return min, max
+}
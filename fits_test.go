@@ -0,0 +1,142 @@
+package into_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFitsIn(t *testing.T) {
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"int8 max", FitsIn[int8](127), true},
+		{"int8 max+1", FitsIn[int8](128), false},
+		{"int8 min", FitsIn[int8](-128), true},
+		{"int8 min-1", FitsIn[int8](-129), false},
+		{"uint8 negative", FitsIn[uint8](-1), false},
+		{"uint64 max", FitsIn[uint64](uint64(math.MaxUint64)), true},
+		{"int64 from uint64 max", FitsIn[int64](uint64(math.MaxUint64)), false},
+		{"int from float fraction", FitsIn[int](2.5), true},
+		{"uint from negative fraction", FitsIn[uint](-0.5), true},
+		{"uint16 from -1.5", FitsIn[uint16](-1.5), false},
+		{"int8 from 127.9", FitsIn[int8](127.9), true},
+		{"int8 from -128.9", FitsIn[int8](-128.9), true},
+		{"int8 from -129.0", FitsIn[int8](-129.0), false},
+		{"int from NaN", FitsIn[int](math.NaN()), false},
+		{"float64 from NaN", FitsIn[float64](math.NaN()), true},
+		{"float32 from MaxFloat64", FitsIn[float32](math.MaxFloat64), false},
+		{"float32 from uint64 max", FitsIn[float32](uint64(math.MaxUint64)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("FitsIn = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFitsInAgreesWithTryInto checks that FitsIn reports true exactly when
+// TryInto succeeds.
+func TestFitsInAgreesWithTryInto(t *testing.T) {
+	ints := []int64{math.MinInt64, math.MinInt32 - 1, math.MinInt32, math.MinInt16 - 1, math.MinInt16,
+		math.MinInt8 - 1, math.MinInt8, -1, 0, 1, math.MaxInt8, math.MaxInt8 + 1, math.MaxUint8,
+		math.MaxUint8 + 1, math.MaxInt16, math.MaxInt16 + 1, math.MaxUint16, math.MaxUint16 + 1,
+		math.MaxInt32, math.MaxInt32 + 1, math.MaxUint32, math.MaxUint32 + 1, math.MaxInt64}
+	uints := []uint64{0, math.MaxInt64, math.MaxInt64 + 1, math.MaxUint64}
+	floats := []float64{math.NaN(), math.Inf(1), math.Inf(-1), -0.5, -1, 0.5, math.MaxFloat32,
+		math.MaxFloat32 * 2, -math.MaxFloat32 * 2, 0x1p63, -0x1p63, 0x1p64, math.Nextafter(0x1p63, 0)}
+	for _, v := range ints {
+		floats = append(floats, float64(v), float64(v)+0.5, float64(v)-0.5)
+	}
+
+	check := func(t *testing.T, name string, value any, fits bool, err error) {
+		t.Helper()
+		if fits != (err == nil) {
+			t.Errorf("%s(%v): FitsIn = %v, TryInto error = %v", name, value, fits, err)
+		}
+	}
+	for _, v := range ints {
+		checkAll(t, check, v)
+	}
+	for _, v := range uints {
+		checkAll(t, check, v)
+	}
+	for _, v := range floats {
+		checkAll(t, check, v)
+		checkAll(t, check, float32(v))
+	}
+}
+
+func checkAll[U Int | Uint | Float](t *testing.T, check func(*testing.T, string, any, bool, error), value U) {
+	t.Helper()
+	check(t, "int", value, FitsIn[int](value), tryErr[int](value))
+	check(t, "int8", value, FitsIn[int8](value), tryErr[int8](value))
+	check(t, "int16", value, FitsIn[int16](value), tryErr[int16](value))
+	check(t, "int32", value, FitsIn[int32](value), tryErr[int32](value))
+	check(t, "int64", value, FitsIn[int64](value), tryErr[int64](value))
+	check(t, "uint", value, FitsIn[uint](value), tryErr[uint](value))
+	check(t, "uint8", value, FitsIn[uint8](value), tryErr[uint8](value))
+	check(t, "uint16", value, FitsIn[uint16](value), tryErr[uint16](value))
+	check(t, "uint32", value, FitsIn[uint32](value), tryErr[uint32](value))
+	check(t, "uint64", value, FitsIn[uint64](value), tryErr[uint64](value))
+	check(t, "float32", value, FitsIn[float32](value), tryErr[float32](value))
+	check(t, "float64", value, FitsIn[float64](value), tryErr[float64](value))
+}
+
+func tryErr[T Int | Uint | Float](value any) error {
+	_, err := TryIntoAny[T](value)
+	return err
+}
+
+func TestBounds(t *testing.T) {
+	type level int8
+
+	tests := []struct {
+		name string
+		got  [2]any
+		want [2]any
+	}{
+		{"int8", pair[int8](), [2]any{int8(math.MinInt8), int8(math.MaxInt8)}},
+		{"int16", pair[int16](), [2]any{int16(math.MinInt16), int16(math.MaxInt16)}},
+		{"int", pair[int](), [2]any{math.MinInt, math.MaxInt}},
+		{"int64", pair[int64](), [2]any{int64(math.MinInt64), int64(math.MaxInt64)}},
+		{"uint", pair[uint](), [2]any{uint(0), uint(math.MaxUint)}},
+		{"uint8", pair[uint8](), [2]any{uint8(0), uint8(math.MaxUint8)}},
+		{"uint32", pair[uint32](), [2]any{uint32(0), uint32(math.MaxUint32)}},
+		{"uint64", pair[uint64](), [2]any{uint64(0), uint64(math.MaxUint64)}},
+		{"float32", pair[float32](), [2]any{float32(-math.MaxFloat32), float32(math.MaxFloat32)}},
+		{"float64", pair[float64](), [2]any{-math.MaxFloat64, math.MaxFloat64}},
+		{"named", pair[level](), [2]any{level(math.MinInt8), level(math.MaxInt8)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Bounds() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// pair returns the bounds of T as an array of interfaces.
+func pair[T Int | Uint | Float]() [2]any {
+	min, max := Bounds[T]()
+	return [2]any{min, max}
+}
+
+// TestBoundsAgreeWithFitsIn checks that the bounds fit in their type and
+// the next values beyond them do not.
+func TestBoundsAgreeWithFitsIn(t *testing.T) {
+	min, max := Bounds[int32]()
+	if !FitsIn[int32](min) || !FitsIn[int32](max) || FitsIn[int32](int64(min)-1) || FitsIn[int32](int64(max)+1) {
+		t.Errorf("Bounds[int32]() = %d, %d disagree with FitsIn", min, max)
+	}
+	umin, umax := Bounds[uint16]()
+	if !FitsIn[uint16](umin) || !FitsIn[uint16](umax) || FitsIn[uint16](-1) || FitsIn[uint16](int(umax)+1) {
+		t.Errorf("Bounds[uint16]() = %d, %d disagree with FitsIn", umin, umax)
+	}
+}
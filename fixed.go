@@ -0,0 +1,130 @@
+package into
+
+import (
+	"fmt"
+	"math"
+)
+
+// QFormat describes a signed fixed-point format in Q notation.
+//
+// A value in the Qm.n format is stored as a two's complement integer of
+// 1+m+n bits: a sign bit, m integer bits and n fraction bits, so the stored
+// integer is the value multiplied by 2^n. Q15 (Q0.15) and Q31 (Q0.31), the
+// formats of most DSP libraries, hold values in [-1, 1).
+type QFormat struct {
+	// Int is the number of integer bits m, not counting the sign bit.
+	Int int
+	// Frac is the number of fraction bits n.
+	Frac int
+}
+
+var (
+	// Q15 is the Q0.15 format of 16-bit DSP samples.
+	Q15 = QFormat{Int: 0, Frac: 15}
+	// Q31 is the Q0.31 format of 32-bit DSP samples.
+	Q31 = QFormat{Int: 0, Frac: 31}
+)
+
+// String returns the format in Q notation, such as "Q1.14".
+func (q QFormat) String() string {
+	return fmt.Sprintf("Q%d.%d", q.Int, q.Frac)
+}
+
+// check returns an error if q does not fit in an int64.
+func (q QFormat) check() error {
+	if q.Int < 0 || q.Frac < 0 || q.Int+q.Frac > 63 {
+		return fmt.Errorf("invalid fixed-point format %s: need 0 <= m, n and m+n <= 63", q)
+	}
+	return nil
+}
+
+// Float64ToQ converts a float64 value to a fixed-point integer.
+//
+// Float64ToQ converts a float64 value to the integer storing it in the given
+// Qm.n format, that is, the value multiplied by 2^n and rounded to the
+// nearest integer with ties to even. Values outside the range of the format,
+// which is [-2^m, 2^m - 2^-n], are rejected or clamped to the nearest bound
+// depending on overflow.
+//
+// Parameters:
+//   - value: the float64 value to be converted.
+//   - format: the fixed-point format, with m+n at most 63.
+//   - overflow: OverflowReject to return an error for out-of-range values,
+//     or Saturate to clamp them.
+//
+// Returns:
+//   - int64: the fixed-point integer, in the range [-2^(m+n), 2^(m+n) - 1].
+//   - error: ErrNaN if the value is NaN, a *ConversionError wrapping
+//     ErrOverflow or ErrUnderflow if the value is out of range and overflow
+//     is OverflowReject, or an error if the format is invalid.
+//
+// Example:
+//
+//	result, err := Float64ToQ(0.5, Q15, OverflowReject)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 16384
+func Float64ToQ(value float64, format QFormat, overflow Overflow) (int64, error) {
+	if err := format.check(); err != nil {
+		return 0, err
+	}
+	if math.IsNaN(value) {
+		return 0, nanError(value, format.String())
+	}
+
+	limit := math.Ldexp(1, format.Int+format.Frac)
+	scaled := math.RoundToEven(math.Ldexp(value, format.Frac))
+	switch {
+	case scaled >= limit:
+		if overflow == Saturate {
+			return int64(1)<<(format.Int+format.Frac) - 1, nil
+		}
+		return 0, overflowError(value, format.String())
+	case scaled < -limit:
+		if overflow == Saturate {
+			return -int64(1) << (format.Int + format.Frac), nil
+		}
+		return 0, underflowError(value, format.String())
+	}
+	return int64(scaled), nil
+}
+
+// QToFloat64 converts a fixed-point integer to a float64 value.
+//
+// QToFloat64 converts the integer storing a value in the given Qm.n format
+// to the value, that is, the integer divided by 2^n. The conversion is exact
+// when m+n is at most 52, and rounds to the nearest float64 otherwise.
+//
+// Parameters:
+//   - value: the fixed-point integer, in the range [-2^(m+n), 2^(m+n) - 1].
+//   - format: the fixed-point format, with m+n at most 63.
+//
+// Returns:
+//   - float64: the converted float64 value.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if the
+//     integer does not fit in 1+m+n bits, or an error if the format is
+//     invalid.
+//
+// Example:
+//
+//	result, err := QToFloat64(-16384, Q15)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: -0.5
+func QToFloat64(value int64, format QFormat) (float64, error) {
+	if err := format.check(); err != nil {
+		return 0, err
+	}
+	bits := format.Int + format.Frac
+	if bits < 63 {
+		if value >= int64(1)<<bits {
+			return 0, &ConversionError{From: format.String(), To: "float64", Value: value, Err: ErrOverflow}
+		}
+		if value < -int64(1)<<bits {
+			return 0, &ConversionError{From: format.String(), To: "float64", Value: value, Err: ErrUnderflow}
+		}
+	}
+	return math.Ldexp(float64(value), -format.Frac), nil
+}
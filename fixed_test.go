@@ -0,0 +1,96 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFloat64ToQ(t *testing.T) {
+	q1x14 := QFormat{Int: 1, Frac: 14}
+	tests := []struct {
+		name     string
+		input    float64
+		format   QFormat
+		overflow Overflow
+		want     int64
+		wantErr  error
+	}{
+		{"half", 0.5, Q15, OverflowReject, 16384, nil},
+		{"negativeOne", -1, Q15, OverflowReject, -32768, nil},
+		{"largest", 1 - 0x1p-15, Q15, OverflowReject, 32767, nil},
+		{"one", 1, Q15, OverflowReject, 0, ErrOverflow},
+		{"belowNegativeOne", -1.0001, Q15, OverflowReject, 0, ErrUnderflow},
+		{"saturateOne", 1, Q15, Saturate, 32767, nil},
+		{"saturateNegative", -5, Q15, Saturate, -32768, nil},
+		{"saturateInf", math.Inf(1), Q31, Saturate, math.MaxInt32, nil},
+		{"roundsToLargest", 1 - 0x1p-16 - 0x1p-20, Q15, OverflowReject, 32767, nil},
+		{"roundsOutOfRange", 1 - 0x1p-17, Q15, OverflowReject, 0, ErrOverflow},
+		{"tieToEven", 0x3p-16, Q15, OverflowReject, 2, nil},
+		{"q1x14", 1.5, q1x14, OverflowReject, 24576, nil},
+		{"q1x14Max", 2, q1x14, OverflowReject, 0, ErrOverflow},
+		{"integer", -3, QFormat{Int: 31, Frac: 0}, OverflowReject, -3, nil},
+		{"q63", -1, QFormat{Int: 0, Frac: 63}, OverflowReject, math.MinInt64, nil},
+		{"q63Saturate", 1, QFormat{Int: 0, Frac: 63}, Saturate, math.MaxInt64, nil},
+		{"nan", math.NaN(), Q15, Saturate, 0, ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Float64ToQ(tt.input, tt.format, tt.overflow)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Float64ToQ(%v, %v) error = %v, want %v", tt.input, tt.format, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Float64ToQ(%v, %v) = %v, want %v", tt.input, tt.format, got, tt.want)
+			}
+		})
+	}
+
+	for _, format := range []QFormat{{Int: -1, Frac: 15}, {Int: 1, Frac: 63}} {
+		if _, err := Float64ToQ(0, format, OverflowReject); err == nil {
+			t.Errorf("Float64ToQ() with format %v should fail", format)
+		}
+	}
+}
+
+func TestQToFloat64(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int64
+		format  QFormat
+		want    float64
+		wantErr error
+	}{
+		{"half", 16384, Q15, 0.5, nil},
+		{"negativeOne", -32768, Q15, -1, nil},
+		{"outOfRange", 32768, Q15, 0, ErrOverflow},
+		{"belowRange", -32769, Q15, 0, ErrUnderflow},
+		{"q31", math.MinInt32, Q31, -1, nil},
+		{"q63", math.MaxInt64, QFormat{Int: 0, Frac: 63}, 1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := QToFloat64(tt.input, tt.format)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("QToFloat64(%v, %v) error = %v, want %v", tt.input, tt.format, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("QToFloat64(%v, %v) = %v, want %v", tt.input, tt.format, got, tt.want)
+			}
+		})
+	}
+
+	for i := int64(-32768); i < 32768; i += 97 {
+		f, err := QToFloat64(i, Q15)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := Float64ToQ(f, Q15, OverflowReject); err != nil || got != i {
+			t.Errorf("Float64ToQ(QToFloat64(%v)) = %v, %v", i, got, err)
+		}
+	}
+}
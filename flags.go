@@ -0,0 +1,164 @@
+package into
+
+import (
+	"sort"
+	"strings"
+)
+
+// Flags maps the names of bit flags to their values.
+//
+// Flags converts strings such as "read|write" to integer masks by OR-ing the
+// values of the names, and masks back to strings. It is built on Enum, so
+// names are matched case-insensitively and can have aliases.
+type Flags[T Int | Uint] struct {
+	enum *Enum[T]
+	sep  string
+}
+
+// NewFlags creates a Flags from the names of its flags.
+//
+// NewFlags creates a Flags whose names are separated by sep, and registers
+// its StringToFlags and FlagsToString methods with Register like NewEnum.
+// Names usually stand for a single bit each, but may also stand for a
+// combination of bits, such as "all". White space around names is ignored.
+//
+// NewFlags panics under the same conditions as NewEnum, or if sep is empty.
+//
+// Parameters:
+//   - sep: the separator between names, e.g. "|" or ",".
+//   - names: the canonical name of each flag.
+//
+// Returns:
+//   - *Flags[T]: the flags.
+//
+// Example:
+//
+//	type Perm uint8
+//
+//	const (
+//	  Read Perm = 1 << iota
+//	  Write
+//	  Exec
+//	)
+//
+//	var perms = NewFlags("|", map[string]Perm{"read": Read, "write": Write, "exec": Exec})
+//
+//	result, _ := TryInto[Perm]("read|write")
+//	fmt.Println(result == Read|Write) // Output: true
+func NewFlags[T Int | Uint](sep string, names map[string]T) *Flags[T] {
+	if sep == "" {
+		panic("into: flags separator is empty")
+	}
+	f := &Flags[T]{enum: newEnum(names), sep: sep}
+	if typeOf[T]().PkgPath() != "" {
+		Register(f.StringToFlags)
+		Register(f.FlagsToString)
+	}
+	return f
+}
+
+// Alias adds another name for a flag.
+//
+// Alias adds name as an alternative spelling of value like Enum.Alias. It
+// returns f so that calls can be chained.
+//
+// Parameters:
+//   - name: the alternative name.
+//   - value: the value the name stands for.
+//
+// Returns:
+//   - *Flags[T]: the flags.
+func (f *Flags[T]) Alias(name string, value T) *Flags[T] {
+	f.enum.Alias(name, value)
+	return f
+}
+
+// StringToFlags converts the given names to a mask.
+//
+// StringToFlags splits value on the separator and ORs the values of the
+// names. An empty string is the zero mask.
+//
+// Parameters:
+//   - value: the names to be converted, e.g. "read|write".
+//
+// Returns:
+//   - T: the mask.
+//   - error: a *ConversionError wrapping ErrUnknownEnum whose Value is the
+//     first name that is not defined.
+//
+// Example:
+//
+//	result, err := perms.StringToFlags("read | exec")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 5
+func (f *Flags[T]) StringToFlags(value string) (T, error) {
+	if strings.TrimSpace(value) == "" {
+		return 0, nil
+	}
+
+	var mask T
+	for _, name := range strings.Split(value, f.sep) {
+		v, err := f.enum.StringToEnum(strings.TrimSpace(name))
+		if err != nil {
+			return 0, err
+		}
+		mask |= v
+	}
+	return mask, nil
+}
+
+// FlagsToString converts the given mask to the names of its flags.
+//
+// FlagsToString converts a mask to the canonical names of its flags, joined
+// by the separator in ascending order of value. Names that combine several
+// bits are preferred over their parts. The zero mask is the name of zero if
+// it has one, or else the empty string.
+//
+// Parameters:
+//   - value: the mask to be converted.
+//
+// Returns:
+//   - string: the names of the flags.
+//   - error: a *ConversionError wrapping ErrUnknownEnum whose Value holds the
+//     bits that have no name.
+//
+// Example:
+//
+//	result, err := perms.FlagsToString(Read | Write)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: read|write
+func (f *Flags[T]) FlagsToString(value T) (string, error) {
+	if value == 0 {
+		return f.enum.values[0], nil
+	}
+
+	flags := make([]T, 0, len(f.enum.values))
+	for v := range f.enum.values {
+		if v != 0 {
+			flags = append(flags, v)
+		}
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i] > flags[j] })
+
+	var set []T
+	rest := value
+	for _, v := range flags {
+		if rest&v == v {
+			set = append(set, v)
+			rest &^= v
+		}
+	}
+	if rest != 0 {
+		return "", &ConversionError{From: typeOf[T]().String(), To: "string", Value: rest, Err: ErrUnknownEnum}
+	}
+
+	names := make([]string, len(set))
+	for i := range set {
+		names[i] = f.enum.values[set[len(set)-1-i]]
+	}
+	return strings.Join(names, f.sep), nil
+}
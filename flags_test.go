@@ -0,0 +1,89 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+type perm uint8
+
+const (
+	read perm = 1 << iota
+	write
+	exec
+)
+
+var perms = NewFlags("|", map[string]perm{"none": 0, "read": read, "write": write, "exec": exec, "all": read | write | exec}).
+	Alias("x", exec)
+
+func TestFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    perm
+		wantErr bool
+	}{
+		{"single", "read", read, false},
+		{"several", "read|write", read | write, false},
+		{"spaces", " read | EXEC ", read | exec, false},
+		{"alias", "write|x", write | exec, false},
+		{"combination", "all", read | write | exec, false},
+		{"repeated", "read|read", read, false},
+		{"empty", "", 0, false},
+		{"zeroName", "none", 0, false},
+		{"unknown", "read|delete", 0, true},
+		{"emptyName", "read||write", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryInto[perm](tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TryInto() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TryInto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var convErr *ConversionError
+	if _, err := perms.StringToFlags("read|delete"); !errors.As(err, &convErr) || convErr.Value != "delete" {
+		t.Errorf("StringToFlags() error = %v, want the offending name", err)
+	}
+}
+
+func TestFlagsToString(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   perm
+		want    string
+		wantErr bool
+	}{
+		{"single", write, "write", false},
+		{"several", read | exec, "read|exec", false},
+		{"combination", read | write | exec, "all", false},
+		{"zero", 0, "none", false},
+		{"unknownBits", read | 0x10, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryInto[string](tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TryInto() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TryInto() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := perms.FlagsToString(0x30); !errors.Is(err, ErrUnknownEnum) {
+		t.Errorf("FlagsToString(0x30) error = %v, want %v", err, ErrUnknownEnum)
+	}
+}
@@ -0,0 +1,310 @@
+package into
+
+import (
+	"math"
+	"strconv"
+)
+
+func init() {
+	Register(Float32ToFloat16)
+	Register(Float64ToFloat16)
+	Register(Float16ToFloat32)
+	Register(Float16ToFloat64)
+	Register(Float16ToString)
+	Register(StringToFloat16)
+}
+
+// Float16 is an IEEE 754 half-precision float, stored as its bits.
+//
+// Float16 holds the 16-bit encoding used by ML and graphics interchange
+// formats: 1 sign bit, 5 exponent bits and 10 fraction bits. Its range is
+// ±65504 and its precision is about 3 decimal digits. The registry converts
+// Float16 values to and from float32, float64 and string, so TryInto and
+// TryIntoAny convert it as a float rather than as a uint16:
+//
+//	h, _ := TryInto[Float16](1.5)
+//	f, _ := TryInto[float64](h)
+//	fmt.Println(uint16(h), f) // Output: 15872 1.5
+type Float16 uint16
+
+// Float32 returns the value of h as a float32, which represents every
+// Float16 value exactly.
+func (h Float16) Float32() float32 {
+	f, _ := Float16BitsToFloat32(uint16(h))
+	return f
+}
+
+// String returns the shortest decimal representation of h.
+func (h Float16) String() string {
+	s, _ := Float16ToString(h)
+	return s
+}
+
+// Float32ToFloat16Bits converts a float32 value to the bits of a half-precision float.
+//
+// Float32ToFloat16Bits converts a float32 value to the IEEE 754
+// half-precision encoding, rounding to the nearest representable value with
+// ties to even. Values below the smallest normal half (about 6.1E-5) are
+// encoded as subnormals, and values below half of the smallest subnormal
+// (about 6.0E-8) round to zero. Infinities and NaN are encoded as such.
+//
+// Parameters:
+//   - value: the float32 value to be converted.
+//
+// Returns:
+//   - uint16: the bits of the half-precision value.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if a
+//     finite value rounds beyond the half-precision range of ±65504.
+//
+// Example:
+//
+//	result, err := Float32ToFloat16Bits(1.5)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Printf("%#04x\n", result) // Output: 0x3e00
+func Float32ToFloat16Bits(value float32) (uint16, error) {
+	bits, ok := float16Bits(float64(value))
+	if !ok {
+		if value < 0 {
+			return 0, underflowError(value, "float16")
+		}
+		return 0, overflowError(value, "float16")
+	}
+	return bits, nil
+}
+
+// Float16BitsToFloat32 converts the bits of a half-precision float to a float32 value.
+//
+// Float16BitsToFloat32 decodes the IEEE 754 half-precision encoding,
+// including subnormals, infinities and NaN. The conversion is exact.
+//
+// Parameters:
+//   - value: the bits of the half-precision value.
+//
+// Returns:
+//   - float32: the converted float32 value.
+//   - error: No error is returned.
+//
+// Example:
+//
+//	result, err := Float16BitsToFloat32(0x3e00)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1.5
+func Float16BitsToFloat32(value uint16) (float32, error) {
+	sign := uint32(value&0x8000) << 16
+	exp := int(value>>10) & 0x1f
+	frac := uint32(value & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		// Infinities and NaN keep their fraction as the NaN payload.
+		return math.Float32frombits(sign | 0x7f800000 | frac<<13), nil
+	case 0:
+		f := float32(math.Ldexp(float64(frac), -24))
+		return math.Float32frombits(sign | math.Float32bits(f)), nil
+	}
+	f := float32(math.Ldexp(float64(1024+frac), exp-25))
+	return math.Float32frombits(sign | math.Float32bits(f)), nil
+}
+
+// Float32ToFloat16 converts a float32 value to Float16.
+//
+// Float32ToFloat16 converts a float32 value to Float16 like
+// Float32ToFloat16Bits. The registry converts float32 values to Float16 with
+// it.
+//
+// Parameters:
+//   - value: the float32 value to be converted.
+//
+// Returns:
+//   - Float16: the converted Float16 value.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if a
+//     finite value rounds beyond the half-precision range of ±65504.
+//
+// Example:
+//
+//	result, err := Float32ToFloat16(0.1)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 0.1
+func Float32ToFloat16(value float32) (Float16, error) {
+	bits, err := Float32ToFloat16Bits(value)
+	return Float16(bits), err
+}
+
+// Float64ToFloat16 converts a float64 value to Float16.
+//
+// Float64ToFloat16 converts a float64 value to Float16, rounding once to the
+// nearest half-precision value with ties to even, so that no double rounding
+// through float32 occurs. The registry converts float64 values to Float16
+// with it.
+//
+// Parameters:
+//   - value: the float64 value to be converted.
+//
+// Returns:
+//   - Float16: the converted Float16 value.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if a
+//     finite value rounds beyond the half-precision range of ±65504.
+//
+// Example:
+//
+//	_, err := Float64ToFloat16(70000)
+//	fmt.Println(errors.Is(err, ErrOverflow)) // Output: true
+func Float64ToFloat16(value float64) (Float16, error) {
+	bits, ok := float16Bits(value)
+	if !ok {
+		if value < 0 {
+			return 0, underflowError(value, "float16")
+		}
+		return 0, overflowError(value, "float16")
+	}
+	return Float16(bits), nil
+}
+
+// Float16ToFloat32 converts a Float16 value to float32.
+//
+// Float16ToFloat32 converts a Float16 value to float32 exactly. The
+// registry converts Float16 values to float32 with it.
+//
+// Parameters:
+//   - value: the Float16 value to be converted.
+//
+// Returns:
+//   - float32: the converted float32 value.
+//   - error: No error is returned.
+//
+// Example:
+//
+//	result, _ := Float16ToFloat32(Float16(0x3c00))
+//	fmt.Println(result) // Output: 1
+func Float16ToFloat32(value Float16) (float32, error) {
+	return Float16BitsToFloat32(uint16(value))
+}
+
+// Float16ToFloat64 converts a Float16 value to float64.
+//
+// Float16ToFloat64 converts a Float16 value to float64 exactly. The
+// registry converts Float16 values to float64 with it.
+//
+// Parameters:
+//   - value: the Float16 value to be converted.
+//
+// Returns:
+//   - float64: the converted float64 value.
+//   - error: No error is returned.
+//
+// Example:
+//
+//	result, _ := Float16ToFloat64(Float16(0x7bff))
+//	fmt.Println(result) // Output: 65504
+func Float16ToFloat64(value Float16) (float64, error) {
+	f, err := Float16BitsToFloat32(uint16(value))
+	return float64(f), err
+}
+
+// Float16ToString converts a Float16 value to a string.
+//
+// Float16ToString converts a Float16 value to the shortest decimal string
+// that rounds back to the same Float16 value, so 0.1 is written as "0.1"
+// rather than as the float32 digits of its encoding. The registry converts
+// Float16 values to string with it.
+//
+// Parameters:
+//   - value: the Float16 value to be converted.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: No error is returned.
+//
+// Example:
+//
+//	h, _ := Float64ToFloat16(0.1)
+//	result, _ := Float16ToString(h)
+//	fmt.Println(result) // Output: 0.1
+func Float16ToString(value Float16) (string, error) {
+	f, _ := Float16BitsToFloat32(uint16(value))
+	if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+		return strconv.FormatFloat(float64(f), 'g', -1, 32), nil
+	}
+	// Up to 5 significant digits identify every Float16 value; use the
+	// fewest digits that round back to the same bits.
+	for prec := 0; prec < 4; prec++ {
+		g, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'e', prec, 32), 64)
+		if bits, ok := float16Bits(g); ok && bits == uint16(value) {
+			return strconv.FormatFloat(g, 'f', -1, 64), nil
+		}
+	}
+	return strconv.FormatFloat(float64(f), 'f', -1, 32), nil
+}
+
+// StringToFloat16 converts a string value to Float16.
+//
+// StringToFloat16 parses a string value like StringToFloat64 and converts
+// the result to Float16 like Float64ToFloat16. The registry converts
+// strings to Float16 with it.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - Float16: the converted Float16 value.
+//   - error: a *ConversionError wrapping strconv.ErrSyntax if the input value
+//     is not a valid number, or ErrOverflow or ErrUnderflow if it is out of
+//     the half-precision range of ±65504.
+//
+// Example:
+//
+//	result, err := StringToFloat16("0.1")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 0.1
+func StringToFloat16(value string) (Float16, error) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, parseError(err, value, "float16")
+	}
+	bits, ok := float16Bits(f)
+	if !ok {
+		return 0, parseError(&strconv.NumError{Func: "ParseFloat", Num: value, Err: strconv.ErrRange}, value, "float16")
+	}
+	return Float16(bits), nil
+}
+
+// float16Bits encodes f as a half-precision float, rounding to nearest
+// with ties to even. It reports false if a finite f rounds to an infinity.
+func float16Bits(f float64) (uint16, bool) {
+	var sign uint16
+	if math.Signbit(f) {
+		sign = 0x8000
+	}
+	abs := math.Abs(f)
+
+	switch {
+	case math.IsNaN(f):
+		return sign | 0x7e00, true
+	case math.IsInf(f, 0):
+		return sign | 0x7c00, true
+	case abs >= 65520:
+		// 65520 is halfway between 65504 and 2^16, which rounds to even,
+		// that is, to infinity.
+		return 0, false
+	case abs < 0x1p-14:
+		// Subnormals are multiples of 2^-24. A result of 1024 carries into
+		// the smallest normal encoding, 0x0400.
+		return sign | uint16(math.RoundToEven(abs*0x1p24)), true
+	}
+
+	exp := math.Ilogb(abs)
+	mant := math.RoundToEven(math.Ldexp(abs, 10-exp))
+	if mant == 2048 {
+		mant = 1024
+		exp++
+	}
+	return sign | uint16(exp+15)<<10 | uint16(mant-1024), true
+}
@@ -0,0 +1,106 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFloat32ToFloat16Bits(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   float32
+		want    uint16
+		wantErr error
+	}{
+		{"zero", 0, 0x0000, nil},
+		{"negativeZero", float32(math.Copysign(0, -1)), 0x8000, nil},
+		{"one", 1, 0x3c00, nil},
+		{"oneAndHalf", 1.5, 0x3e00, nil},
+		{"negativeTwo", -2, 0xc000, nil},
+		{"max", 65504, 0x7bff, nil},
+		{"roundsToMax", 65519, 0x7bff, nil},
+		{"overflow", 65520, 0, ErrOverflow},
+		{"underflow", -1e6, 0, ErrUnderflow},
+		{"inf", float32(math.Inf(1)), 0x7c00, nil},
+		{"negativeInf", float32(math.Inf(-1)), 0xfc00, nil},
+		{"smallestNormal", 0x1p-14, 0x0400, nil},
+		{"largestSubnormal", 0x3ffp-24, 0x03ff, nil},
+		{"smallestSubnormal", 0x1p-24, 0x0001, nil},
+		{"subnormalCarry", 0x7ffp-25, 0x0400, nil},
+		{"halfSmallestSubnormal", 0x1p-25, 0x0000, nil},
+		{"aboveHalfSmallestSubnormal", 0x1.01p-25, 0x0001, nil},
+		{"tieToEvenDown", 1 + 0x1p-11, 0x3c00, nil},
+		{"tieToEvenUp", 1 + 0x3p-11, 0x3c02, nil},
+		{"carryIntoExponent", 2 - 0x1p-12, 0x4000, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Float32ToFloat16Bits(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Float32ToFloat16Bits(%v) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Float32ToFloat16Bits(%v) = %#04x, want %#04x", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if got, err := Float32ToFloat16Bits(float32(math.NaN())); err != nil || got&0x7c00 != 0x7c00 || got&0x3ff == 0 {
+		t.Errorf("Float32ToFloat16Bits(NaN) = %#04x, %v, want a NaN", got, err)
+	}
+}
+
+func TestFloat16RoundTrip(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		bits := uint16(i)
+		f, err := Float16BitsToFloat32(bits)
+		if err != nil {
+			t.Fatalf("Float16BitsToFloat32(%#04x) error = %v", bits, err)
+		}
+		if math.IsNaN(float64(f)) {
+			if bits&0x7c00 != 0x7c00 || bits&0x3ff == 0 {
+				t.Errorf("Float16BitsToFloat32(%#04x) = NaN", bits)
+			}
+			continue
+		}
+		got, err := Float32ToFloat16Bits(f)
+		if err != nil || got != bits {
+			t.Errorf("Float32ToFloat16Bits(%v) = %#04x, %v, want %#04x", f, got, err, bits)
+		}
+		if s := Float16(bits).String(); !math.IsInf(float64(f), 0) {
+			h, err := TryInto[Float16](s)
+			if err != nil || h != Float16(bits) && f != 0 {
+				t.Errorf("TryInto[Float16](%q) = %#04x, %v, want %#04x", s, uint16(h), err, bits)
+			}
+		}
+	}
+}
+
+func TestFloat16Generic(t *testing.T) {
+	h, err := TryInto[Float16](1.5)
+	if err != nil || h != 0x3e00 {
+		t.Fatalf("TryInto[Float16](1.5) = %#04x, %v, want 0x3e00", uint16(h), err)
+	}
+	if f, err := TryInto[float64](h); err != nil || f != 1.5 {
+		t.Errorf("TryInto[float64](Float16) = %v, %v, want 1.5", f, err)
+	}
+	if f, err := TryIntoAny[float32](Float16(0xc000)); err != nil || f != -2 {
+		t.Errorf("TryIntoAny[float32](Float16) = %v, %v, want -2", f, err)
+	}
+	if s, err := TryInto[string](Float16(0x2e66)); err != nil || s != "0.1" {
+		t.Errorf("TryInto[string](Float16) = %q, %v, want \"0.1\"", s, err)
+	}
+	if _, err := TryInto[Float16](float32(1e5)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TryInto[Float16](1e5) error = %v, want ErrOverflow", err)
+	}
+	if h, err := Float64ToFloat16(1 + 0x1p-11 + 0x1p-40); err != nil || h != 0x3c01 {
+		t.Errorf("Float64ToFloat16() = %#04x, %v, want 0x3c01 without double rounding", uint16(h), err)
+	}
+	if got := Float16(0x7bff).Float32(); got != 65504 {
+		t.Errorf("Float16.Float32() = %v, want 65504", got)
+	}
+}
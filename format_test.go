@@ -0,0 +1,124 @@
+package into_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFloat64ToStringFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   float64
+		format  FloatFormat
+		want    string
+		wantErr bool
+	}{
+		{"default", 1.5, FloatFormat{}, "1.5", false},
+		{"fixedShortest", 1.5, FloatFormat{Verb: 'f'}, "1.5", false},
+		{"fixedZeroPrecision", 1.5, FloatFormat{Verb: 'f', Precision: 0, HasPrecision: true}, "2", false},
+		{"precisionWithoutHasPrecision", 1.5, FloatFormat{Verb: 'f', Precision: 3}, "1.5", false},
+		{"fixed", 1.5, FloatFormat{Verb: 'f', Precision: 3, HasPrecision: true}, "1.500", false},
+		{"fixedTrim", 1.5, FloatFormat{Verb: 'f', Precision: 3, HasPrecision: true, TrimZeros: true}, "1.5", false},
+		{"fixedTrimInteger", 2, FloatFormat{Verb: 'f', Precision: 3, HasPrecision: true, TrimZeros: true}, "2", false},
+		{"fixedTrimKeepsIntegerZeros", 100, FloatFormat{Verb: 'f', Precision: 0, HasPrecision: true, TrimZeros: true}, "100", false},
+		{"scientific", 1500, FloatFormat{Verb: 'e', Precision: 3, HasPrecision: true}, "1.500e+03", false},
+		{"scientificTrim", 1500, FloatFormat{Verb: 'e', Precision: 3, HasPrecision: true, TrimZeros: true}, "1.5e+03", false},
+		{"scientificUpper", 1500, FloatFormat{Verb: 'E'}, "1.5E+03", false},
+		{"general", 123456789, FloatFormat{Verb: 'g', Precision: 4, HasPrecision: true}, "1.235e+08", false},
+		{"inf", math.Inf(1), FloatFormat{Verb: 'f', Precision: 2, HasPrecision: true, TrimZeros: true}, "+Inf", false},
+		{"invalidVerb", 1, FloatFormat{Verb: 'x'}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Float64ToStringFormat(tt.input, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Float64ToStringFormat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Float64ToStringFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	opts := Options{FloatFormat: &FloatFormat{Verb: 'e', Precision: 2, HasPrecision: true}}
+	if got, err := TryIntoWith[string](float32(0.25), opts); err != nil || got != "2.50e-01" {
+		t.Errorf("TryIntoWith[string]() = %v, %v, want 2.50e-01", got, err)
+	}
+}
+
+func TestInt64ToStringFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int64
+		format  IntFormat
+		want    string
+		wantErr bool
+	}{
+		{"default", -123, IntFormat{}, "-123", false},
+		{"hex", 255, IntFormat{Base: 16}, "ff", false},
+		{"hexPrefix", -31, IntFormat{Base: 16, Prefix: true}, "-0x1f", false},
+		{"binaryPrefix", 5, IntFormat{Base: 2, Prefix: true, Width: 8}, "0b00000101", false},
+		{"octalPrefix", 8, IntFormat{Base: 8, Prefix: true}, "0o10", false},
+		{"padded", 42, IntFormat{Width: 6}, "000042", false},
+		{"comma", 1234567, IntFormat{Separator: ','}, "1,234,567", false},
+		{"underscore", -1000000, IntFormat{Separator: '_'}, "-1_000_000", false},
+		{"short", 999, IntFormat{Separator: ','}, "999", false},
+		{"hexGroups", 0xdeadbeef, IntFormat{Base: 16, Prefix: true, Separator: '_'}, "0xdead_beef", false},
+		{"groupSize", 123456, IntFormat{Separator: ' ', GroupSize: 2}, "12 34 56", false},
+		{"min", math.MinInt64, IntFormat{}, "-9223372036854775808", false},
+		{"invalidBase", 1, IntFormat{Base: 37}, "", true},
+		{"invalidPrefix", 1, IntFormat{Base: 10, Prefix: true}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Int64ToStringFormat(tt.input, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Int64ToStringFormat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Int64ToStringFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := Uint64ToStringBase(math.MaxUint64, 36); err != nil || got != "3w5e11264sgsf" {
+		t.Errorf("Uint64ToStringBase() = %v, %v", got, err)
+	}
+	opts := Options{IntFormat: &IntFormat{Base: 16, Prefix: true}}
+	if got, err := TryIntoWith[string](uint8(200), opts); err != nil || got != "0xc8" {
+		t.Errorf("TryIntoWith[string]() = %v, %v, want 0xc8", got, err)
+	}
+}
+
+func TestBoolToStringAs(t *testing.T) {
+	tests := []struct {
+		name  string
+		input bool
+		t, f  string
+		want  string
+	}{
+		{"yes", true, "yes", "no", "yes"},
+		{"no", false, "yes", "no", "no"},
+		{"one", true, "1", "0", "1"},
+		{"off", false, "on", "off", "off"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BoolIntoStringAs(tt.input, tt.t, tt.f); got != tt.want {
+				t.Errorf("BoolIntoStringAs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	opts := Options{BoolFormat: &BoolFormat{True: "on", False: "off"}}
+	if got, err := TryIntoWith[string](false, opts); err != nil || got != "off" {
+		t.Errorf("TryIntoWith[string]() = %v, %v, want off", got, err)
+	}
+}
@@ -0,0 +1,304 @@
+package into_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+var fuzzIntegerSeeds = []string{
+	"0", "1", "-1", "+1", "123", " 123", "123 ", "1e3", "0x10", "1_000",
+	"127", "128", "-128", "-129", "255", "256", "32767", "32768", "65535", "65536",
+	"2147483647", "2147483648", "-2147483648", "-2147483649", "4294967295", "4294967296",
+	"9223372036854775807", "9223372036854775808", "-9223372036854775808", "-9223372036854775809",
+	"18446744073709551615", "18446744073709551616", "", "-", "abc", "١٢٣",
+}
+
+var fuzzFloatSeeds = []string{
+	"0", "-0", "1.5", "-1.5", "1e38", "3.4028235e38", "3.5e38", "1e308", "1e309",
+	"4.9e-324", "1e-400", "inf", "-Inf", "NaN", "0x1p-2", "1_000.5", ".5", "5.", "", "e",
+}
+
+// fuzzParser checks that parse agrees with reference and that every parsed
+// value survives a round trip through format.
+func fuzzParser[T comparable](t *testing.T, s string, parse func(string) (T, error), format func(T) (string, error), reference func(string) (T, error)) {
+	got, err := parse(s)
+	want, wantErr := reference(s)
+	if (err != nil) != (wantErr != nil) {
+		t.Fatalf("parse(%q) error = %v, reference error = %v", s, err, wantErr)
+	}
+	if err != nil {
+		return
+	}
+	if got != want && !isNaN(got) {
+		t.Fatalf("parse(%q) = %v, reference = %v", s, got, want)
+	}
+
+	formatted, err := format(got)
+	if err != nil {
+		t.Fatalf("format(%v) error = %v", got, err)
+	}
+	again, err := parse(formatted)
+	if err != nil {
+		t.Fatalf("parse(format(%v)) = parse(%q) error = %v", got, formatted, err)
+	}
+	if again != got && !isNaN(got) {
+		t.Fatalf("parse(format(%v)) = %v", got, again)
+	}
+}
+
+// isNaN reports whether v is a float32 or float64 NaN. It is also used by
+// the generated matrix tests.
+func isNaN(v any) bool {
+	switch f := v.(type) {
+	case float32:
+		return math.IsNaN(float64(f))
+	case float64:
+		return math.IsNaN(f)
+	}
+	return false
+}
+
+func FuzzStringToInt(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToInt, IntToString, strconv.Atoi)
+	})
+}
+
+func FuzzStringToInt8(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToInt8, Int8ToString, func(s string) (int8, error) {
+			i, err := strconv.ParseInt(s, 10, 8)
+			return int8(i), err
+		})
+	})
+}
+
+func FuzzStringToInt16(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToInt16, Int16ToString, func(s string) (int16, error) {
+			i, err := strconv.ParseInt(s, 10, 16)
+			return int16(i), err
+		})
+	})
+}
+
+func FuzzStringToInt32(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToInt32, Int32ToString, func(s string) (int32, error) {
+			i, err := strconv.ParseInt(s, 10, 32)
+			return int32(i), err
+		})
+	})
+}
+
+func FuzzStringToInt64(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToInt64, Int64ToString, func(s string) (int64, error) {
+			return strconv.ParseInt(s, 10, 64)
+		})
+	})
+}
+
+func FuzzStringToUint(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToUint, UintToString, func(s string) (uint, error) {
+			i, err := strconv.ParseUint(s, 10, strconv.IntSize)
+			return uint(i), err
+		})
+	})
+}
+
+func FuzzStringToUint8(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToUint8, Uint8ToString, func(s string) (uint8, error) {
+			i, err := strconv.ParseUint(s, 10, 8)
+			return uint8(i), err
+		})
+	})
+}
+
+func FuzzStringToUint16(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToUint16, Uint16ToString, func(s string) (uint16, error) {
+			i, err := strconv.ParseUint(s, 10, 16)
+			return uint16(i), err
+		})
+	})
+}
+
+func FuzzStringToUint32(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToUint32, Uint32ToString, func(s string) (uint32, error) {
+			i, err := strconv.ParseUint(s, 10, 32)
+			return uint32(i), err
+		})
+	})
+}
+
+func FuzzStringToUint64(f *testing.F) {
+	for _, s := range fuzzIntegerSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToUint64, Uint64ToString, func(s string) (uint64, error) {
+			return strconv.ParseUint(s, 10, 64)
+		})
+	})
+}
+
+func FuzzStringToFloat32(f *testing.F) {
+	for _, s := range fuzzFloatSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToFloat32, Float32ToString, func(s string) (float32, error) {
+			v, err := strconv.ParseFloat(s, 32)
+			return float32(v), err
+		})
+	})
+}
+
+func FuzzStringToFloat64(f *testing.F) {
+	for _, s := range fuzzFloatSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToFloat64, Float64ToString, func(s string) (float64, error) {
+			return strconv.ParseFloat(s, 64)
+		})
+	})
+}
+
+func FuzzStringToBool(f *testing.F) {
+	for _, s := range []string{"true", "false", "1", "0", "t", "F", "TRUE", "yes", ""} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParser(t, s, StringToBool, BoolToString, strconv.ParseBool)
+	})
+}
+
+func FuzzStringToTime(f *testing.F) {
+	for _, s := range []string{
+		"2024-05-01", "2024-05-01T15:04:05Z", "2024-05-01T15:04:05+08:00", "2024-05-01 15:04:05",
+		"Mon, 02 Jan 2006 15:04:05 MST", "02 Jan 2006", "3:04PM", "Jan  2 15:04:05", "0000-00-00", "",
+	} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		got, err := StringToTime(s)
+		if err != nil || got.Year() < 0 || got.Year() > 9999 {
+			return
+		}
+		again, err := StringToTime(got.Format(time.RFC3339Nano))
+		if err != nil {
+			t.Fatalf("StringToTime(%q) round trip error = %v", got.Format(time.RFC3339Nano), err)
+		}
+		if !again.Equal(got) {
+			t.Fatalf("StringToTime(%q) round trip = %v, want %v", s, again, got)
+		}
+	})
+}
+
+func FuzzStringToDuration(f *testing.F) {
+	for _, s := range []string{"1h30m", "-1.5s", "300ms", "1us", "0", "9223372036854775807ns", "1d", "", "PT1H30M", "-P1DT0.5S"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		got, err := StringToDuration(s)
+		if err != nil {
+			return
+		}
+		again, err := StringToDuration(got.String())
+		if err != nil || again != got {
+			t.Fatalf("StringToDuration(%q) round trip = %v, %v, want %v", got.String(), again, err, got)
+		}
+	})
+}
+
+func FuzzStringToInt64WithUnits(f *testing.F) {
+	for _, s := range []string{"10MiB", "1.5k", "8Ei", "-2G", "1e3k", "1e999999999", "kB", "1/2", ""} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		got, err := StringToInt64WithUnits(s)
+		if err != nil {
+			return
+		}
+		want, err := StringToFloat64WithUnits(s)
+		if err != nil || math.Abs(float64(got)-want) > math.Abs(want)*1e-15 {
+			t.Fatalf("StringToInt64WithUnits(%q) = %v, float path = %v, %v", s, got, want, err)
+		}
+	})
+}
+
+func FuzzTryIntoFloat32(f *testing.F) {
+	for _, v := range []float64{0, 1.5, -1.5, math.MaxFloat32, -math.MaxFloat32, math.MaxFloat64, math.SmallestNonzeroFloat64} {
+		f.Add(v)
+	}
+	f.Fuzz(func(t *testing.T, v float64) {
+		got, err := TryIntoFloat32(v)
+		want, wantErr := Float64ToFloat32(v)
+		if (err != nil) != (wantErr != nil) || got != want && !math.IsNaN(float64(got)) {
+			t.Fatalf("TryIntoFloat32(%v) = %v, %v, direct = %v, %v", v, got, err, want, wantErr)
+		}
+	})
+}
+
+func FuzzTryIntoInteger(f *testing.F) {
+	for _, v := range []int64{0, 1, -1, math.MaxInt8, math.MinInt8, math.MaxUint8, math.MaxInt16 + 1, math.MinInt32, math.MaxUint32, math.MaxInt64, math.MinInt64} {
+		f.Add(v)
+	}
+	f.Fuzz(func(t *testing.T, v int64) {
+		if got, err := TryInto[int8](v); (err == nil) != (v >= math.MinInt8 && v <= math.MaxInt8) || err == nil && int64(got) != v {
+			t.Fatalf("TryInto[int8](%v) = %v, %v", v, got, err)
+		}
+		if got, err := TryInto[uint16](v); (err == nil) != (v >= 0 && v <= math.MaxUint16) || err == nil && int64(got) != v {
+			t.Fatalf("TryInto[uint16](%v) = %v, %v", v, got, err)
+		}
+		if got, err := TryInto[int32](v); (err == nil) != (v >= math.MinInt32 && v <= math.MaxInt32) || err == nil && int64(got) != v {
+			t.Fatalf("TryInto[int32](%v) = %v, %v", v, got, err)
+		}
+		if got, err := TryInto[uint64](v); (err == nil) != (v >= 0) || err == nil && int64(got) != v {
+			t.Fatalf("TryInto[uint64](%v) = %v, %v", v, got, err)
+		}
+		s, err := TryInto[string](v)
+		if err != nil {
+			t.Fatalf("TryInto[string](%v) error = %v", v, err)
+		}
+		if got, err := TryInto[int64](s); err != nil || got != v {
+			t.Fatalf("TryInto[int64](%q) = %v, %v", s, got, err)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package into
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// Header converts the value of an HTTP header to a value of type T.
+//
+// Header converts the first value of the header key in h, as h.Get returns
+// it, to T with the same dispatch and boundary checks as TryIntoValue.
+// time.Time values are parsed with http.ParseTime, which accepts the date
+// formats allowed by HTTP/1.1 (e.g. "Mon, 02 Jan 2006 15:04:05 GMT"), and
+// time.Duration values with StringToDuration. The options set by SetDefaults
+// are applied to the conversion.
+//
+// Parameters:
+//   - h: the header to read the value from.
+//   - key: the name of the header, which is canonicalized as by h.Get.
+//
+// Returns:
+//   - T: the converted value.
+//   - error: an error naming the header, wrapping ErrMissing if the header
+//     is not present or the reason of the failed conversion.
+//
+// Example:
+//
+//	h := http.Header{}
+//	h.Set("Content-Length", "1024")
+//	length, err := Header[int64](h, "Content-Length")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(length) // Output: 1024
+func Header[T any](h http.Header, key string) (T, error) {
+	var result T
+	values := h.Values(key)
+	if len(values) == 0 {
+		return result, fmt.Errorf("header %s: %w", http.CanonicalHeaderKey(key), ErrMissing)
+	}
+	value := values[0]
+
+	if p, ok := any(&result).(*time.Time); ok {
+		t, err := http.ParseTime(value)
+		if err != nil {
+			return result, fmt.Errorf("header %s: %w", http.CanonicalHeaderKey(key), err)
+		}
+		*p = t
+		return result, nil
+	}
+	if err := TryIntoValue(reflect.ValueOf(&result).Elem(), reflect.ValueOf(value)); err != nil {
+		return result, fmt.Errorf("header %s: %w", http.CanonicalHeaderKey(key), err)
+	}
+	return result, nil
+}
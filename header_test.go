@@ -0,0 +1,66 @@
+package into_test
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("Content-Length", "1024")
+	h.Set("Retry-After", "120")
+	h.Set("X-Timeout", "1.5s")
+	h.Set("Last-Modified", "Tue, 14 Nov 2023 22:13:20 GMT")
+	h.Set("X-Small", "300")
+	h.Set("X-Flag", "true")
+	h.Add("X-Multi", "7")
+	h.Add("X-Multi", "8")
+
+	if got, err := Header[int64](h, "content-length"); err != nil || got != 1024 {
+		t.Errorf("Header[int64]() = %v, %v, want 1024", got, err)
+	}
+	if got, err := Header[uint32](h, "Retry-After"); err != nil || got != 120 {
+		t.Errorf("Header[uint32]() = %v, %v, want 120", got, err)
+	}
+	if got, err := Header[time.Duration](h, "X-Timeout"); err != nil || got != 1500*time.Millisecond {
+		t.Errorf("Header[time.Duration]() = %v, %v, want 1.5s", got, err)
+	}
+	if got, err := Header[time.Time](h, "Last-Modified"); err != nil || !got.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Header[time.Time]() = %v, %v, want %v", got, err, time.Unix(1700000000, 0).UTC())
+	}
+	if got, err := Header[bool](h, "X-Flag"); err != nil || !got {
+		t.Errorf("Header[bool]() = %v, %v, want true", got, err)
+	}
+	if got, err := Header[int](h, "X-Multi"); err != nil || got != 7 {
+		t.Errorf("Header[int]() = %v, %v, want the first value 7", got, err)
+	}
+
+	tests := []struct {
+		name    string
+		convert func() error
+		wantErr error
+	}{
+		{"missing", func() error { _, err := Header[int](h, "X-Absent"); return err }, ErrMissing},
+		{"overflow", func() error { _, err := Header[int8](h, "X-Small"); return err }, ErrOverflow},
+		{"syntax", func() error { _, err := Header[int](h, "X-Timeout"); return err }, strconv.ErrSyntax},
+		{"badDate", func() error { _, err := Header[time.Time](h, "X-Flag"); return err }, nil},
+		{"unsupported", func() error { _, err := Header[[]int](h, "X-Multi"); return err }, ErrUnsupportedType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.convert()
+			if err == nil {
+				t.Fatal("Header() error = nil, want an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Header() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package into
+
+import "reflect"
+
+// DecodeHook converts scalar data for struct decoders such as mapstructure.
+//
+// DecodeHook has the signature of mapstructure.DecodeHookFuncType, so it can
+// be passed as the DecodeHook of a mapstructure.DecoderConfig or to
+// viper.DecodeHook without this package depending on them. It converts data
+// to the type to with the dispatch and boundary checks of TryIntoValue when
+// both types are supported, e.g. "5s" to time.Duration, "2024-05-01" to
+// time.Time or 2.0 to int. A float with a fraction is truncated or rounded
+// according to the options set by SetDefaults, and out-of-range values are
+// reported instead of wrapping around. Other data, such as maps and slices,
+// is returned unchanged for the decoder to handle.
+//
+// Parameters:
+//   - from: the type of data.
+//   - to: the type of the destination.
+//   - data: the value to be converted.
+//
+// Returns:
+//   - any: the converted value, or data if the types are not supported.
+//   - error: an error if the conversion fails.
+//
+// Example:
+//
+//	var cfg struct{ Timeout time.Duration }
+//	decoder, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+//	  DecodeHook: DecodeHook,
+//	  Result:     &cfg,
+//	})
+//	_ = decoder.Decode(map[string]any{"timeout": "5s"})
+//	fmt.Println(cfg.Timeout) // Output: 5s
+func DecodeHook(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if data == nil || from == to {
+		return data, nil
+	}
+	if to == timeType {
+		if _, ok := basicTypes[indirectType(from).Kind()]; !ok {
+			return data, nil
+		}
+	} else if !CanConvert(from, to) {
+		return data, nil
+	}
+
+	dst := reflect.New(to).Elem()
+	if err := TryIntoValue(dst, reflect.ValueOf(data)); err != nil {
+		return nil, err
+	}
+	return dst.Interface(), nil
+}
@@ -0,0 +1,51 @@
+package into_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+// decodeHookFuncType is the type of mapstructure.DecodeHookFuncType.
+type decodeHookFuncType func(reflect.Type, reflect.Type, interface{}) (interface{}, error)
+
+var _ decodeHookFuncType = DecodeHook
+
+func TestDecodeHook(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    any
+		to      reflect.Type
+		want    any
+		wantErr error
+	}{
+		{"duration", "5s", reflect.TypeOf(time.Duration(0)), 5 * time.Second, nil},
+		{"time", "2024-05-01", reflect.TypeOf(time.Time{}), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), nil},
+		{"floatToInt", 2.0, reflect.TypeOf(0), 2, nil},
+		{"stringToUint", "8080", reflect.TypeOf(uint16(0)), uint16(8080), nil},
+		{"named", "21.5", reflect.TypeOf(celsius(0)), celsius(21.5), nil},
+		{"overflow", 300, reflect.TypeOf(int8(0)), nil, ErrOverflow},
+		{"sameType", "a", reflect.TypeOf(""), "a", nil},
+		{"map", map[string]any{"a": 1}, reflect.TypeOf(struct{ A int }{}), map[string]any{"a": 1}, nil},
+		{"slice", []any{"1"}, reflect.TypeOf([]int{}), []any{"1"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeHook(reflect.TypeOf(tt.data), tt.to, tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecodeHook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeHook() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := DecodeHook(nil, reflect.TypeOf(0), nil); err != nil || got != nil {
+		t.Errorf("DecodeHook(nil) = %v, %v, want nil", got, err)
+	}
+}
@@ -1,57 +1,63 @@
+// Code generated by convgen; DO NOT EDIT.
+
 package into
 
 import (
-	"errors"
 	"math"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
-// TryIntoInt16 attempts to convert a value of type T to an int16.
+// TryIntoInt16 converts a value of type T to int16.
 //
-// TryIntoInt16 attempts to convert a value of type T to an int16.
-// It supports conversion from various types, including float64, float32,
-// int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64,
-// string, and bool.
+// TryIntoInt16 converts a value of type T to int16 with the default options,
+// like TryInto[int16]. It supports bool, the integer and float types, string,
+// and types based on them.
 //
 // Parameters:
 //   - value: the value to be converted.
 //
 // Returns:
 //   - int16: the converted int16 value.
-//   - error: an error if the conversion fails or if the value is out of range
-//     for int16.
+//   - error: an error if the value cannot be converted, e.g. because it is out
+//     of the int16 range.
+//
+// Example:
+//
+//	result, err := TryIntoInt16("123")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 123
 func TryIntoInt16[T convertable](value T) (int16, error) {
-	result, err := toInt16(value)
-	return result.(int16), err
+	result, err := toKindWith(reflect.Int16, value, Defaults())
+	observe[int16](value, err)
+	if err != nil {
+		return 0, err
+	}
+	return result.(int16), nil
 }
 
-// toInt16 is a helper function for TryIntoInt16 that handles conversion
-// based on the type of the input value.
+// toInt16 converts a value of a basic kind to int16.
 //
-// toInt16 is a helper function for TryIntoInt16 that handles conversion
-// based on the type of the input value. It supports conversion from various
-// types, including float64, float32, int, int8, int16, int32, int64, uint,
-// uint8, uint16, uint32, uint64, string, and bool.
+// toInt16 converts a value of a basic kind to int16 with the direct converter
+// for the kind, such as StringToInt16.
 //
 // Parameters:
 //   - value: the value to be converted.
 //
 // Returns:
-//   - any: the converted value.
-//   - error: an error if the conversion fails or if the value is out of range
-//     for int16.
+//   - any: the converted int16 value.
+//   - error: an error if the value cannot be converted.
 func toInt16(value any) (any, error) {
-	valueType := reflect.TypeOf(value)
-	switch valueType.Kind() {
-	case reflect.Float64:
-		return Float64ToInt16(value.(float64))
-	case reflect.Float32:
-		return Float32ToInt16(value.(float32))
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Bool:
+		return BoolIntoInt16(value.(bool)), nil
 	case reflect.Int:
 		return IntToInt16(value.(int))
 	case reflect.Int8:
-		return Int8ToInt16(value.(int8))
+		return Int8IntoInt16(value.(int8)), nil
 	case reflect.Int16:
 		return value.(int16), nil
 	case reflect.Int32:
@@ -61,307 +67,404 @@ func toInt16(value any) (any, error) {
 	case reflect.Uint:
 		return UintToInt16(value.(uint))
 	case reflect.Uint8:
-		return Uint8ToInt16(value.(uint8))
+		return Uint8IntoInt16(value.(uint8)), nil
 	case reflect.Uint16:
 		return Uint16ToInt16(value.(uint16))
 	case reflect.Uint32:
 		return Uint32ToInt16(value.(uint32))
 	case reflect.Uint64:
 		return Uint64ToInt16(value.(uint64))
+	case reflect.Float32:
+		return Float32ToInt16(value.(float32))
+	case reflect.Float64:
+		return Float64ToInt16(value.(float64))
 	case reflect.String:
 		return StringToInt16(value.(string))
-	case reflect.Bool:
-		return BoolToInt16(value.(bool))
 	default:
-		return 0, errors.New("unsupported type")
+		return 0, unsupportedError(value, "int16")
 	}
 }
 
-// BoolToInt16 converts a boolean value to an int16.
+// BoolIntoInt16 converts a bool value to int16.
 //
-// BoolToInt16 converts a boolean value to an int16. True is converted to 1,
-// and false is converted to 0.
+// BoolIntoInt16 converts a bool value to int16. True is converted to 1 and
+// false to 0.
 //
 // Parameters:
-//   - value: the boolean value to be converted.
+//   - value: the bool value to be converted.
 //
 // Returns:
 //   - int16: the converted int16 value.
-//   - error: nil.
-func BoolToInt16(value bool) (int16, error) {
+//
+// Example:
+//
+//	fmt.Println(BoolIntoInt16(true)) // Output: 1
+func BoolIntoInt16(value bool) int16 {
 	if value {
-		return 1, nil
+		return 1
 	}
-	return 0, nil
+	return 0
 }
 
-// Float32ToInt16 converts a float32 value to an int16.
+// BoolToInt16 converts a bool value to int16.
 //
-// Float32ToInt16 converts a float32 value to an int16. If the value is
-// outside the range of int16, it returns an error.
+// BoolToInt16 converts a bool value to int16 like BoolIntoInt16. The conversion
+// cannot fail, so the error is always nil.
 //
 // Parameters:
-//   - value: the float32 value to be converted. The range of float32 is
-//     approximately ±3.4E38. The function ensures that the input value is
-//     within the range of int16, which is approximately -32,768 to 32,767.
+//   - value: the bool value to be converted.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
-func Float32ToInt16(value float32) (int16, error) {
-	if value > math.MaxInt16 || value < math.MinInt16 {
-		return 0, errors.New("value out of range for int16")
-	}
-	return int16(value), nil
+//   - int16: the converted int16 value.
+//   - error: nil.
+//
+// Deprecated: Use BoolIntoInt16, which has no error to handle.
+func BoolToInt16(value bool) (int16, error) {
+	return BoolIntoInt16(value), nil
 }
 
-// Float64ToInt16 converts a float64 value to an int16.
+// IntToInt16 converts an int value to int16.
 //
-// Float64ToInt16 converts a float64 value to an int16. If the value is
-// outside the range of int16, it returns an error.
+// IntToInt16 converts an int value to int16. It returns an error if the value
+// is outside the range of int16.
 //
 // Parameters:
-//   - value: the float64 value to be converted. The range of float64 is
-//     approximately ±1.7E308. The function ensures that the input value is
-//     within the range of int16, which is approximately -32,768 to 32,767.
+//   - value: the int value to be converted. The range of int is that of int32
+//     or int64, depending on the platform.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
-func Float64ToInt16(value float64) (int16, error) {
-	if value > math.MaxInt16 || value < math.MinInt16 {
-		return 0, errors.New("value out of range for int16")
-	}
-	return int16(value), nil
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if the
+//     value is out of the int16 range.
+func IntToInt16(value int) (int16, error) {
+	return checkIntRange[int16](value)
 }
 
-// IntToInt16 converts an int value to an int16.
+// Int8IntoInt16 converts an int8 value to int16.
 //
-// IntToInt16 converts an int value to an int16. If the value is outside the
-// range of int16, it returns an error.
+// Int8IntoInt16 converts an int8 value to int16 exactly. Every int8 value is
+// representable in int16 on every platform, so the conversion cannot fail.
 //
 // Parameters:
-//   - value: the int value to be converted. The range of int is
-//     approximately -9,223,372,036,854,775,808 to 9,223,372,036,854,775,807.
-//     The function ensures that the input value is within the range of int16,
-//     which is approximately -32,768 to 32,767.
+//   - value: the int8 value to be converted.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
-func IntToInt16(value int) (int16, error) {
-	if value > math.MaxInt16 || value < math.MinInt16 {
-		return 0, errors.New("value out of range for int16")
-	}
-	return int16(value), nil
+//   - int16: the converted int16 value.
+//
+// Example:
+//
+//	fmt.Println(Int8IntoInt16(-128)) // Output: -128
+func Int8IntoInt16(value int8) int16 {
+	return int16(value)
 }
 
-// Int8ToInt16 converts an int8 value to an int16.
+// Int8ToInt16 converts an int8 value to int16.
 //
-// Int8ToInt16 converts an int8 value to an int16. The range of int8 is
-// -128 to 127, which is a subset of the range of int16 (-32,768 to 32,767).
-// Therefore, no range check is necessary.
+// Int8ToInt16 converts an int8 value to int16 like Int8IntoInt16. The
+// conversion cannot fail, so the error is always nil.
 //
 // Parameters:
-//   - value: the int8 value to be converted. The range of int8 is
-//     approximately -128 to 127.
+//   - value: the int8 value to be converted.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
+//   - int16: the converted int16 value.
 //   - error: nil.
+//
+// Deprecated: Use Int8IntoInt16, which has no error to handle.
 func Int8ToInt16(value int8) (int16, error) {
-	return int16(value), nil
+	return Int8IntoInt16(value), nil
 }
 
-// Int16ToInt16 converts an int16 value to an int16.
+// Int16ToInt16 converts an int16 value to int16.
 //
-// Int16ToInt16 converts an int16 value to an int16. This function simply
-// returns the input value without any conversion or range check.
+// Int16ToInt16 returns the value unchanged, so that every pair of basic types
+// has a direct converter.
 //
 // Parameters:
-//   - value: the int16 value to be converted. The range of int16 is
-//     approximately -32,768 to 32,767.
+//   - value: the int16 value to be converted.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
+//   - int16: the value.
 //   - error: nil.
 func Int16ToInt16(value int16) (int16, error) {
 	return value, nil
 }
 
-// Int32ToInt16 converts an int32 value to an int16.
+// Int32ToInt16 converts an int32 value to int16.
 //
-// Int32ToInt16 converts an int32 value to an int16. If the value is outside
-// the range of int16, it returns an error.
+// Int32ToInt16 converts an int32 value to int16. It returns an error if the
+// value is outside the range of int16.
 //
 // Parameters:
 //   - value: the int32 value to be converted. The range of int32 is
-//     approximately -2,147,483,648 to 2,147,483,647. The function ensures
-//     that the input value is within the range of int16, which is
-//     approximately -32,768 to 32,767.
+//     -2,147,483,648 to 2,147,483,647.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if the
+//     value is out of the int16 range.
 func Int32ToInt16(value int32) (int16, error) {
-	if value > math.MaxInt16 || value < math.MinInt16 {
-		return 0, errors.New("value out of range for int16")
-	}
-	return int16(value), nil
+	return checkIntRange[int16](value)
 }
 
-// Int64ToInt16 converts an int64 value to an int16.
+// Int64ToInt16 converts an int64 value to int16.
 //
-// Int64ToInt16 converts an int64 value to an int16. If the value is outside
-// the range of int16, it returns an error.
+// Int64ToInt16 converts an int64 value to int16. It returns an error if the
+// value is outside the range of int16.
 //
 // Parameters:
 //   - value: the int64 value to be converted. The range of int64 is
-//     approximately -9,223,372,036,854,775,808 to 9,223,372,036,854,775,807.
-//     The function ensures that the input value is within the range of int16,
-//     which is approximately -32,768 to 32,767.
+//     -9,223,372,036,854,775,808 to 9,223,372,036,854,775,807.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if the
+//     value is out of the int16 range.
 func Int64ToInt16(value int64) (int16, error) {
-	if value > math.MaxInt16 || value < math.MinInt16 {
-		return 0, errors.New("value out of range for int16")
-	}
-	return int16(value), nil
+	return checkIntRange[int16](value)
 }
 
-// StringToInt16 converts a string value to an int16.
+// UintToInt16 converts a uint value to int16.
 //
-// StringToInt16 converts a string value to an int16. It parses the string
-// using base 10. If the string cannot be parsed as an integer, it returns an
-// error. If the parsed integer is outside the range of int16, it returns an
-// error.
+// UintToInt16 converts a uint value to int16. It returns an error if the value
+// is outside the range of int16.
 //
 // Parameters:
-//   - value: the string value to be converted.
+//   - value: the uint value to be converted. The range of uint is that of
+//     uint32 or uint64, depending on the platform.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the string cannot be parsed as an integer or if the
-//     parsed integer is out of the int16 range.
-func StringToInt16(value string) (int16, error) {
-	i, err := strconv.ParseInt(value, 10, 16)
-	if err != nil {
-		return 0, err
-	}
-	return int16(i), nil
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a *ConversionError wrapping ErrOverflow if the value exceeds the
+//     maximum of int16.
+func UintToInt16(value uint) (int16, error) {
+	return checkUintRange[int16](value)
 }
 
-// UintToInt16 converts a uint value to an int16.
+// Uint8IntoInt16 converts a uint8 value to int16.
 //
-// UintToInt16 converts a uint value to an int16. If the value is outside the
-// range of int16, it returns an error.
+// Uint8IntoInt16 converts a uint8 value to int16 exactly. Every uint8 value is
+// representable in int16 on every platform, so the conversion cannot fail.
 //
 // Parameters:
-//   - value: the uint value to be converted. The range of uint is
-//     approximately 0 to 18,446,744,073,709,551,615. The function ensures that
-//     the input value is within the range of int16, which is
-//     approximately -32,768 to 32,767.
+//   - value: the uint8 value to be converted.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
-func UintToInt16(value uint) (int16, error) {
-	if value > math.MaxInt16 {
-		return 0, errors.New("value exceeds int16 max limit")
-	}
-	return int16(value), nil
+//   - int16: the converted int16 value.
+//
+// Example:
+//
+//	fmt.Println(Uint8IntoInt16(255)) // Output: 255
+func Uint8IntoInt16(value uint8) int16 {
+	return int16(value)
 }
 
-// Uint8ToInt16 converts a uint8 value to an int16.
+// Uint8ToInt16 converts a uint8 value to int16.
 //
-// Uint8ToInt16 converts a uint8 value to an int16. The range of uint8 is
-// 0 to 255, which is a subset of the range of int16 (-32,768 to 32,767).
-// Therefore, no range check is necessary.
+// Uint8ToInt16 converts a uint8 value to int16 like Uint8IntoInt16. The
+// conversion cannot fail, so the error is always nil.
 //
 // Parameters:
-//   - value: the uint8 value to be converted. The range of uint8 is
-//     approximately 0 to 255.
+//   - value: the uint8 value to be converted.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
+//   - int16: the converted int16 value.
 //   - error: nil.
+//
+// Deprecated: Use Uint8IntoInt16, which has no error to handle.
 func Uint8ToInt16(value uint8) (int16, error) {
-	return int16(value), nil
+	return Uint8IntoInt16(value), nil
 }
 
-// Uint16ToInt16 converts a uint16 value to an int16.
+// Uint16ToInt16 converts a uint16 value to int16.
 //
-// Uint16ToInt16 converts a uint16 value to an int16. If the value is outside
-// the range of int16, it returns an error.
+// Uint16ToInt16 converts a uint16 value to int16. It returns an error if the
+// value is outside the range of int16.
 //
 // Parameters:
-//   - value: the uint16 value to be converted. The range of uint16 is
-//     approximately 0 to 65,535. The function ensures that the input value is
-//     within the range of int16, which is approximately -32,768 to 32,767.
+//   - value: the uint16 value to be converted. The range of uint16 is 0 to
+//     65,535.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a *ConversionError wrapping ErrOverflow if the value exceeds the
+//     maximum of int16.
 func Uint16ToInt16(value uint16) (int16, error) {
-	if value > math.MaxInt16 {
-		return 0, errors.New("value exceeds int16 max limit")
+	return checkUintRange[int16](value)
+}
+
+// Uint32ToInt16 converts a uint32 value to int16.
+//
+// Uint32ToInt16 converts a uint32 value to int16. It returns an error if the
+// value is outside the range of int16.
+//
+// Parameters:
+//   - value: the uint32 value to be converted. The range of uint32 is 0 to
+//     4,294,967,295.
+//
+// Returns:
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a *ConversionError wrapping ErrOverflow if the value exceeds the
+//     maximum of int16.
+func Uint32ToInt16(value uint32) (int16, error) {
+	return checkUintRange[int16](value)
+}
+
+// Uint64ToInt16 converts a uint64 value to int16.
+//
+// Uint64ToInt16 converts a uint64 value to int16. It returns an error if the
+// value is outside the range of int16.
+//
+// Parameters:
+//   - value: the uint64 value to be converted. The range of uint64 is 0 to
+//     18,446,744,073,709,551,615.
+//
+// Returns:
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a *ConversionError wrapping ErrOverflow if the value exceeds the
+//     maximum of int16.
+func Uint64ToInt16(value uint64) (int16, error) {
+	return checkUintRange[int16](value)
+}
+
+// Float32ToInt16 converts a float32 value to int16.
+//
+// Float32ToInt16 converts a float32 value to int16, truncating it toward zero.
+// It returns an error if the value is NaN or the truncated value is outside the
+// range of int16; use Float32ToInt16Exact to reject values with a fraction as
+// well.
+//
+// Parameters:
+//   - value: the float32 value to be converted. The range of float32 is
+//     approximately ±3.4E38.
+//
+// Returns:
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a *ConversionError wrapping ErrNaN if the value is NaN, or
+//     ErrOverflow or ErrUnderflow if it is out of the int16 range.
+func Float32ToInt16(value float32) (int16, error) {
+	return checkFloatToInt[int16](value)
+}
+
+// Float32ToInt16Exact converts a float32 value to int16 without dropping a fraction.
+//
+// Float32ToInt16Exact converts a float32 value to int16 like Float32ToInt16,
+// but returns ErrLossOfPrecision instead of truncating when the value has a
+// fractional component or is NaN.
+//
+// Parameters:
+//   - value: the float32 value to be converted.
+//
+// Returns:
+//   - int16: the converted int16 value.
+//   - error: ErrLossOfPrecision if the value is not integral, or an error if
+//     the input value is out of the int16 range.
+//
+// Example:
+//
+//	_, err := Float32ToInt16Exact(123.456)
+//	fmt.Println(errors.Is(err, ErrLossOfPrecision)) // Output: true
+func Float32ToInt16Exact(value float32) (int16, error) {
+	if f := float64(value); f != math.Trunc(f) {
+		return 0, ErrLossOfPrecision
 	}
-	return int16(value), nil
+	return Float32ToInt16(value)
 }
 
-// Uint32ToInt16 converts a uint32 value to an int16.
+// Float64ToInt16 converts a float64 value to int16.
 //
-// Uint32ToInt16 converts a uint32 value to an int16. If the value is outside
-// the range of int16, it returns an error.
+// Float64ToInt16 converts a float64 value to int16, truncating it toward zero.
+// It returns an error if the value is NaN or the truncated value is outside the
+// range of int16; use Float64ToInt16Exact to reject values with a fraction as
+// well.
 //
 // Parameters:
-//   - value: the uint32 value to be converted. The range of uint32 is
-//     approximately 0 to 4,294,967,295. The function ensures that the input
-//     value is within the range of int16, which is approximately -32,768 to
+//   - value: the float64 value to be converted. The range of float64 is
+//     approximately ±1.7E308.
+//
+// Returns:
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
 //     32,767.
+//   - error: a *ConversionError wrapping ErrNaN if the value is NaN, or
+//     ErrOverflow or ErrUnderflow if it is out of the int16 range.
+func Float64ToInt16(value float64) (int16, error) {
+	return checkFloatToInt[int16](value)
+}
+
+// Float64ToInt16Exact converts a float64 value to int16 without dropping a fraction.
+//
+// Float64ToInt16Exact converts a float64 value to int16 like Float64ToInt16,
+// but returns ErrLossOfPrecision instead of truncating when the value has a
+// fractional component or is NaN.
+//
+// Parameters:
+//   - value: the float64 value to be converted.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
-func Uint32ToInt16(value uint32) (int16, error) {
-	if value > math.MaxInt16 {
-		return 0, errors.New("value exceeds int16 max limit")
+//   - int16: the converted int16 value.
+//   - error: ErrLossOfPrecision if the value is not integral, or an error if
+//     the input value is out of the int16 range.
+//
+// Example:
+//
+//	_, err := Float64ToInt16Exact(123.456)
+//	fmt.Println(errors.Is(err, ErrLossOfPrecision)) // Output: true
+func Float64ToInt16Exact(value float64) (int16, error) {
+	if value != math.Trunc(value) {
+		return 0, ErrLossOfPrecision
 	}
-	return int16(value), nil
+	return Float64ToInt16(value)
 }
 
-// Uint64ToInt16 converts a uint64 value to an int16.
+// StringToInt16 converts a string value to int16.
 //
-// Uint64ToInt16 converts a uint64 value to an int16. If the value is outside
-// the range of int16, it returns an error.
+// StringToInt16 converts a string value to int16. It parses the string as a
+// base 10 integer with an optional sign, as strconv.ParseInt does.
 //
 // Parameters:
-//   - value: the uint64 value to be converted. The range of uint64 is
-//     approximately 0 to 18,446,744,073,709,551,615. The function ensures that
-//     the input value is within the range of int16, which is
-//     approximately -32,768 to 32,767.
+//   - value: the string value to be converted.
 //
 // Returns:
-//   - int16: the converted int16 value. The range of int16 is
-//     approximately -32,768 to 32,767.
-//   - error: an error if the input value is out of the int16 range.
-func Uint64ToInt16(value uint64) (int16, error) {
-	if value > math.MaxInt16 {
-		return 0, errors.New("value exceeds int16 max limit")
+//   - int16: the converted int16 value. The range of int16 is -32,768 to
+//     32,767.
+//   - error: a ConversionError wrapping strconv.ErrSyntax if the input value is
+//     not a valid integer, or ErrOverflow or ErrUnderflow if it is out of the
+//     int16 range.
+func StringToInt16(value string) (int16, error) {
+	i, err := strconv.ParseInt(value, 10, 16)
+	if err != nil {
+		return 0, parseError(err, value, "int16")
 	}
-	return int16(value), nil
+	return int16(i), nil
+}
+
+// StringToInt16Trimmed converts a string value to int16 after trimming white space.
+//
+// StringToInt16Trimmed converts a string value to int16 like StringToInt16,
+// after removing leading and trailing white space from the input.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - int16: the converted int16 value.
+//   - error: an error if the trimmed value cannot be converted.
+//
+// Example:
+//
+//	result, err := StringToInt16Trimmed(" 123\n")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 123
+func StringToInt16Trimmed(value string) (int16, error) {
+	return StringToInt16(strings.TrimSpace(value))
 }
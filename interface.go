@@ -1,18 +1,116 @@
 package into
 
+import "reflect"
+
+// ITryInto is implemented by values that convert themselves to T.
+//
+// TryInto, TryIntoAny and TryIntoValue call the TryInto method of a source
+// value when T is exactly the target type and no function added with
+// Register matches.
 type ITryInto[T any] interface {
 	TryInto() (T, error)
 }
 
+// IInto is implemented by values that convert themselves to T and cannot
+// fail. It is used like ITryInto.
 type IInto[T any] interface {
 	Into() T
 }
 
-// TODO: Implement TryFrom and From Converters
+// ITryFrom is implemented by pointers to types that fill themselves from a
+// value of type T.
+//
+// TryInto, TryIntoAny and TryIntoValue call the TryFrom method of a new
+// target value when the source value is assignable to T, and neither a
+// function added with Register nor an ITryInto method of the source
+// matches. T may be an interface, such as Angle, so that one method accepts
+// a family of source types:
+//
+//	func (d *Degrees) TryFrom(a Angle) error {
+//	  *d = Degrees(a.Radians() * 180 / math.Pi)
+//	  return nil
+//	}
 type ITryFrom[T any] interface {
-	TryFrom() (T, error)
+	TryFrom(value T) error
 }
 
+// IFrom is implemented by pointers to types that fill themselves from a
+// value of type T and cannot fail. It is used like ITryFrom.
 type IFrom[T any] interface {
-	From() T
+	From(value T)
+}
+
+// errorType is the reflect.Type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// convertMethod converts value to dst with the conversion methods of the
+// source or target type.
+//
+// convertMethod reports whether the type of value implements ITryInto or
+// IInto for dst, or the pointer type of dst implements ITryFrom or IFrom for
+// a type value is assignable to. If one does, it returns the result of the
+// method.
+func convertMethod(value any, dst reflect.Type) (any, bool, error) {
+	src := reflect.ValueOf(value)
+	if !src.IsValid() {
+		return nil, false, nil
+	}
+
+	if src.NumMethod() > 0 {
+		if m := src.MethodByName("TryInto"); m.IsValid() && isMethod(m.Type(), nil, []reflect.Type{dst, errorType}) {
+			out := m.Call(nil)
+			err, _ := out[1].Interface().(error)
+			return out[0].Interface(), true, err
+		}
+		if m := src.MethodByName("Into"); m.IsValid() && isMethod(m.Type(), nil, []reflect.Type{dst}) {
+			return m.Call(nil)[0].Interface(), true, nil
+		}
+	}
+
+	ptr := reflect.PtrTo(dst)
+	if ptr.NumMethod() == 0 {
+		return nil, false, nil
+	}
+	if m, ok := ptr.MethodByName("TryFrom"); ok && isFromMethod(m.Type, src.Type(), []reflect.Type{errorType}) {
+		result := reflect.New(dst)
+		out := result.Method(m.Index).Call([]reflect.Value{src})
+		if err, _ := out[0].Interface().(error); err != nil {
+			return nil, true, err
+		}
+		return result.Elem().Interface(), true, nil
+	}
+	if m, ok := ptr.MethodByName("From"); ok && isFromMethod(m.Type, src.Type(), nil) {
+		result := reflect.New(dst)
+		result.Method(m.Index).Call([]reflect.Value{src})
+		return result.Elem().Interface(), true, nil
+	}
+	return nil, false, nil
+}
+
+// isMethod reports whether the method type t has exactly the given
+// parameter and result types.
+func isMethod(t reflect.Type, in []reflect.Type, out []reflect.Type) bool {
+	if t.NumIn() != len(in) || t.NumOut() != len(out) || t.IsVariadic() {
+		return false
+	}
+	for i, typ := range in {
+		if t.In(i) != typ {
+			return false
+		}
+	}
+	for i, typ := range out {
+		if t.Out(i) != typ {
+			return false
+		}
+	}
+	return true
+}
+
+// isFromMethod reports whether the method type t, including its receiver,
+// takes one parameter src is assignable to and has the given result types.
+func isFromMethod(t reflect.Type, src reflect.Type, out []reflect.Type) bool {
+	if t.NumIn() != 2 || !src.AssignableTo(t.In(1)) {
+		return false
+	}
+	return isMethod(t, []reflect.Type{t.In(0), t.In(1)}, out)
 }
@@ -0,0 +1,112 @@
+// Package basictypes describes the basic types supported by into.
+//
+// The table is shared by the code generators of the module, so that adding
+// a type to it adds the direct converters of the type (convgen), the
+// converters of named types with it as underlying type (intogen) and the
+// cases of the conversion matrix test (matrixgen).
+package basictypes
+
+// Kind classifies the basic types by the way values are converted.
+type Kind int
+
+const (
+	Bool Kind = iota
+	Int
+	Uint
+	Float
+	String
+)
+
+// Type is a basic type supported by into.
+type Type struct {
+	// Type is the name of the type.
+	Type string
+	// Name is the name used in function names.
+	Name string
+	// Kind is the kind of the type.
+	Kind Kind
+	// Bits is the size of an integer or float type, with int and uint
+	// taken as 64 bits.
+	Bits int
+	// Platform reports whether the size of the type depends on the
+	// platform. Bits is then the largest size, and 32 the smallest.
+	Platform bool
+}
+
+// MinBits returns the smallest size of the type on any platform.
+func (t Type) MinBits() int {
+	if t.Platform {
+		return 32
+	}
+	return t.Bits
+}
+
+// Numeric reports whether t is an integer or float type.
+func (t Type) Numeric() bool {
+	return t.Kind == Int || t.Kind == Uint || t.Kind == Float
+}
+
+// WidensTo reports whether every value of the numeric type t converts to
+// the numeric type u exactly on every platform, so the conversion cannot
+// fail. int and uint are taken as 64 bits when converted and as 32 bits
+// when converted to.
+func (t Type) WidensTo(u Type) bool {
+	switch {
+	case !t.Numeric() || !u.Numeric():
+		return false
+	case t == u:
+		return true
+	case t.Kind == Float:
+		return u.Kind == Float && t.Bits <= u.Bits
+	case u.Kind == Float:
+		return t.Bits <= u.Significand()
+	case t.Kind == Int && u.Kind == Uint:
+		return false
+	case t.Kind == Uint && u.Kind == Int:
+		return t.Bits < u.MinBits()
+	}
+	return t.Bits <= u.MinBits()
+}
+
+// Infallible reports whether the conversion from t to the different type u
+// cannot fail, so that into has an XxxIntoYyy converter for it: bool to
+// any other type, a number to bool or string, an integer to a float, and a
+// number to a type it widens to.
+func (t Type) Infallible(u Type) bool {
+	switch {
+	case t == u || t.Kind == String:
+		return false
+	case t.Kind == Bool || u.Kind == Bool || u.Kind == String:
+		return true
+	case u.Kind == Float && t.Kind != Float:
+		return true
+	}
+	return t.WidensTo(u)
+}
+
+// Significand returns the number of significand bits of the float type t,
+// including the implicit bit.
+func (t Type) Significand() int {
+	if t.Bits == 32 {
+		return 24
+	}
+	return 53
+}
+
+// Types lists the basic types in the order in which code is generated.
+var Types = []Type{
+	{"bool", "Bool", Bool, 0, false},
+	{"int", "Int", Int, 64, true},
+	{"int8", "Int8", Int, 8, false},
+	{"int16", "Int16", Int, 16, false},
+	{"int32", "Int32", Int, 32, false},
+	{"int64", "Int64", Int, 64, false},
+	{"uint", "Uint", Uint, 64, true},
+	{"uint8", "Uint8", Uint, 8, false},
+	{"uint16", "Uint16", Uint, 16, false},
+	{"uint32", "Uint32", Uint, 32, false},
+	{"uint64", "Uint64", Uint, 64, false},
+	{"float32", "Float32", Float, 32, false},
+	{"float64", "Float64", Float, 64, false},
+	{"string", "String", String, 0, false},
+}
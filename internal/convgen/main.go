@@ -0,0 +1,638 @@
+// Command convgen generates the direct converters of into.
+//
+// convgen writes one file per integer and float type of the basic type
+// table, e.g. int16.go, with the TryIntoXxx function, the toXxx dispatch
+// helper and the XxxToYyy direct converters from every basic type, plus the
+// Exact and Trimmed variants. The range checks are delegated to the shared
+// helpers of bounds.go, so every pair of types follows the same rules. The
+// conversions that cannot fail get an XxxIntoYyy converter without an error
+// result, and their XxxToYyy converter is kept as a deprecated wrapper. It
+// also writes lossless.go, with the WidensToXxx constraints and the table of
+// lossless kind pairs.
+//
+// Usage:
+//
+//	convgen [-dir directory]
+//
+// It is run with go generate from the root of the module:
+//
+//	//go:generate go run ./internal/convgen
+//
+// To add a target type, add it to the table of package basictypes; the
+// matrix test generated by matrixgen then covers its converters as well.
+// The converters to bool and string have format-specific variants and are
+// written by hand.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/zenless-lab/into/internal/basictypes"
+)
+
+// basicType is a basic type supported by into.
+type basicType = basictypes.Type
+
+// converter is a generated function.
+type converter struct {
+	// Head is the first line of the doc comment.
+	Head string
+	// Text is the paragraph of the doc comment.
+	Text string
+	// Params and Returns are the items of the Parameters and Returns
+	// sections of the doc comment.
+	Params, Returns []string
+	// Example is the example code of the doc comment, if any.
+	Example string
+	// Deprecated is the Deprecated paragraph of the doc comment, if any.
+	Deprecated string
+	// Signature is the signature of the function, without func.
+	Signature string
+	// Body is the body of the function.
+	Body string
+}
+
+// article returns the indefinite article for a value of type t.
+func article(t string) string {
+	if strings.HasPrefix(t, "int") {
+		return "an"
+	}
+	return "a"
+}
+
+// typeRange describes the range of the numeric type t.
+func typeRange(t basicType) string {
+	switch {
+	case t.Kind == basictypes.Float && t.Bits == 32:
+		return "approximately ±3.4E38"
+	case t.Kind == basictypes.Float:
+		return "approximately ±1.7E308"
+	case t.Platform:
+		return fmt.Sprintf("that of %s32 or %s64, depending on the platform", t.Type, t.Type)
+	}
+	one := big.NewInt(1)
+	if t.Kind == basictypes.Uint {
+		max := new(big.Int).Lsh(one, uint(t.Bits))
+		return "0 to " + group(max.Sub(max, one).String())
+	}
+	limit := new(big.Int).Lsh(one, uint(t.Bits-1))
+	return group("-"+limit.String()) + " to " + group(limit.Sub(limit, one).String())
+}
+
+// group inserts thousands separators into the decimal integer s.
+func group(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s
+}
+
+// converters returns the functions generated for the target type to.
+func converters(to basicType) []converter {
+	cs := []converter{tryInto(to), dispatch(to)}
+	for _, from := range basictypes.Types {
+		switch {
+		case from == to:
+			cs = append(cs, identity(to))
+		case from.WidensTo(to):
+			c := widen(from, to)
+			cs = append(cs, c, wrapper(c, from, to))
+		case from.Kind == basictypes.Bool:
+			c := fromBool(to)
+			cs = append(cs, c, wrapper(c, from, to))
+		case from.Kind == basictypes.String:
+			cs = append(cs, fromString(to), fromStringTrimmed(to))
+		case to.Kind == basictypes.Float && from.Kind == basictypes.Float:
+			cs = append(cs, floatToFloat(from, to)...)
+		case to.Kind == basictypes.Float:
+			cs = append(cs, intToFloat(from, to)...)
+		case from.Kind == basictypes.Float:
+			cs = append(cs, floatToInt(from, to), floatToIntExact(from, to))
+		default:
+			cs = append(cs, intToInt(from, to))
+		}
+	}
+	return cs
+}
+
+// name returns the name of the converter from one type to another.
+func name(from, to basicType) string {
+	return from.Name + "To" + to.Name
+}
+
+// intoName returns the name of the converter without an error from one type
+// to another.
+func intoName(from, to basicType) string {
+	return from.Name + "Into" + to.Name
+}
+
+// signature returns the signature of the converter named fn.
+func signature(fn string, from, to basicType) string {
+	return fmt.Sprintf("%s(value %s) (%s, error)", fn, from.Type, to.Type)
+}
+
+func tryInto(to basicType) converter {
+	fn := "TryInto" + to.Name
+	return converter{
+		Head: fmt.Sprintf("%s converts a value of type T to %s.", fn, to.Type),
+		Text: fmt.Sprintf("%s converts a value of type T to %s with the default options, like TryInto[%s]. "+
+			"It supports bool, the integer and float types, string, and types based on them.", fn, to.Type, to.Type),
+		Params: []string{"value: the value to be converted."},
+		Returns: []string{
+			fmt.Sprintf("%s: the converted %s value.", to.Type, to.Type),
+			fmt.Sprintf("error: an error if the value cannot be converted, e.g. because it is out of the %s range.", to.Type),
+		},
+		Example:   fmt.Sprintf("result, err := %s(\"123\")\nif err != nil {\n  log.Fatal(err)\n}\nfmt.Println(result) // Output: 123", fn),
+		Signature: fmt.Sprintf("%s[T convertable](value T) (%s, error)", fn, to.Type),
+		Body: fmt.Sprintf("\tresult, err := toKindWith(reflect.%s, value, Defaults())\n\tobserve[%s](value, err)\n"+
+			"\tif err != nil {\n\t\treturn 0, err\n\t}\n\treturn result.(%s), nil", to.Name, to.Type, to.Type),
+	}
+}
+
+func dispatch(to basicType) converter {
+	fn := "to" + to.Name
+	var body strings.Builder
+	body.WriteString("\tswitch reflect.TypeOf(value).Kind() {\n")
+	for _, from := range basictypes.Types {
+		fmt.Fprintf(&body, "\tcase reflect.%s:\n", from.Name)
+		switch {
+		case from == to:
+			fmt.Fprintf(&body, "\t\treturn value.(%s), nil\n", to.Type)
+		case from.Infallible(to):
+			fmt.Fprintf(&body, "\t\treturn %s(value.(%s)), nil\n", intoName(from, to), from.Type)
+		default:
+			fmt.Fprintf(&body, "\t\treturn %s(value.(%s))\n", name(from, to), from.Type)
+		}
+	}
+	fmt.Fprintf(&body, "\tdefault:\n\t\treturn 0, unsupportedError(value, %q)\n\t}", to.Type)
+	return converter{
+		Head: fmt.Sprintf("%s converts a value of a basic kind to %s.", fn, to.Type),
+		Text: fmt.Sprintf("%s converts a value of a basic kind to %s with the direct converter "+
+			"for the kind, such as %s.", fn, to.Type, name(basictypes.Types[len(basictypes.Types)-1], to)),
+		Params: []string{"value: the value to be converted."},
+		Returns: []string{
+			fmt.Sprintf("any: the converted %s value.", to.Type),
+			"error: an error if the value cannot be converted.",
+		},
+		Signature: fn + "(value any) (any, error)",
+		Body:      body.String(),
+	}
+}
+
+func identity(t basicType) converter {
+	fn := name(t, t)
+	return converter{
+		Head:      fmt.Sprintf("%s converts %s %s value to %s.", fn, article(t.Type), t.Type, t.Type),
+		Text:      fmt.Sprintf("%s returns the value unchanged, so that every pair of basic types has a direct converter.", fn),
+		Params:    []string{fmt.Sprintf("value: the %s value to be converted.", t.Type)},
+		Returns:   []string{fmt.Sprintf("%s: the value.", t.Type), "error: nil."},
+		Signature: signature(fn, t, t),
+		Body:      "\treturn value, nil",
+	}
+}
+
+func fromBool(to basicType) converter {
+	from := basictypes.Types[0]
+	fn := intoName(from, to)
+	return converter{
+		Head:      fmt.Sprintf("%s converts a bool value to %s.", fn, to.Type),
+		Text:      fmt.Sprintf("%s converts a bool value to %s. True is converted to 1 and false to 0.", fn, to.Type),
+		Params:    []string{"value: the bool value to be converted."},
+		Returns:   []string{fmt.Sprintf("%s: the converted %s value.", to.Type, to.Type)},
+		Example:   fmt.Sprintf("fmt.Println(%s(true)) // Output: 1", fn),
+		Signature: fmt.Sprintf("%s(value bool) %s", fn, to.Type),
+		Body:      "\tif value {\n\t\treturn 1\n\t}\n\treturn 0",
+	}
+}
+
+func fromString(to basicType) converter {
+	from := basictypes.Types[len(basictypes.Types)-1]
+	fn := name(from, to)
+	c := converter{
+		Head:      fmt.Sprintf("%s converts a string value to %s.", fn, to.Type),
+		Params:    []string{"value: the string value to be converted."},
+		Signature: signature(fn, from, to),
+	}
+
+	bits := fmt.Sprint(to.Bits)
+	if to.Platform {
+		bits = "strconv.IntSize"
+	}
+	result := to.Type + "(i)"
+	if to.Type == "int64" || to.Type == "uint64" {
+		result = "i"
+	}
+	switch to.Kind {
+	case basictypes.Int:
+		c.Text = fmt.Sprintf("%s converts a string value to %s. It parses the string as a base 10 integer "+
+			"with an optional sign, as strconv.ParseInt does.", fn, to.Type)
+		c.Returns = []string{
+			fmt.Sprintf("%s: the converted %s value. The range of %s is %s.", to.Type, to.Type, to.Type, typeRange(to)),
+			fmt.Sprintf("error: a ConversionError wrapping strconv.ErrSyntax if the input value is not a valid integer, "+
+				"or ErrOverflow or ErrUnderflow if it is out of the %s range.", to.Type),
+		}
+		c.Body = fmt.Sprintf("\ti, err := strconv.ParseInt(value, 10, %s)\n\tif err != nil {\n\t\treturn 0, parseError(err, value, %q)\n\t}\n\treturn %s, nil",
+			bits, to.Type, result)
+	case basictypes.Uint:
+		c.Text = fmt.Sprintf("%s converts a string value to %s. It parses the string as a base 10 integer "+
+			"without a sign, as strconv.ParseUint does.", fn, to.Type)
+		c.Returns = []string{
+			fmt.Sprintf("%s: the converted %s value. The range of %s is %s.", to.Type, to.Type, to.Type, typeRange(to)),
+			fmt.Sprintf("error: a ConversionError wrapping strconv.ErrSyntax if the input value is not a valid "+
+				"integer, ErrUnderflow if it is negative, or ErrOverflow if it exceeds the maximum of %s.", to.Type),
+		}
+		c.Body = fmt.Sprintf("\ti, err := strconv.ParseUint(value, 10, %s)\n\tif err != nil {\n\t\treturn 0, parseError(err, value, %q)\n\t}\n\treturn %s, nil",
+			bits, to.Type, result)
+	default:
+		result = to.Type + "(f)"
+		if to.Bits == 64 {
+			result = "f"
+		}
+		c.Text = fmt.Sprintf("%s converts a string value to %s. It parses the string as a decimal or "+
+			"hexadecimal floating-point number, as strconv.ParseFloat does, and rounds it to the nearest %s. "+
+			"It accepts \"NaN\" and the infinities; use %sFinite to reject them.", fn, to.Type, to.Type, fn)
+		c.Returns = []string{
+			fmt.Sprintf("%s: the converted %s value. The range of %s is %s.", to.Type, to.Type, to.Type, typeRange(to)),
+			fmt.Sprintf("error: a ConversionError wrapping strconv.ErrSyntax if the input value is not a valid number, "+
+				"or ErrOverflow or ErrUnderflow if it is out of the %s range.", to.Type),
+		}
+		c.Body = fmt.Sprintf("\tf, err := strconv.ParseFloat(value, %d)\n\tif err != nil {\n\t\treturn 0, parseError(err, value, %q)\n\t}\n\treturn %s, nil",
+			to.Bits, to.Type, result)
+	}
+	return c
+}
+
+func fromStringTrimmed(to basicType) converter {
+	from := basictypes.Types[len(basictypes.Types)-1]
+	base := name(from, to)
+	fn := base + "Trimmed"
+	input, output := `" 123\n"`, "123"
+	if to.Kind == basictypes.Float {
+		input, output = `" 123.456\n"`, "123.456"
+	}
+	return converter{
+		Head: fmt.Sprintf("%s converts a string value to %s after trimming white space.", fn, to.Type),
+		Text: fmt.Sprintf("%s converts a string value to %s like %s, after removing leading and trailing "+
+			"white space from the input.", fn, to.Type, base),
+		Params: []string{"value: the string value to be converted."},
+		Returns: []string{
+			fmt.Sprintf("%s: the converted %s value.", to.Type, to.Type),
+			"error: an error if the trimmed value cannot be converted.",
+		},
+		Example:   fmt.Sprintf("result, err := %s(%s)\nif err != nil {\n  log.Fatal(err)\n}\nfmt.Println(result) // Output: %s", fn, input, output),
+		Signature: signature(fn, from, to),
+		Body:      fmt.Sprintf("\treturn %s(strings.TrimSpace(value))", base),
+	}
+}
+
+func intToInt(from, to basicType) converter {
+	fn := name(from, to)
+	c := converter{
+		Head:      fmt.Sprintf("%s converts %s %s value to %s.", fn, article(from.Type), from.Type, to.Type),
+		Params:    []string{fmt.Sprintf("value: the %s value to be converted. The range of %s is %s.", from.Type, from.Type, typeRange(from))},
+		Signature: signature(fn, from, to),
+	}
+	result := fmt.Sprintf("%s: the converted %s value. The range of %s is %s.", to.Type, to.Type, to.Type, typeRange(to))
+	c.Text = fmt.Sprintf("%s converts %s %s value to %s. It returns an error if the value is outside "+
+		"the range of %s.", fn, article(from.Type), from.Type, to.Type, to.Type)
+	overflow := !(from.Kind == basictypes.Int && to.Kind == basictypes.Uint && from.Bits <= to.MinBits())
+	underflow := from.Kind == basictypes.Int
+	switch {
+	case overflow && underflow:
+		c.Returns = []string{result, fmt.Sprintf("error: a *ConversionError wrapping ErrOverflow or ErrUnderflow "+
+			"if the value is out of the %s range.", to.Type)}
+	case underflow:
+		c.Returns = []string{result, "error: a *ConversionError wrapping ErrUnderflow if the value is negative."}
+	default:
+		c.Returns = []string{result, fmt.Sprintf("error: a *ConversionError wrapping ErrOverflow if the value "+
+			"exceeds the maximum of %s.", to.Type)}
+	}
+	if from.Kind == basictypes.Int {
+		c.Body = fmt.Sprintf("\treturn checkIntRange[%s](value)", to.Type)
+	} else {
+		c.Body = fmt.Sprintf("\treturn checkUintRange[%s](value)", to.Type)
+	}
+	return c
+}
+
+func floatToInt(from, to basicType) converter {
+	fn := name(from, to)
+	return converter{
+		Head: fmt.Sprintf("%s converts a %s value to %s.", fn, from.Type, to.Type),
+		Text: fmt.Sprintf("%s converts a %s value to %s, truncating it toward zero. It returns an error if "+
+			"the value is NaN or the truncated value is outside the range of %s; use %sExact to reject "+
+			"values with a fraction as well.", fn, from.Type, to.Type, to.Type, fn),
+		Params: []string{fmt.Sprintf("value: the %s value to be converted. The range of %s is %s.", from.Type, from.Type, typeRange(from))},
+		Returns: []string{
+			fmt.Sprintf("%s: the converted %s value. The range of %s is %s.", to.Type, to.Type, to.Type, typeRange(to)),
+			fmt.Sprintf("error: a *ConversionError wrapping ErrNaN if the value is NaN, or ErrOverflow or "+
+				"ErrUnderflow if it is out of the %s range.", to.Type),
+		},
+		Signature: signature(fn, from, to),
+		Body:      fmt.Sprintf("\treturn checkFloatToInt[%s](value)", to.Type),
+	}
+}
+
+func floatToIntExact(from, to basicType) converter {
+	base := name(from, to)
+	fn := base + "Exact"
+	check := "\tif value != math.Trunc(value) {"
+	if from.Bits != 64 {
+		check = "\tif f := float64(value); f != math.Trunc(f) {"
+	}
+	return converter{
+		Head: fmt.Sprintf("%s converts a %s value to %s without dropping a fraction.", fn, from.Type, to.Type),
+		Text: fmt.Sprintf("%s converts a %s value to %s like %s, but returns ErrLossOfPrecision instead of "+
+			"truncating when the value has a fractional component or is NaN.", fn, from.Type, to.Type, base),
+		Params: []string{fmt.Sprintf("value: the %s value to be converted.", from.Type)},
+		Returns: []string{
+			fmt.Sprintf("%s: the converted %s value.", to.Type, to.Type),
+			fmt.Sprintf("error: ErrLossOfPrecision if the value is not integral, or an error if the input value "+
+				"is out of the %s range.", to.Type),
+		},
+		Example:   fmt.Sprintf("_, err := %s(123.456)\nfmt.Println(errors.Is(err, ErrLossOfPrecision)) // Output: true", fn),
+		Signature: signature(fn, from, to),
+		Body:      fmt.Sprintf("%s\n\t\treturn 0, ErrLossOfPrecision\n\t}\n\treturn %s(value)", check, base),
+	}
+}
+
+func intToFloat(from, to basicType) []converter {
+	fn := name(from, to)
+	into := intoName(from, to)
+	bits := to.Significand()
+	magnitude := "values with a magnitude"
+	if from.Kind == basictypes.Uint {
+		magnitude = "values"
+	}
+	c := converter{
+		Head: fmt.Sprintf("%s converts %s %s value to %s.", into, article(from.Type), from.Type, to.Type),
+		Text: fmt.Sprintf("%s converts %s %s value to %s. The range of %s is within the range of %s, "+
+			"so the conversion cannot fail. %s has a %d-bit significand, however, so %s above 2^%d are "+
+			"rounded to the nearest %s; use %sExact to detect the rounding.", into, article(from.Type), from.Type,
+			to.Type, from.Type, to.Type, to.Type, bits, magnitude, bits, to.Type, fn),
+		Params: []string{fmt.Sprintf("value: the %s value to be converted. The range of %s is %s.", from.Type, from.Type, typeRange(from))},
+		Returns: []string{
+			fmt.Sprintf("%s: the converted %s value. The range of %s is %s.", to.Type, to.Type, to.Type, typeRange(to)),
+		},
+		Signature: fmt.Sprintf("%s(value %s) %s", into, from.Type, to.Type),
+		Body:      fmt.Sprintf("\treturn %s(value)", to.Type),
+	}
+
+	exact := "isExactInt64"
+	wide := "int64"
+	if from.Kind == basictypes.Uint {
+		exact, wide = "isExactUint64", "uint64"
+	}
+	arg := wide + "(value)"
+	if from.Type == wide {
+		arg = "value"
+	}
+	result := "float64(f)"
+	if to.Bits == 64 {
+		result = "f"
+	}
+	example := "16777217"
+	if to.Bits == 64 {
+		example = "9007199254740993"
+	}
+	return []converter{c, wrapper(c, from, to), {
+		Head: fmt.Sprintf("%sExact converts %s %s value to %s without rounding.", fn, article(from.Type), from.Type, to.Type),
+		Text: fmt.Sprintf("%sExact converts %s %s value to %s like %s, but returns ErrLossOfPrecision when "+
+			"the value cannot be represented exactly, i.e. when converting the result back would not "+
+			"reproduce the original value.", fn, article(from.Type), from.Type, to.Type, into),
+		Params: []string{fmt.Sprintf("value: the %s value to be converted.", from.Type)},
+		Returns: []string{
+			fmt.Sprintf("%s: the converted %s value.", to.Type, to.Type),
+			"error: ErrLossOfPrecision if the value is rounded by the conversion.",
+		},
+		Example:   fmt.Sprintf("_, err := %sExact(%s)\nfmt.Println(errors.Is(err, ErrLossOfPrecision)) // Output: true", fn, example),
+		Signature: signature(fn+"Exact", from, to),
+		Body: fmt.Sprintf("\tf := %s(value)\n\tif !%s(%s, %s) {\n\t\treturn 0, ErrLossOfPrecision\n\t}\n\treturn f, nil",
+			to.Type, exact, arg, result),
+	}}
+}
+
+func floatToFloat(from, to basicType) []converter {
+	fn := name(from, to)
+	c := converter{
+		Head:      fmt.Sprintf("%s converts a %s value to %s.", fn, from.Type, to.Type),
+		Params:    []string{fmt.Sprintf("value: the %s value to be converted. The range of %s is %s.", from.Type, from.Type, typeRange(from))},
+		Signature: signature(fn, from, to),
+	}
+	result := fmt.Sprintf("%s: the converted %s value. The range of %s is %s.", to.Type, to.Type, to.Type, typeRange(to))
+	c.Text = fmt.Sprintf("%s converts a %s value to %s, rounding it to the nearest %s. It returns an error "+
+		"if the value is outside the range of %s, including the infinities. NaN is converted to NaN.",
+		fn, from.Type, to.Type, to.Type, to.Type)
+	c.Returns = []string{result, fmt.Sprintf("error: a *ConversionError wrapping ErrOverflow or ErrUnderflow "+
+		"if the value is out of the %s range.", to.Type)}
+	c.Example = fmt.Sprintf("result, err := %s(123.456)\nif err != nil {\n  log.Fatal(err)\n}\nfmt.Println(result) // Output: 123.456", fn)
+	c.Body = fmt.Sprintf("\tif value > math.Max%s {\n\t\treturn 0, overflowError(value, %q)\n\t}\n"+
+		"\tif value < -math.Max%s {\n\t\treturn 0, underflowError(value, %q)\n\t}\n\treturn %s(value), nil",
+		to.Name, to.Type, to.Name, to.Type, to.Type)
+	return []converter{c, {
+		Head: fmt.Sprintf("%sExact converts a %s value to %s without rounding.", fn, from.Type, to.Type),
+		Text: fmt.Sprintf("%sExact converts a %s value to %s like %s, but returns ErrLossOfPrecision when "+
+			"the value cannot be represented exactly in %s. NaN is passed through unchanged.", fn, from.Type, to.Type, fn, to.Type),
+		Params: []string{fmt.Sprintf("value: the %s value to be converted.", from.Type)},
+		Returns: []string{
+			fmt.Sprintf("%s: the converted %s value.", to.Type, to.Type),
+			fmt.Sprintf("error: ErrLossOfPrecision if the value is rounded by the conversion, or an error if "+
+				"the input value is out of the %s range.", to.Type),
+		},
+		Example:   fmt.Sprintf("_, err := %sExact(0.1)\nfmt.Println(errors.Is(err, ErrLossOfPrecision)) // Output: true", fn),
+		Signature: signature(fn+"Exact", from, to),
+		Body: fmt.Sprintf("\tf, err := %s(value)\n\tif err != nil {\n\t\treturn 0, err\n\t}\n"+
+			"\tif %s(f) != value && !math.IsNaN(value) {\n\t\treturn 0, ErrLossOfPrecision\n\t}\n\treturn f, nil",
+			fn, from.Type),
+	}}
+}
+
+// wrapper returns the deprecated XxxToYyy converter that calls the
+// infallible converter c and returns a nil error, for compatibility.
+func wrapper(c converter, from, to basicType) converter {
+	fn, into := name(from, to), intoName(from, to)
+	return converter{
+		Head: fmt.Sprintf("%s converts %s %s value to %s.", fn, article(from.Type), from.Type, to.Type),
+		Text: fmt.Sprintf("%s converts %s %s value to %s like %s. The conversion cannot fail, so the error "+
+			"is always nil.", fn, article(from.Type), from.Type, to.Type, into),
+		Params:     c.Params,
+		Returns:    append(c.Returns[:len(c.Returns):len(c.Returns)], "error: nil."),
+		Deprecated: fmt.Sprintf("Use %s, which has no error to handle.", into),
+		Signature:  signature(fn, from, to),
+		Body:       fmt.Sprintf("\treturn %s(value), nil", into),
+	}
+}
+
+// comment formats text as a doc comment wrapped at 80 columns, with each
+// line prefixed by prefix and continuation lines by indent.
+func comment(text, prefix, indent string) string {
+	var b strings.Builder
+	line := prefix
+	for _, word := range strings.Fields(text) {
+		if line != prefix && line != indent && len(line)+1+len(word) > 80 {
+			b.WriteString(line + "\n")
+			line = indent
+		}
+		if line == prefix || line == indent {
+			line += word
+		} else {
+			line += " " + word
+		}
+	}
+	b.WriteString(line + "\n")
+	return b.String()
+}
+
+// generate returns the formatted source of the file for the type t.
+func generate(t basicType) ([]byte, error) {
+	cs := converters(t)
+	var code strings.Builder
+	for _, c := range cs {
+		code.WriteString(c.Body)
+	}
+	var imports []string
+	for _, pkg := range []string{"math", "reflect", "strconv", "strings"} {
+		if strings.Contains(code.String(), pkg+".") {
+			imports = append(imports, fmt.Sprintf("\t%q", pkg))
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by convgen; DO NOT EDIT.\n\npackage into\n\nimport (\n%s\n)\n", strings.Join(imports, "\n"))
+	if err := converterTemplate.Execute(&buf, cs); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// generateLossless returns the formatted source of lossless.go, which
+// holds the WidensToXxx constraints and the table of lossless kind pairs
+// used by IsLossless.
+func generateLossless() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by convgen; DO NOT EDIT.\n\npackage into\n\nimport \"reflect\"\n")
+	for _, to := range basictypes.Types {
+		if !to.Numeric() {
+			continue
+		}
+		var froms []string
+		for _, from := range basictypes.Types {
+			if from.WidensTo(to) {
+				froms = append(froms, "~"+from.Type)
+			}
+		}
+		fmt.Fprintf(&buf, "\n%s", comment(fmt.Sprintf("WidensTo%s is satisfied by the types whose values all convert to %s "+
+			"exactly on every platform, so that their conversion to %s cannot fail.", to.Name, to.Type, to.Type), "// ", "// "))
+		fmt.Fprintf(&buf, "type WidensTo%s interface {\n\t%s\n}\n", to.Name, strings.Join(froms, " | "))
+	}
+
+	buf.WriteString("\n// losslessKinds holds the pairs of kinds whose values all convert exactly\n" +
+		"// on every platform.\nvar losslessKinds = map[[2]reflect.Kind]bool{\n")
+	for _, from := range basictypes.Types {
+		for _, to := range basictypes.Types {
+			if from.WidensTo(to) {
+				fmt.Fprintf(&buf, "\t{reflect.%s, reflect.%s}: true,\n", from.Name, to.Name)
+			}
+		}
+	}
+	buf.WriteString("}\n")
+
+	buf.WriteString("\n// infallibleKinds holds the pairs of different kinds whose values all convert\n" +
+		"// without an error on every platform, which have an XxxIntoYyy converter.\nvar infallibleKinds = map[[2]reflect.Kind]bool{\n")
+	for _, from := range basictypes.Types {
+		for _, to := range basictypes.Types {
+			if from.Infallible(to) {
+				fmt.Fprintf(&buf, "\t{reflect.%s, reflect.%s}: true,\n", from.Name, to.Name)
+			}
+		}
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// widen returns the infallible converter of a pair of types for which
+// WidensTo holds.
+func widen(from, to basicType) converter {
+	fn := intoName(from, to)
+	example := "-128"
+	if from.Kind != basictypes.Int {
+		example = "255"
+	}
+	return converter{
+		Head: fmt.Sprintf("%s converts %s %s value to %s.", fn, article(from.Type), from.Type, to.Type),
+		Text: fmt.Sprintf("%s converts %s %s value to %s exactly. Every %s value is representable in %s on every "+
+			"platform, so the conversion cannot fail.", fn, article(from.Type), from.Type, to.Type,
+			from.Type, to.Type),
+		Params:    []string{fmt.Sprintf("value: the %s value to be converted.", from.Type)},
+		Returns:   []string{fmt.Sprintf("%s: the converted %s value.", to.Type, to.Type)},
+		Example:   fmt.Sprintf("fmt.Println(%s(%s)) // Output: %s", fn, example, example),
+		Signature: fmt.Sprintf("%s(value %s) %s", fn, from.Type, to.Type),
+		Body:      fmt.Sprintf("\treturn %s(value)", to.Type),
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("convgen: ")
+
+	dir := flag.String("dir", ".", "output directory")
+	flag.Parse()
+
+	for _, t := range basictypes.Types {
+		if !t.Numeric() {
+			continue
+		}
+		src, err := generate(t)
+		if err != nil {
+			log.Fatalf("%s: %v", t.Type, err)
+		}
+		if err := os.WriteFile(filepath.Join(*dir, t.Type+".go"), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	src, err := generateLossless()
+	if err != nil {
+		log.Fatalf("lossless: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, "lossless.go"), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+var converterTemplate = template.Must(template.New("converters").Funcs(template.FuncMap{
+	"comment": func(text string) string { return comment(text, "// ", "// ") },
+	"item":    func(text string) string { return comment(text, "//   - ", "//     ") },
+	"code": func(code string) string {
+		return "//\t" + strings.ReplaceAll(code, "\n", "\n//\t") + "\n"
+	},
+}).Parse(`{{range .}}
+// {{.Head}}
+//
+{{comment .Text}}//
+// Parameters:
+{{range .Params}}{{item .}}{{end}}//
+// Returns:
+{{range .Returns}}{{item .}}{{end}}
+{{- if .Example}}//
+// Example:
+//
+{{code .Example}}{{end}}
+{{- if .Deprecated}}//
+{{comment (print "Deprecated: " .Deprecated)}}{{end}}func {{.Signature}} {
+{{.Body}}
+}
+{{end}}`))
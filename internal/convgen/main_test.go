@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zenless-lab/into/internal/basictypes"
+)
+
+// TestGeneratedFilesUpToDate checks that the converter files of the module
+// are the current output of convgen, so that an edit of a generated file or
+// of the generator without running go generate fails the tests.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	for _, typ := range basictypes.Types {
+		if !typ.Numeric() {
+			continue
+		}
+		t.Run(typ.Type, func(t *testing.T) {
+			want, err := generate(typ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(filepath.Join("..", "..", typ.Type+".go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s.go is out of date; run go generate", typ.Type)
+			}
+		})
+	}
+}
+
+func TestLosslessUpToDate(t *testing.T) {
+	want, err := generateLossless()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filepath.Join("..", "..", "lossless.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("lossless.go is out of date; run go generate")
+	}
+}
+
+func TestTypeRange(t *testing.T) {
+	tests := []struct {
+		typ  string
+		want string
+	}{
+		{"int8", "-128 to 127"},
+		{"uint16", "0 to 65,535"},
+		{"int64", "-9,223,372,036,854,775,808 to 9,223,372,036,854,775,807"},
+		{"uint", "that of uint32 or uint64, depending on the platform"},
+		{"float32", "approximately ±3.4E38"},
+	}
+	for _, tt := range tests {
+		for _, typ := range basictypes.Types {
+			if typ.Type == tt.typ {
+				if got := typeRange(typ); got != tt.want {
+					t.Errorf("typeRange(%s) = %q, want %q", tt.typ, got, tt.want)
+				}
+			}
+		}
+	}
+}
+
+func TestArticle(t *testing.T) {
+	tests := []struct {
+		typ  string
+		want string
+	}{
+		{"int", "an"},
+		{"int64", "an"},
+		{"uint8", "a"},
+		{"float32", "a"},
+	}
+	for _, tt := range tests {
+		if got := article(tt.typ); got != tt.want {
+			t.Errorf("article(%s) = %q, want %q", tt.typ, got, tt.want)
+		}
+	}
+
+	for _, typ := range basictypes.Types {
+		if !typ.Numeric() {
+			continue
+		}
+		src, err := generate(typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i := bytes.Index(src, []byte(" a int")); i >= 0 {
+			end := bytes.IndexByte(src[i:], '\n')
+			t.Errorf("%s.go: %q uses \"a\" before an int type", typ.Type, src[i:i+end])
+		}
+	}
+}
@@ -0,0 +1,360 @@
+// Command dispatchgen generates the dispatch consistency test of into.
+//
+// dispatchgen parses the package and finds the generic TryIntoXxx
+// dispatchers whose value parameter has a type parameter as its type, such
+// as TryIntoTime[T String | Float | Int | Uint | time.Time](value T). It
+// resolves the type set of each constraint through the constraint
+// interfaces of types.go and writes a test that calls the dispatcher with a
+// value of every basic type, and of a named type of every basic type, in
+// the type set. The test fails if a call returns ErrUnsupportedType, which
+// means the constraint accepts a type at compile time that the dispatch
+// switch rejects at run time. Type parameters used only in the results,
+// such as T in TryInto[T, U], are instantiated with every basic type of
+// their type set.
+//
+// Usage:
+//
+//	dispatchgen [-dir directory] [-output file]
+//
+// It is run with go generate from the root of the module:
+//
+//	//go:generate go run ./internal/dispatchgen -output dispatch_test.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/zenless-lab/into/internal/basictypes"
+)
+
+// basicType is a basic type supported by into.
+type basicType = basictypes.Type
+
+// typeSet is a set of basic type names.
+type typeSet map[string]bool
+
+// dispatcher is a generic conversion function of the package.
+type dispatcher struct {
+	// Name is the name of the function.
+	Name string
+	// TypeParams are the names of the type parameters, in order.
+	TypeParams []string
+	// Sets are the basic types of the type set of each type parameter.
+	Sets []typeSet
+	// Value is the index of the type parameter of the value parameter.
+	Value int
+	// Options reports whether the function takes an Options parameter
+	// after the value.
+	Options bool
+}
+
+// testCase is a generated call of a dispatcher.
+type testCase struct {
+	// Call is the Go expression of the call.
+	Call string
+}
+
+// parsePackage returns the declarations of the non-test files of the
+// package in dir, sorted by file name.
+func parsePackage(dir string) ([]*ast.File, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// constraints returns the interface type declarations of files by name.
+func constraints(files []*ast.File) map[string]*ast.InterfaceType {
+	m := map[string]*ast.InterfaceType{}
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if it, ok := ts.Type.(*ast.InterfaceType); ok {
+					m[ts.Name.Name] = it
+				}
+			}
+		}
+	}
+	return m
+}
+
+// resolve returns the basic types in the type set of the constraint expr.
+// Terms that are not basic types, such as time.Time or ~[]byte, are
+// ignored.
+func resolve(expr ast.Expr, ifaces map[string]*ast.InterfaceType) typeSet {
+	set := typeSet{}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.Name == "any" {
+			for _, t := range basictypes.Types {
+				set[t.Type] = true
+			}
+			return set
+		}
+		for _, t := range basictypes.Types {
+			if t.Type == e.Name {
+				set[e.Name] = true
+				return set
+			}
+		}
+		if it, ok := ifaces[e.Name]; ok {
+			for _, field := range it.Methods.List {
+				if len(field.Names) == 0 {
+					for name := range resolve(field.Type, ifaces) {
+						set[name] = true
+					}
+				}
+			}
+		}
+	case *ast.BinaryExpr:
+		if e.Op == token.OR {
+			for name := range resolve(e.X, ifaces) {
+				set[name] = true
+			}
+			for name := range resolve(e.Y, ifaces) {
+				set[name] = true
+			}
+		}
+	case *ast.UnaryExpr:
+		if e.Op == token.TILDE {
+			return resolve(e.X, ifaces)
+		}
+	}
+	return set
+}
+
+// dispatchers returns the TryIntoXxx dispatchers of files.
+func dispatchers(files []*ast.File) []dispatcher {
+	ifaces := constraints(files)
+	var ds []dispatcher
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "TryInto") || fn.Type.TypeParams == nil {
+				continue
+			}
+			if d, ok := newDispatcher(fn, ifaces); ok {
+				ds = append(ds, d)
+			}
+		}
+	}
+	return ds
+}
+
+// newDispatcher returns the dispatcher declared by fn, and whether fn is a
+// dispatcher: a function taking a value of a type parameter, optionally
+// followed by Options, and returning an error last.
+func newDispatcher(fn *ast.FuncDecl, ifaces map[string]*ast.InterfaceType) (dispatcher, bool) {
+	d := dispatcher{Name: fn.Name.Name, Value: -1}
+	for _, field := range fn.Type.TypeParams.List {
+		set := resolve(field.Type, ifaces)
+		for _, name := range field.Names {
+			d.TypeParams = append(d.TypeParams, name.Name)
+			d.Sets = append(d.Sets, set)
+		}
+	}
+
+	var params []ast.Expr
+	for _, field := range fn.Type.Params.List {
+		for range field.Names {
+			params = append(params, field.Type)
+		}
+	}
+	if len(params) == 0 || len(params) > 2 {
+		return d, false
+	}
+	if ident, ok := params[0].(*ast.Ident); ok {
+		for i, name := range d.TypeParams {
+			if name == ident.Name {
+				d.Value = i
+			}
+		}
+	}
+	if d.Value < 0 {
+		return d, false
+	}
+	if len(params) == 2 {
+		if ident, ok := params[1].(*ast.Ident); !ok || ident.Name != "Options" {
+			return d, false
+		}
+		d.Options = true
+	}
+
+	results := fn.Type.Results
+	if results == nil {
+		return d, false
+	}
+	last, ok := results.List[len(results.List)-1].Type.(*ast.Ident)
+	return d, ok && last.Name == "error"
+}
+
+// literal returns the Go expression of a sample value of t, or of the
+// named type of t if named is set.
+func literal(t basicType, named bool) string {
+	var v string
+	switch t.Kind {
+	case basictypes.Bool:
+		v = "true"
+	case basictypes.String:
+		v = `"1"`
+	default:
+		v = "1"
+	}
+	if named {
+		return namedType(t) + "(" + v + ")"
+	}
+	if t.Kind == basictypes.Bool || t.Kind == basictypes.String {
+		return v
+	}
+	return t.Type + "(" + v + ")"
+}
+
+// namedType returns the name of the named type of t declared by the test.
+func namedType(t basicType) string {
+	return "dispatch" + t.Name
+}
+
+// cases returns the calls of d with every combination of the basic types
+// of its type parameters, and the named types of its value parameter.
+func cases(d dispatcher) []testCase {
+	var tcs []testCase
+	var walk func(typeArgs []string, value string)
+	walk = func(typeArgs []string, value string) {
+		i := len(typeArgs)
+		if i == len(d.TypeParams) {
+			args := value
+			if d.Options {
+				args += ", Options{}"
+			}
+			call := fmt.Sprintf("%s[%s](%s)", d.Name, strings.Join(typeArgs, ", "), args)
+			tcs = append(tcs, testCase{Call: call})
+			return
+		}
+		for _, t := range basictypes.Types {
+			if !d.Sets[i][t.Type] {
+				continue
+			}
+			if i != d.Value {
+				walk(append(typeArgs[:i:i], t.Type), value)
+				continue
+			}
+			walk(append(typeArgs[:i:i], t.Type), literal(t, false))
+			walk(append(typeArgs[:i:i], namedType(t)), literal(t, true))
+		}
+	}
+	walk(nil, "")
+	return tcs
+}
+
+// generate returns the source of the dispatch test for the package in dir.
+func generate(dir string) ([]byte, error) {
+	files, err := parsePackage(dir)
+	if err != nil {
+		return nil, err
+	}
+	var tcs []testCase
+	for _, d := range dispatchers(files) {
+		tcs = append(tcs, cases(d)...)
+	}
+
+	var buf bytes.Buffer
+	data := struct {
+		Types []basicType
+		Cases []testCase
+	}{basictypes.Types, tcs}
+	if err := dispatchTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("dispatchgen: ")
+
+	dir := flag.String("dir", ".", "package directory")
+	output := flag.String("output", "dispatch_test.go", "output file name")
+	flag.Parse()
+
+	src, err := generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+var dispatchTemplate = template.Must(template.New("dispatch").Funcs(template.FuncMap{
+	"named": namedType,
+}).Parse(`// Code generated by dispatchgen; DO NOT EDIT.
+
+package into_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+// The named types of the basic types, which the dispatchers accept through
+// the ~ terms of their constraints.
+type (
+{{- range .Types}}
+	{{named .}} {{.Type}}
+{{- end}}
+)
+
+var dispatchCalls = []struct {
+	name string
+	call func() error
+}{
+{{- range .Cases}}
+	{ {{- printf "%q" .Call}}, func() error { _, err := {{.Call}}; return err }},
+{{- end}}
+}
+
+// TestDispatchConsistency checks that every type accepted by the constraint
+// of a dispatcher is also accepted by its dispatch switch at run time.
+func TestDispatchConsistency(t *testing.T) {
+	for _, tt := range dispatchCalls {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); errors.Is(err, ErrUnsupportedType) {
+				t.Errorf("error = %v; the constraint accepts a type the dispatch rejects", err)
+			}
+		})
+	}
+}
+`))
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// TestGeneratedFileUpToDate checks that dispatch_test.go is the current
+// output of dispatchgen, so that a new or changed dispatcher without running
+// go generate fails the tests.
+func TestGeneratedFileUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..")
+	want, err := generate(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "dispatch_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("dispatch_test.go is out of date; run go generate")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	const src = `package p
+
+type Int interface{ ~int | ~int8 }
+type Uint interface{ ~uint }
+type Number interface{ Int | Uint }
+`
+	f, err := parser.ParseFile(token.NewFileSet(), "p.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ifaces := constraints([]*ast.File{f})
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"Number", []string{"int", "int8", "uint"}},
+		{"Int | string", []string{"int", "int8", "string"}},
+		{"~float64 | time.Time | ~[]byte", []string{"float64"}},
+		{"Unknown", nil},
+	}
+	for _, tt := range tests {
+		expr, err := parser.ParseExpr(tt.expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for name := range resolve(expr, ifaces) {
+			got = append(got, name)
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("resolve(%s) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
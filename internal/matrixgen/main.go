@@ -0,0 +1,381 @@
+// Command matrixgen generates the conversion matrix test of into.
+//
+// matrixgen writes a table-driven test that calls every direct converter
+// between the basic types supported by into (e.g. Int8ToUint16 or
+// Float32ToString) with the boundary values of the source type: the
+// minimum, the maximum, zero, one, minus one, a representative value in
+// between, and NaN and the infinities for floats. The expected results are
+// computed independently of into with math/big and strconv, so the test
+// locks in the boundary semantics of the converters.
+//
+// Usage:
+//
+//	matrixgen [-output file]
+//
+// It is run with go generate from the root of the module:
+//
+//	//go:generate go run ./internal/matrixgen -output matrix_test.go
+//
+// The results of the int and uint converters depend on the size of int, so
+// the generated test is built for 64-bit platforms only.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/zenless-lab/into/internal/basictypes"
+)
+
+// basicType is a basic type supported by into.
+type basicType = basictypes.Type
+
+// The kinds of the basic types.
+const (
+	kindBool   = basictypes.Bool
+	kindInt    = basictypes.Int
+	kindUint   = basictypes.Uint
+	kindFloat  = basictypes.Float
+	kindString = basictypes.String
+)
+
+// basicTypes lists the basic types in the order in which cases are generated.
+var basicTypes = basictypes.Types
+
+// stringValues are the source values of the string converters, chosen
+// around the limits of the integer and float types.
+var stringValues = []string{
+	"", "0", "1", "-1", "42", "+42", "42.5", "-42.5", "1e3", "abc", "true", "false",
+	"127", "128", "-128", "-129", "255", "256", "32767", "32768", "65535", "65536",
+	"2147483647", "2147483648", "-2147483649", "4294967295", "4294967296",
+	"9223372036854775807", "9223372036854775808", "-9223372036854775808", "-9223372036854775809",
+	"18446744073709551615", "18446744073709551616",
+	"3.4028235e38", "3.5e38", "1.7976931348623157e308", "1e309", "NaN", "Inf", "-Inf",
+}
+
+// value is a source value of a converter.
+type value struct {
+	// Type is the type of the value.
+	Type basicType
+	// Literal is the Go expression of the value.
+	Literal string
+
+	b bool
+	i *big.Int
+	f float64
+	s string
+}
+
+// values returns the boundary values of t.
+func values(t basicType) []value {
+	switch t.Kind {
+	case kindBool:
+		return []value{{Type: t, Literal: "false", b: false}, {Type: t, Literal: "true", b: true}}
+	case kindInt, kindUint:
+		min, max := intRange(t)
+		ints := []*big.Int{min, big.NewInt(0), big.NewInt(1), big.NewInt(42), max}
+		if t.Kind == kindInt {
+			ints = append([]*big.Int{ints[0], big.NewInt(-1)}, ints[1:]...)
+		}
+		vs := make([]value, len(ints))
+		for i, n := range ints {
+			vs[i] = value{Type: t, Literal: n.String(), i: n}
+		}
+		return vs
+	case kindFloat:
+		max := math.MaxFloat64
+		if t.Bits == 32 {
+			max = math.MaxFloat32
+		}
+		floats := []float64{math.Inf(-1), -max, -42.5, -1, 0, 1, 42.5, max, math.Inf(1), math.NaN()}
+		vs := make([]value, len(floats))
+		for i, f := range floats {
+			vs[i] = value{Type: t, Literal: floatLiteral(f, t), f: f}
+		}
+		return vs
+	default:
+		vs := make([]value, len(stringValues))
+		for i, s := range stringValues {
+			vs[i] = value{Type: t, Literal: strconv.Quote(s), s: s}
+		}
+		return vs
+	}
+}
+
+// intRange returns the minimum and maximum values of the integer type t.
+func intRange(t basicType) (*big.Int, *big.Int) {
+	one := big.NewInt(1)
+	if t.Kind == kindUint {
+		max := new(big.Int).Lsh(one, uint(t.Bits))
+		return big.NewInt(0), max.Sub(max, one)
+	}
+	limit := new(big.Int).Lsh(one, uint(t.Bits-1))
+	return new(big.Int).Neg(limit), new(big.Int).Sub(limit, one)
+}
+
+// floatLiteral returns the Go expression of f as a value of the float type t.
+func floatLiteral(f float64, t basicType) string {
+	var s string
+	switch {
+	case math.IsNaN(f):
+		s = "math.NaN()"
+	case math.IsInf(f, 0):
+		s = fmt.Sprintf("math.Inf(%d)", int(math.Copysign(1, f)))
+	default:
+		return t.Type + "(" + strconv.FormatFloat(f, 'g', -1, t.Bits) + ")"
+	}
+	if t.Bits == 32 {
+		return "float32(" + s + ")"
+	}
+	return s
+}
+
+// testCase is a generated case of the matrix.
+type testCase struct {
+	// Func is the name of the converter.
+	Func string
+	// Arg is the Go expression of the argument.
+	Arg string
+	// Want is the Go expression of the expected result.
+	Want string
+	// WantErr is the Go expression of the expected error.
+	WantErr string
+}
+
+// expect returns the expected result of converting v to t as a Go
+// expression, or the expression of the expected error.
+func expect(v value, t basicType) (want, wantErr string) {
+	switch t.Kind {
+	case kindBool:
+		return expectBool(v)
+	case kindInt, kindUint:
+		return expectInt(v, t)
+	case kindFloat:
+		return expectFloat(v, t)
+	default:
+		return expectString(v)
+	}
+}
+
+// expectBool returns the expected result of converting v to bool.
+func expectBool(v value) (string, string) {
+	switch v.Type.Kind {
+	case kindInt, kindUint:
+		return strconv.FormatBool(v.i.Sign() != 0), ""
+	case kindFloat:
+		return strconv.FormatBool(v.f != 0), ""
+	default:
+		b, err := strconv.ParseBool(v.s)
+		if err != nil {
+			return "", numError(err, v.s)
+		}
+		return strconv.FormatBool(b), ""
+	}
+}
+
+// expectInt returns the expected result of converting v to the integer
+// type t. Floats are truncated toward zero before the range is checked, and
+// NaN is rejected.
+func expectInt(v value, t basicType) (string, string) {
+	var n *big.Int
+	switch v.Type.Kind {
+	case kindBool:
+		n = big.NewInt(0)
+		if v.b {
+			n = big.NewInt(1)
+		}
+	case kindInt, kindUint:
+		n = v.i
+	case kindFloat:
+		switch {
+		case math.IsNaN(v.f):
+			return "", "ErrNaN"
+		case math.IsInf(v.f, 1):
+			return "", "ErrOverflow"
+		case math.IsInf(v.f, -1):
+			return "", "ErrUnderflow"
+		}
+		n, _ = new(big.Float).SetFloat64(math.Trunc(v.f)).Int(nil)
+	default:
+		var err error
+		if t.Kind == kindInt {
+			_, err = strconv.ParseInt(v.s, 10, t.Bits)
+		} else {
+			_, err = strconv.ParseUint(v.s, 10, t.Bits)
+		}
+		if err != nil {
+			return "", numError(err, v.s)
+		}
+		n, _ = new(big.Int).SetString(v.s, 10)
+	}
+
+	min, max := intRange(t)
+	switch {
+	case n.Cmp(max) > 0:
+		return "", "ErrOverflow"
+	case n.Cmp(min) < 0:
+		return "", "ErrUnderflow"
+	}
+	return t.Type + "(" + n.String() + ")", ""
+}
+
+// expectFloat returns the expected result of converting v to the float
+// type t. Integers are rounded to the nearest float, and float64 values
+// beyond the range of float32, including the infinities, overflow.
+func expectFloat(v value, t basicType) (string, string) {
+	var f float64
+	switch v.Type.Kind {
+	case kindBool:
+		if v.b {
+			f = 1
+		}
+	case kindInt, kindUint:
+		bf := new(big.Float).SetInt(v.i)
+		if t.Bits == 32 {
+			f32, _ := bf.Float32()
+			f = float64(f32)
+		} else {
+			f, _ = bf.Float64()
+		}
+	case kindFloat:
+		f = v.f
+		if t.Bits == 32 && v.Type.Bits == 64 {
+			switch {
+			case f > math.MaxFloat32:
+				return "", "ErrOverflow"
+			case f < -math.MaxFloat32:
+				return "", "ErrUnderflow"
+			}
+		}
+	default:
+		var err error
+		if f, err = strconv.ParseFloat(v.s, t.Bits); err != nil {
+			return "", numError(err, v.s)
+		}
+	}
+	return floatLiteral(f, t), ""
+}
+
+// expectString returns the expected result of converting v to string.
+func expectString(v value) (string, string) {
+	switch v.Type.Kind {
+	case kindBool:
+		return strconv.Quote(strconv.FormatBool(v.b)), ""
+	case kindInt, kindUint:
+		return strconv.Quote(v.i.String()), ""
+	default:
+		return strconv.Quote(strconv.FormatFloat(v.f, 'f', -1, v.Type.Bits)), ""
+	}
+}
+
+// numError returns the Go expression of the reason of a strconv error
+// for the string s. Negative integers rejected by strconv.ParseUint are
+// reported as underflows.
+func numError(err error, s string) string {
+	var numErr *strconv.NumError
+	negative := strings.HasPrefix(s, "-") && s != "-" && strings.Trim(s[1:], "0123456789") == "" && strings.Trim(s[1:], "0") != ""
+	switch {
+	case errors.As(err, &numErr) && numErr.Func == "ParseUint" && negative:
+		return "ErrUnderflow"
+	case !errors.Is(err, strconv.ErrRange):
+		return "strconv.ErrSyntax"
+	case strings.HasPrefix(s, "-"):
+		return "ErrUnderflow"
+	default:
+		return "ErrOverflow"
+	}
+}
+
+// cases returns the cases of every converter between two distinct types.
+func cases() []testCase {
+	var tcs []testCase
+	for _, from := range basicTypes {
+		for _, to := range basicTypes {
+			if from == to {
+				continue
+			}
+			for _, v := range values(from) {
+				want, wantErr := expect(v, to)
+				tcs = append(tcs, testCase{Func: from.Name + "To" + to.Name, Arg: v.Literal, Want: want, WantErr: wantErr})
+			}
+		}
+	}
+	return tcs
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("matrixgen: ")
+
+	output := flag.String("output", "matrix_test.go", "output file name")
+	flag.Parse()
+
+	var buf bytes.Buffer
+	if err := matrixTemplate.Execute(&buf, cases()); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+var matrixTemplate = template.Must(template.New("matrix").Parse(`// Code generated by matrixgen; DO NOT EDIT.
+
+//go:build !386 && !arm && !mips && !mipsle
+
+package into_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+var conversionMatrix = []struct {
+	name    string
+	convert func() (any, error)
+	want    any
+	wantErr error
+}{
+{{- range .}}
+	{ {{- printf "%q" (print .Func "(" .Arg ")")}}, func() (any, error) { return {{.Func}}({{.Arg}}) }, {{if .Want}}{{.Want}}{{else}}nil{{end}}, {{if .WantErr}}{{.WantErr}}{{else}}nil{{end}}},
+{{- end}}
+}
+
+func TestConversionMatrix(t *testing.T) {
+	for _, tt := range conversionMatrix {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.want && !(isNaN(got) && isNaN(tt.want)) {
+				t.Errorf("got %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+			}
+		})
+	}
+}
+`))
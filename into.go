@@ -2,7 +2,9 @@ package into
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"time"
 )
 
 // Into converts a value of type U to a value of type T.
@@ -10,7 +12,8 @@ import (
 // Into converts a value of type U to a value of type T. If the conversion fails, it panics.
 //
 // Parameters:
-//   - value: the value to be converted. It must be a convertable type.
+//   - value: the value to be converted. It must be a convertable type or
+//     time.Time.
 //
 // Returns:
 //   - T: the converted value of type T.
@@ -20,7 +23,7 @@ import (
 //	var a float64 = 123.456
 //	b := Into[int](a)
 //	fmt.Println(b) // Output: 123
-func Into[T convertable, U convertable](value U) T {
+func Into[T convertable | time.Time, U convertable | time.Time](value U) T {
 	result, err := TryInto[T, U](value)
 	if err != nil {
 		panic(err)
@@ -31,9 +34,23 @@ func Into[T convertable, U convertable](value U) T {
 // TryInto attempts to convert a value of type U to a value of type T.
 //
 // TryInto attempts to convert a value of type U to a value of type T. If the conversion fails, it returns an error.
+// The options set by SetDefaults are applied to the conversion. A function
+// added with Register for U and T takes precedence over the built-in
+// dispatch, followed by the conversion methods of ITryInto, IInto, ITryFrom
+// and IFrom.
+//
+// time.Time takes part in the conversions as a source and as a target: it
+// is formatted as a string with the TimeLayout option, converted to float64
+// seconds since the Unix epoch by the registered TimeToFloat64, and parsed
+// or converted from numbers as by TryIntoTime:
+//
+//	s, _ := TryInto[string](time.Unix(0, 0).UTC())
+//	t, _ := TryInto[time.Time](s)
+//	fmt.Println(s, t.Unix()) // Output: 1970-01-01T00:00:00Z 0
 //
 // Parameters:
-//   - value: the value to be converted. It must be a convertable type.
+//   - value: the value to be converted. It must be a convertable type or
+//     time.Time.
 //
 // Returns:
 //   - T: the converted value of type T.
@@ -47,44 +64,304 @@ func Into[T convertable, U convertable](value U) T {
 //	  log.Fatal(err)
 //	}
 //	fmt.Println(b) // Output: 123
-func TryInto[T convertable, U convertable](value U) (result T, err error) {
+func TryInto[T convertable | time.Time, U convertable | time.Time](value U) (result T, err error) {
+	if o := loadObserver(); o != nil {
+		defer func() { o(reflect.TypeOf(value), reflect.TypeOf(result), err) }()
+	}
+	if r, ok, err := convertRegistered(value, reflect.TypeOf(result), Defaults()); ok {
+		if err != nil {
+			return result, err
+		}
+		return r.(T), nil
+	}
+
 	resultType := reflect.TypeOf(result)
+	r, err := toTypeWith(resultType, value, Defaults())
+	if err != nil {
+		return
+	}
+
+	result = reflect.ValueOf(r).Convert(resultType).Interface().(T)
+	return
+}
 
-	var r any
-	switch resultType.Kind() {
+// TryIntoAny attempts to convert a value of any type to a value of type T.
+//
+// TryIntoAny attempts to convert a value whose type is only known at runtime,
+// such as the values produced by encoding/json or database/sql, to a value
+// of type T. Pointers are dereferenced and named types (e.g. json.Number or
+// type Celsius float64) are converted through their underlying basic type,
+// unless a function added with Register matches the types exactly.
+// The options set by SetDefaults are applied to the conversion.
+//
+// Parameters:
+//   - value: the value to be converted. Its underlying type, after
+//     dereferencing pointers, must be a bool, integer, float or string type.
+//
+// Returns:
+//   - T: the converted value of type T.
+//   - error: ErrNil if value is nil or a nil pointer, or an error if the type
+//     of value is unsupported or the conversion fails.
+//
+// Example:
+//
+//	var decoded any
+//	_ = json.Unmarshal([]byte(`{"port": 8080}`), &decoded)
+//	port, err := TryIntoAny[uint16](decoded.(map[string]any)["port"])
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(port) // Output: 8080
+func TryIntoAny[T convertable | time.Time](value any) (result T, err error) {
+	if o := loadObserver(); o != nil {
+		defer func() { o(reflect.TypeOf(value), reflect.TypeOf(result), err) }()
+	}
+	if r, ok, err := convertRegistered(value, reflect.TypeOf(result), Defaults()); ok {
+		if err != nil {
+			return result, err
+		}
+		return r.(T), nil
+	}
+
+	resultType := reflect.TypeOf(result)
+	v, err := basicValue(value, resultType)
+	if err != nil {
+		return
+	}
+
+	r, err := toTypeWith(resultType, v, Defaults())
+	if err != nil {
+		return
+	}
+
+	result = reflect.ValueOf(r).Convert(resultType).Interface().(T)
+	return
+}
+
+// TryIntoValue converts the value held by src and stores it in dst.
+//
+// TryIntoValue converts src to the type of dst with the same dispatch and
+// boundary checks as TryIntoAny, for callers such as decoders and ORMs that
+// hold reflect.Values and do not know the concrete types at compile time.
+// Pointers in src are dereferenced. A nil pointer in dst is allocated, and
+// dst is left unchanged if the conversion fails. Functions added with
+// Register are used when the types of src and dst match exactly.
+// The options set by SetDefaults are applied to the conversion.
+//
+// Parameters:
+//   - dst: the settable value that receives the result. Its type, after
+//     dereferencing pointers, must be a bool, integer, float, string or
+//     time.Time type.
+//   - src: the value to be converted.
+//
+// Returns:
+//   - error: ErrNil if src is invalid or a nil pointer, or an error if dst is
+//     not settable, a type is unsupported or the conversion fails.
+//
+// Example:
+//
+//	var port uint16
+//	err := TryIntoValue(reflect.ValueOf(&port).Elem(), reflect.ValueOf("8080"))
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(port) // Output: 8080
+func TryIntoValue(dst reflect.Value, src reflect.Value) (err error) {
+	if o := loadObserver(); o != nil {
+		defer func() { o(valueType(src), valueType(dst), err) }()
+	}
+	if !dst.CanSet() {
+		return errors.New("destination is not settable")
+	}
+	if src.IsValid() && src.CanInterface() {
+		if r, ok, err := convertRegistered(src.Interface(), dst.Type(), Defaults()); ok {
+			if err != nil {
+				return err
+			}
+			dst.Set(reflect.ValueOf(r))
+			return nil
+		}
+	}
+
+	v, err := basicValueOf(src, dst.Type())
+	if err != nil {
+		return err
+	}
+	return setValue(dst, v, Defaults())
+}
+
+// setValue converts a basic value to the type of dst and stores it in dst.
+//
+// setValue allocates nil pointers on the way to the target and only stores
+// them once the conversion has succeeded.
+func setValue(dst reflect.Value, value any, opts Options) error {
+	if dst.Kind() == reflect.Ptr {
+		elem := reflect.New(dst.Type().Elem())
+		if !dst.IsNil() {
+			elem.Elem().Set(dst.Elem())
+		}
+		if err := setValue(elem.Elem(), value, opts); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+
+	if dst.Type() == timeType {
+		r, err := toTimeWith(value, opts)
+		if err != nil {
+			return err
+		}
+		dst.Set(reflect.ValueOf(r))
+		return nil
+	}
+	if _, ok := basicTypes[dst.Kind()]; !ok {
+		return &UnsupportedTypeError{From: fmt.Sprintf("%T", value), To: dst.Type().String()}
+	}
+	r, err := toTypeWith(dst.Type(), value, opts)
+	if err != nil {
+		return err
+	}
+	dst.Set(reflect.ValueOf(r).Convert(dst.Type()))
+	return nil
+}
+
+// basicValue converts a value to its predeclared basic type.
+//
+// basicValue dereferences pointers and converts named types to the basic
+// type of their kind, so the result can be passed to toKind.
+//
+// Parameters:
+//   - value: the value to be converted.
+//   - dst: the target type of the conversion, named in errors.
+//
+// Returns:
+//   - any: the value typed as a basic type.
+//   - error: ErrNil if value is nil or a nil pointer, or an
+//     *UnsupportedTypeError naming the type of value and dst if the kind of
+//     value is unsupported.
+func basicValue(value any, dst reflect.Type) (any, error) {
+	return basicValueOf(reflect.ValueOf(value), dst)
+}
+
+// basicValueOf is like basicValue for a reflect.Value.
+//
+// basicValueOf copies the value out by kind rather than through Interface,
+// so values read from unexported struct fields are accepted. A
+// time.Duration or time.Time is kept as is for toTypeWith.
+func basicValueOf(rv reflect.Value, dst reflect.Type) (any, error) {
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, ErrNil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil, ErrNil
+	}
+
+	if rv.Type() == durationType {
+		return time.Duration(rv.Int()), nil
+	}
+	if rv.Type() == timeType && rv.CanInterface() {
+		return rv.Interface(), nil
+	}
+	t, ok := basicTypes[rv.Kind()]
+	if !ok {
+		return nil, &UnsupportedTypeError{From: rv.Type().String(), To: dst.String()}
+	}
+	v := reflect.New(t).Elem()
+	switch rv.Kind() {
+	case reflect.Bool:
+		v.SetBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(rv.Float())
+	case reflect.String:
+		v.SetString(rv.String())
+	}
+	return v.Interface(), nil
+}
+
+// kindValue converts a value of a named basic type to its predeclared type.
+//
+// kindValue converts values such as type Celsius float64 to the basic type
+// of their kind, so that the toXxx helpers can assert them. Any other value
+// is returned unchanged.
+func kindValue(value any) any {
+	rv := reflect.ValueOf(value)
+	t, ok := basicTypes[rv.Kind()]
+	if !ok || rv.Type() == t {
+		return value
+	}
+	return rv.Convert(t).Interface()
+}
+
+// toKind converts a value to the basic type of the given kind.
+//
+// toKind dispatches to the toXxx helper that matches the target kind.
+// It is the shared entry point for every conversion that only knows its
+// target at runtime.
+//
+// Parameters:
+//   - kind: the kind of the target type.
+//   - value: the value to be converted.
+//
+// Returns:
+//   - any: the converted value, typed as the basic type of kind.
+//   - error: an error if the target kind is unsupported or the conversion fails.
+func toKind(kind reflect.Kind, value any) (any, error) {
+	switch kind {
 	case reflect.Float64:
-		r, err = toFloat64(value)
+		return toFloat64(value)
 	case reflect.Float32:
-		r, err = toFloat32(value)
+		return toFloat32(value)
 	case reflect.Int:
-		r, err = toInt(value)
+		return toInt(value)
 	case reflect.Int8:
-		r, err = toInt8(value)
+		return toInt8(value)
 	case reflect.Int16:
-		r, err = toInt16(value)
+		return toInt16(value)
 	case reflect.Int32:
-		r, err = toInt32(value)
+		return toInt32(value)
 	case reflect.Int64:
-		r, err = toInt64(value)
+		return toInt64(value)
 	case reflect.Uint:
-		r, err = toUint(value)
+		return toUint(value)
 	case reflect.Uint8:
-		r, err = toUint8(value)
+		return toUint8(value)
 	case reflect.Uint16:
-		r, err = toUint16(value)
+		return toUint16(value)
 	case reflect.Uint32:
-		r, err = toUint32(value)
+		return toUint32(value)
 	case reflect.Uint64:
-		r, err = toUint64(value)
+		return toUint64(value)
 	case reflect.String:
-		r, err = toString(value)
+		return toString(value)
 	case reflect.Bool:
-		r, err = toBool(value)
+		return toBool(value)
 	default:
-		err = errors.New("unsupported type")
-		return
+		return nil, unsupportedError(value, kind.String())
 	}
+}
 
-	result = r.(T)
-	return
+// basicTypes maps each supported kind to its predeclared basic type.
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+	reflect.String:  reflect.TypeOf(""),
 }
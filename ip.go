@@ -0,0 +1,194 @@
+package into
+
+import (
+	"errors"
+	"net/netip"
+)
+
+func init() {
+	Register(StringToAddr)
+	Register(AddrToString)
+	Register(StringToPrefix)
+	Register(PrefixToString)
+	Register(Uint32ToIPv4)
+	Register(IPv4ToUint32)
+	Register(Bytes16ToAddr)
+	Register(AddrToBytes16)
+}
+
+// errInvalidAddr is returned when the zero netip.Addr is converted.
+var errInvalidAddr = errors.New("invalid IP address")
+
+// StringToAddr converts the given string value to a netip.Addr value.
+//
+// StringToAddr converts an IPv4 address such as "192.0.2.1" or an IPv6
+// address such as "2001:db8::1", with an optional zone, to a netip.Addr
+// value. The registry converts strings to netip.Addr with it.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - netip.Addr: the converted netip.Addr value.
+//   - error: an error if the string is not a valid IP address.
+//
+// Example:
+//
+//	result, err := StringToAddr("192.0.2.1")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.Is4()) // Output: true
+func StringToAddr(value string) (netip.Addr, error) {
+	return netip.ParseAddr(value)
+}
+
+// AddrToString converts the given netip.Addr value to a string value.
+//
+// AddrToString converts a netip.Addr value to its canonical string form,
+// e.g. "2001:db8::1".
+//
+// Parameters:
+//   - value: the netip.Addr value to be converted.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: an error if the value is the zero netip.Addr.
+func AddrToString(value netip.Addr) (string, error) {
+	if !value.IsValid() {
+		return "", errInvalidAddr
+	}
+	return value.String(), nil
+}
+
+// StringToPrefix converts the given string value to a netip.Prefix value.
+//
+// StringToPrefix converts a CIDR prefix such as "192.0.2.0/24" or
+// "2001:db8::/32" to a netip.Prefix value. Host bits are kept, so
+// "192.0.2.1/24" is accepted; use Masked on the result to clear them.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - netip.Prefix: the converted netip.Prefix value.
+//   - error: an error if the string is not a valid prefix.
+//
+// Example:
+//
+//	result, err := StringToPrefix("192.0.2.0/24")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.Bits()) // Output: 24
+func StringToPrefix(value string) (netip.Prefix, error) {
+	return netip.ParsePrefix(value)
+}
+
+// PrefixToString converts the given netip.Prefix value to a string value.
+//
+// PrefixToString converts a netip.Prefix value to the CIDR notation, e.g.
+// "192.0.2.0/24".
+//
+// Parameters:
+//   - value: the netip.Prefix value to be converted.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: an error if the value is not a valid prefix.
+func PrefixToString(value netip.Prefix) (string, error) {
+	if !value.IsValid() {
+		return "", errors.New("invalid IP prefix")
+	}
+	return value.String(), nil
+}
+
+// Uint32ToIPv4 converts the given uint32 value to an IPv4 netip.Addr value.
+//
+// Uint32ToIPv4 converts a uint32 value to the IPv4 address with the same
+// bits in network byte order, so 0xC0000201 is 192.0.2.1.
+//
+// Parameters:
+//   - value: the uint32 value to be converted.
+//
+// Returns:
+//   - netip.Addr: the IPv4 address.
+//   - error: nil.
+//
+// Example:
+//
+//	result, err := Uint32ToIPv4(0xC0000201)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 192.0.2.1
+func Uint32ToIPv4(value uint32) (netip.Addr, error) {
+	return netip.AddrFrom4([4]byte{byte(value >> 24), byte(value >> 16), byte(value >> 8), byte(value)}), nil
+}
+
+// IPv4ToUint32 converts the given IPv4 netip.Addr value to a uint32 value.
+//
+// IPv4ToUint32 converts an IPv4 address to a uint32 value with the same bits
+// in network byte order. IPv4-mapped IPv6 addresses such as
+// "::ffff:192.0.2.1" are unmapped first.
+//
+// Parameters:
+//   - value: the netip.Addr value to be converted.
+//
+// Returns:
+//   - uint32: the converted uint32 value.
+//   - error: a *ConversionError wrapping ErrOverflow if the value is an IPv6
+//     address, or an error if it is the zero netip.Addr.
+//
+// Example:
+//
+//	result, err := IPv4ToUint32(netip.MustParseAddr("192.0.2.1"))
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Printf("%#x\n", result) // Output: 0xc0000201
+func IPv4ToUint32(value netip.Addr) (uint32, error) {
+	if !value.IsValid() {
+		return 0, errInvalidAddr
+	}
+	value = value.Unmap()
+	if !value.Is4() {
+		return 0, overflowError(value, "uint32")
+	}
+	b := value.As4()
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3]), nil
+}
+
+// Bytes16ToAddr converts the given 16-byte array to a netip.Addr value.
+//
+// Bytes16ToAddr converts a 16-byte array to the IPv6 address with the same
+// bytes. IPv4 addresses in this form are IPv4-mapped IPv6 addresses; use
+// Unmap on the result to get the IPv4 address.
+//
+// Parameters:
+//   - value: the 16-byte array to be converted.
+//
+// Returns:
+//   - netip.Addr: the IPv6 address.
+//   - error: nil.
+func Bytes16ToAddr(value [16]byte) (netip.Addr, error) {
+	return netip.AddrFrom16(value), nil
+}
+
+// AddrToBytes16 converts the given netip.Addr value to a 16-byte array.
+//
+// AddrToBytes16 converts an IP address to its 16-byte form. IPv4 addresses
+// are converted to IPv4-mapped IPv6 addresses and zones are dropped.
+//
+// Parameters:
+//   - value: the netip.Addr value to be converted.
+//
+// Returns:
+//   - [16]byte: the 16-byte form of the address.
+//   - error: an error if the value is the zero netip.Addr.
+func AddrToBytes16(value netip.Addr) ([16]byte, error) {
+	if !value.IsValid() {
+		return [16]byte{}, errInvalidAddr
+	}
+	return value.As16(), nil
+}
@@ -0,0 +1,84 @@
+package into_test
+
+import (
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestIPv4Uint32(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    uint32
+		wantErr error
+	}{
+		{"ipv4", "192.0.2.1", 0xC0000201, nil},
+		{"zero", "0.0.0.0", 0, nil},
+		{"broadcast", "255.255.255.255", 0xFFFFFFFF, nil},
+		{"mapped", "::ffff:192.0.2.1", 0xC0000201, nil},
+		{"ipv6", "2001:db8::1", 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := netip.MustParseAddr(tt.input)
+			got, err := IPv4ToUint32(addr)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("IPv4ToUint32(%v) error = %v, wantErr %v", addr, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IPv4ToUint32(%v) = %#x, want %#x", addr, got, tt.want)
+			}
+			if back, _ := Uint32ToIPv4(got); back != addr.Unmap() {
+				t.Errorf("Uint32ToIPv4(%#x) = %v, want %v", got, back, addr.Unmap())
+			}
+		})
+	}
+
+	if _, err := IPv4ToUint32(netip.Addr{}); err == nil {
+		t.Error("IPv4ToUint32(netip.Addr{}) error = nil")
+	}
+}
+
+func TestIPRegistry(t *testing.T) {
+	var addr netip.Addr
+	if err := TryIntoValue(reflect.ValueOf(&addr).Elem(), reflect.ValueOf("2001:db8::1")); err != nil || addr != netip.MustParseAddr("2001:db8::1") {
+		t.Errorf("TryIntoValue(netip.Addr) = %v, %v", addr, err)
+	}
+	if err := TryIntoValue(reflect.ValueOf(&addr).Elem(), reflect.ValueOf("300.0.0.1")); err == nil {
+		t.Error("TryIntoValue(netip.Addr, \"300.0.0.1\") error = nil")
+	}
+
+	var prefix netip.Prefix
+	if err := TryIntoValue(reflect.ValueOf(&prefix).Elem(), reflect.ValueOf("192.0.2.0/24")); err != nil || prefix.Bits() != 24 {
+		t.Errorf("TryIntoValue(netip.Prefix) = %v, %v", prefix, err)
+	}
+	if got, err := TryIntoAny[string](prefix); err != nil || got != "192.0.2.0/24" {
+		t.Errorf("TryIntoAny[string](%v) = %q, %v", prefix, got, err)
+	}
+
+	if got, err := TryIntoAny[uint32](netip.MustParseAddr("192.0.2.1")); err != nil || got != 0xC0000201 {
+		t.Errorf("TryIntoAny[uint32]() = %#x, %v", got, err)
+	}
+	if err := TryIntoValue(reflect.ValueOf(&addr).Elem(), reflect.ValueOf(uint32(0x7F000001))); err != nil || addr.String() != "127.0.0.1" {
+		t.Errorf("TryIntoValue(netip.Addr, uint32) = %v, %v", addr, err)
+	}
+
+	var b [16]byte
+	if err := TryIntoValue(reflect.ValueOf(&b).Elem(), reflect.ValueOf(netip.MustParseAddr("::1"))); err != nil || b[15] != 1 {
+		t.Errorf("TryIntoValue([16]byte) = %v, %v", b, err)
+	}
+	if got, _ := Bytes16ToAddr(b); got != netip.IPv6Loopback() {
+		t.Errorf("Bytes16ToAddr(%v) = %v", b, got)
+	}
+	if _, err := AddrToString(netip.Addr{}); err == nil {
+		t.Error("AddrToString(netip.Addr{}) error = nil")
+	}
+}
@@ -0,0 +1,50 @@
+package into
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Join converts values to strings and joins them with a separator.
+//
+// Join converts each element of values to a string like TryIntoString,
+// with the options set by SetDefaults, and concatenates the results with
+// sep between them. It is a cheaper alternative to fmt.Sprint or
+// fmt.Sprintf for writing log fields or CSV records, as the elements are
+// formatted by their direct converters rather than by the reflection of fmt.
+//
+// Parameters:
+//   - values: the values to be joined. Each element must be of a type
+//     accepted by TryIntoString.
+//   - sep: the separator placed between elements.
+//
+// Returns:
+//   - string: the joined string.
+//   - error: an *IndexError naming the position of the first element that
+//     cannot be converted, wrapping ErrNil for a nil element.
+//
+// Example:
+//
+//	result, err := Join([]any{"id", 42, 1.5, true}, ",")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: id,42,1.5,true
+func Join(values []any, sep string) (string, error) {
+	opts := Defaults()
+	var b strings.Builder
+	for i, value := range values {
+		if value == nil {
+			return "", &IndexError{Index: i, Err: ErrNil}
+		}
+		s, err := toKindWith(reflect.String, value, opts)
+		if err != nil {
+			return "", &IndexError{Index: i, Err: err}
+		}
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(s.(string))
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,48 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestJoin(t *testing.T) {
+	type celsius float64
+
+	tests := []struct {
+		name    string
+		values  []any
+		sep     string
+		want    string
+		wantErr error
+		index   int
+	}{
+		{"mixed", []any{"id", 42, 1.5, true}, ",", "id,42,1.5,true", nil, 0},
+		{"empty", nil, ",", "", nil, 0},
+		{"single", []any{uint8(7)}, ", ", "7", nil, 0},
+		{"bytes and named", []any{[]byte("raw"), celsius(21.5)}, " ", "raw 21.5", nil, 0},
+		{"duration", []any{90 * time.Second}, "", "1m30s", nil, 0},
+		{"nil element", []any{1, nil}, ",", "", ErrNil, 1},
+		{"unsupported element", []any{struct{}{}}, ",", "", ErrUnsupportedType, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Join(tt.values, tt.sep)
+			if tt.wantErr != nil {
+				var indexErr *IndexError
+				if !errors.Is(err, tt.wantErr) || !errors.As(err, &indexErr) || indexErr.Index != tt.index {
+					t.Fatalf("error = %v, want %v at index %d", err, tt.wantErr, tt.index)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Join = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
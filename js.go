@@ -0,0 +1,94 @@
+//go:build js && wasm
+
+package into
+
+import (
+	"reflect"
+	"syscall/js"
+)
+
+// FromJSValue converts a JavaScript value to a value of type T.
+//
+// FromJSValue converts a number, string or boolean received from JavaScript
+// to T with the same dispatch and boundary checks as TryInto, so Go code
+// compiled to WebAssembly can validate the float64 numbers of JavaScript
+// into typed integers. Numbers are converted from float64, so fractions are
+// handled by the Rounding of the options set by SetDefaults; set it to
+// Exact to reject them.
+//
+// Parameters:
+//   - v: the JavaScript value to be converted.
+//
+// Returns:
+//   - T: the converted value.
+//   - error: ErrNil if v is null or undefined, an *UnsupportedTypeError if v
+//     is an object, a function or a symbol, or an error if the conversion
+//     fails.
+//
+// Example:
+//
+//	port, err := FromJSValue[uint16](js.Global().Get("config").Get("port"))
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(port) // Output: 8080
+func FromJSValue[T convertable](v js.Value) (T, error) {
+	var result T
+	switch v.Type() {
+	case js.TypeNumber:
+		return TryInto[T](v.Float())
+	case js.TypeString:
+		return TryInto[T](v.String())
+	case js.TypeBoolean:
+		return TryInto[T](v.Bool())
+	case js.TypeNull, js.TypeUndefined:
+		return result, ErrNil
+	default:
+		return result, &UnsupportedTypeError{From: "js." + v.Type().String(), To: reflect.TypeOf(result).String()}
+	}
+}
+
+// ToJSValue converts a value to a JavaScript value.
+//
+// ToJSValue converts a bool, integer, float or string to the corresponding
+// JavaScript value. As JavaScript numbers are float64, integers that cannot
+// be represented exactly, such as 2^53+1, are rejected rather than rounded.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - js.Value: the JavaScript boolean, number or string.
+//   - error: ErrLossOfPrecision if value is an integer that a float64
+//     cannot represent exactly.
+//
+// Example:
+//
+//	result, err := ToJSValue(int64(42))
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.Float()) // Output: 42
+func ToJSValue[T convertable](value T) (js.Value, error) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return js.ValueOf(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f, err := Int64ToFloat64Exact(rv.Int())
+		if err != nil {
+			return js.Undefined(), err
+		}
+		return js.ValueOf(f), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		f, err := Uint64ToFloat64Exact(rv.Uint())
+		if err != nil {
+			return js.Undefined(), err
+		}
+		return js.ValueOf(f), nil
+	case reflect.Float32, reflect.Float64:
+		return js.ValueOf(rv.Float()), nil
+	default:
+		return js.ValueOf(rv.String()), nil
+	}
+}
@@ -0,0 +1,60 @@
+//go:build js && wasm
+
+package into_test
+
+import (
+	"errors"
+	"math"
+	"syscall/js"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFromJSValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   js.Value
+		want    int8
+		wantErr error
+	}{
+		{"number", js.ValueOf(42), 42, nil},
+		{"fraction", js.ValueOf(2.7), 2, nil},
+		{"string", js.ValueOf("-7"), -7, nil},
+		{"boolean", js.ValueOf(true), 1, nil},
+		{"overflow", js.ValueOf(300), 0, ErrOverflow},
+		{"underflow", js.ValueOf(-300), 0, ErrUnderflow},
+		{"nan", js.ValueOf(math.NaN()), 0, ErrNaN},
+		{"null", js.Null(), 0, ErrNil},
+		{"undefined", js.Undefined(), 0, ErrNil},
+		{"object", js.ValueOf(map[string]any{}), 0, ErrUnsupportedType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromJSValue[int8](tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("FromJSValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FromJSValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToJSValue(t *testing.T) {
+	if v, err := ToJSValue(int64(42)); err != nil || v.Float() != 42 {
+		t.Errorf("ToJSValue(42) = %v, %v, want 42", v, err)
+	}
+	if v, err := ToJSValue(true); err != nil || !v.Bool() {
+		t.Errorf("ToJSValue(true) = %v, %v, want true", v, err)
+	}
+	if v, err := ToJSValue("hi"); err != nil || v.String() != "hi" {
+		t.Errorf("ToJSValue(\"hi\") = %v, %v, want hi", v, err)
+	}
+	if _, err := ToJSValue(uint64(1<<53 + 1)); !errors.Is(err, ErrLossOfPrecision) {
+		t.Errorf("ToJSValue(2^53+1) error = %v, want %v", err, ErrLossOfPrecision)
+	}
+}
@@ -0,0 +1,75 @@
+package into
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// FromJSONValue converts a JSON scalar to a value of type T.
+//
+// FromJSONValue decodes a JSON number, string or boolean and converts it to
+// T with the boundary checks of TryInto. Unlike json.Unmarshal into an
+// interface{}, numbers are not decoded through float64, so large integers
+// keep their precision and values such as 300 into uint8 are rejected with
+// ErrOverflow. A number with a nonzero fraction, such as 1.5, is rejected
+// with ErrLossOfPrecision when T is an integer type, as json.Unmarshal does.
+// The options set by SetDefaults are applied to the conversion.
+//
+// Parameters:
+//   - raw: the JSON text of a single scalar value.
+//
+// Returns:
+//   - T: the converted value of type T.
+//   - error: ErrNil if raw is null, or an error if raw is not a single JSON
+//     scalar or the conversion fails.
+//
+// Example:
+//
+//	var payload map[string]json.RawMessage
+//	_ = json.Unmarshal([]byte(`{"level": 300}`), &payload)
+//	_, err := FromJSONValue[uint8](payload["level"])
+//	fmt.Println(errors.Is(err, ErrOverflow)) // Output: true
+func FromJSONValue[T convertable](raw json.RawMessage) (result T, err error) {
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	var v any
+	if err = d.Decode(&v); err != nil {
+		return
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return result, fmt.Errorf("invalid JSON scalar %s: unexpected data after the value", raw)
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return result, ErrNil
+	case json.Number:
+		opts := Defaults()
+		if !isIntegerKind(reflect.TypeOf(result).Kind()) {
+			return TryIntoWith[T](string(v), opts)
+		}
+		// Integers are checked against the bounds of T as integers, so that
+		// out-of-range values report ErrOverflow or ErrUnderflow.
+		if i, err := v.Int64(); err == nil {
+			return TryIntoWith[T](i, opts)
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return TryIntoWith[T](u, opts)
+		}
+		f, err := v.Float64()
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return result, err
+		}
+		opts.Rounding = Exact
+		return TryIntoWith[T](f, opts)
+	case string, bool:
+		return TryIntoAny[T](v)
+	default:
+		return result, fmt.Errorf("invalid JSON scalar %s: %T is not a scalar", raw, v)
+	}
+}
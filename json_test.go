@@ -0,0 +1,66 @@
+package into_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFromJSONValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    uint8
+		wantErr error
+	}{
+		{"number", "200", 200, nil},
+		{"numberSpaces", " 200\n", 200, nil},
+		{"exponent", "2e2", 200, nil},
+		{"integralFraction", "200.0", 200, nil},
+		{"string", `"200"`, 200, nil},
+		{"bool", "true", 1, nil},
+		{"overflow", "300", 0, ErrOverflow},
+		{"exponentOverflow", "3e2", 0, ErrOverflow},
+		{"negative", "-1", 0, ErrUnderflow},
+		{"huge", "1e400", 0, ErrOverflow},
+		{"hugeInteger", "123456789012345678901234567890", 0, ErrOverflow},
+		{"fraction", "1.5", 0, ErrLossOfPrecision},
+		{"null", "null", 0, ErrNil},
+		{"array", "[1]", 0, errInvalid},
+		{"object", `{"a": 1}`, 0, errInvalid},
+		{"trailing", "1 2", 0, errInvalid},
+		{"empty", "", 0, errInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromJSONValue[uint8](json.RawMessage(tt.input))
+			if tt.wantErr == errInvalid {
+				if err == nil {
+					t.Errorf("FromJSONValue(%s) error = nil", tt.input)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("FromJSONValue(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FromJSONValue(%s) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	// Integers beyond 2^53 keep their precision.
+	if got, err := FromJSONValue[int64](json.RawMessage("9007199254740993")); err != nil || got != 9007199254740993 {
+		t.Errorf("FromJSONValue[int64]() = %v, %v, want 9007199254740993", got, err)
+	}
+	if got, err := FromJSONValue[float64](json.RawMessage("1.5")); err != nil || got != 1.5 {
+		t.Errorf("FromJSONValue[float64]() = %v, %v, want 1.5", got, err)
+	}
+	if got, err := FromJSONValue[string](json.RawMessage("1.50")); err != nil || got != "1.50" {
+		t.Errorf("FromJSONValue[string]() = %q, %v, want %q", got, err, "1.50")
+	}
+}
@@ -0,0 +1,78 @@
+package into
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// FNV-1a parameters for 64-bit hashes.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// IntoKey returns a stable 64-bit hash of a value, for sharding keys.
+//
+// IntoKey hashes the decimal form of numbers and the bytes of strings with
+// 64-bit FNV-1a, so IDs that hold the same value in different types have the
+// same key: 42, uint8(42), 42.0 and "42" all hash alike. Floats with a
+// fraction, or beyond the integer range, are hashed in the 'g' format of
+// CanonicalString for float64, so float32(0.1), which is not the float64
+// 0.1, has its own key. Bools are hashed as "true" or "false". The hash is
+// part of the API and does not change between versions, so keys may be
+// stored. It is not a cryptographic hash.
+//
+// Parameters:
+//   - value: the value to be hashed.
+//
+// Returns:
+//   - uint64: the hash of value.
+//
+// Example:
+//
+//	shard := IntoKey(int64(42)) % 16
+//	fmt.Println(shard == IntoKey("42")%16) // Output: true
+func IntoKey[T convertable](value T) uint64 {
+	var buf [32]byte
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fnv64a(strconv.AppendInt(buf[:0], rv.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fnv64a(strconv.AppendUint(buf[:0], rv.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		switch {
+		case f != math.Trunc(f) || math.IsInf(f, 0):
+		case f >= -(1<<63) && f < 1<<63:
+			return fnv64a(strconv.AppendInt(buf[:0], int64(f), 10))
+		case f >= 0 && f < 1<<64:
+			return fnv64a(strconv.AppendUint(buf[:0], uint64(f), 10))
+		}
+		return fnv64a(strconv.AppendFloat(buf[:0], f, 'g', -1, 64))
+	case reflect.Bool:
+		return fnv64a(strconv.AppendBool(buf[:0], rv.Bool()))
+	}
+	return fnv64aString(rv.String())
+}
+
+// fnv64a returns the 64-bit FNV-1a hash of b.
+func fnv64a(b []byte) uint64 {
+	h := uint64(fnvOffset64)
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// fnv64aString returns the 64-bit FNV-1a hash of s.
+func fnv64aString(s string) uint64 {
+	h := uint64(fnvOffset64)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime64
+	}
+	return h
+}
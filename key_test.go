@@ -0,0 +1,68 @@
+package into_test
+
+import (
+	"hash/fnv"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+// fnvString returns the 64-bit FNV-1a hash of s with hash/fnv.
+func fnvString(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+func TestIntoKey(t *testing.T) {
+	type userID int64
+
+	tests := []struct {
+		name string
+		got  uint64
+		form string
+	}{
+		{"int", IntoKey(42), "42"},
+		{"int8", IntoKey(int8(-7)), "-7"},
+		{"uint8", IntoKey(uint8(42)), "42"},
+		{"uint64", IntoKey(uint64(math.MaxUint64)), "18446744073709551615"},
+		{"named", IntoKey(userID(42)), "42"},
+		{"string", IntoKey("42"), "42"},
+		{"empty string", IntoKey(""), ""},
+		{"integral float", IntoKey(42.0), "42"},
+		{"negative zero", IntoKey(math.Copysign(0, -1)), "0"},
+		{"large float", IntoKey(1e19), "10000000000000000000"},
+		{"huge float", IntoKey(1e300), "1e+300"},
+		{"fraction", IntoKey(0.1), "0.1"},
+		{"float32", IntoKey(float32(0.1)), "0.10000000149011612"},
+		{"infinity", IntoKey(math.Inf(1)), "+Inf"},
+		{"NaN", IntoKey(math.NaN()), "NaN"},
+		{"bool", IntoKey(true), "true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if want := fnvString(tt.form); tt.got != want {
+				t.Errorf("IntoKey() = %#x, want the hash of %q, %#x", tt.got, tt.form, want)
+			}
+		})
+	}
+}
+
+// TestIntoKeyStable pins hash values, which must not change between
+// versions since keys may be stored.
+func TestIntoKeyStable(t *testing.T) {
+	tests := []struct {
+		got  uint64
+		want uint64
+	}{
+		{IntoKey(""), 0xcbf29ce484222325},
+		{IntoKey("a"), 0xaf63dc4c8601ec8c},
+		{IntoKey(42), 0x07ee7e07b4b19223},
+	}
+	for i, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("case %d: IntoKey() = %#016x, want %#016x", i, tt.got, tt.want)
+		}
+	}
+}
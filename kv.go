@@ -0,0 +1,97 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// StringToMap converts a string of key=value pairs to a map.
+//
+// StringToMap splits value into pairs at every pairSep, and each pair into
+// a key and a value at its first kvSep, so values may contain kvSep, as in
+// connection strings ("host=db;password=a=b") and tag lists
+// ("env:prod,team:core"). The white space around keys and values is
+// trimmed, and empty pairs, such as the one after a trailing separator, are
+// skipped. A key given several times keeps its last value.
+//
+// Parameters:
+//   - value: the string to be converted.
+//   - pairSep: the separator between pairs; it must not be empty.
+//   - kvSep: the separator between a key and its value; it must not be
+//     empty.
+//
+// Returns:
+//   - map[string]string: the pairs, or nil on error.
+//   - error: an *IndexError naming the position of the first pair without
+//     kvSep or with an empty key, or an error if a separator is empty.
+//
+// Example:
+//
+//	result, err := StringToMap("a=1;b=2", ";", "=")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: map[a:1 b:2]
+func StringToMap(value string, pairSep string, kvSep string) (map[string]string, error) {
+	if pairSep == "" || kvSep == "" {
+		return nil, errors.New("empty separator")
+	}
+
+	result := make(map[string]string)
+	for i, pair := range strings.Split(value, pairSep) {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, kvSep)
+		if !ok {
+			return nil, &IndexError{Index: i, Err: fmt.Errorf("missing %q in pair %q", kvSep, pair)}
+		}
+		k = strings.TrimSpace(k)
+		if k == "" {
+			return nil, &IndexError{Index: i, Err: fmt.Errorf("empty key in pair %q", pair)}
+		}
+		result[k] = strings.TrimSpace(v)
+	}
+	return result, nil
+}
+
+// StringToMapOf converts a string of key=value pairs to a map of T values.
+//
+// StringToMapOf splits value like StringToMap and converts every value like
+// TryInto.
+//
+// Parameters:
+//   - value: the string to be converted.
+//   - pairSep: the separator between pairs; it must not be empty.
+//   - kvSep: the separator between a key and its value; it must not be
+//     empty.
+//
+// Returns:
+//   - map[string]T: the converted pairs, or nil on error.
+//   - error: an error from StringToMap, or an error naming the key of the
+//     value that failed, wrapping its error.
+//
+// Example:
+//
+//	result, err := StringToMapOf[int]("retries=3, timeout=30", ",", "=")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: map[retries:3 timeout:30]
+func StringToMapOf[T convertable](value string, pairSep string, kvSep string) (map[string]T, error) {
+	pairs, err := StringToMap(value, pairSep, kvSep)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]T, len(pairs))
+	for k, v := range pairs {
+		r, err := TryInto[T](v)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", k, err)
+		}
+		result[k] = r
+	}
+	return result, nil
+}
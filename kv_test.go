@@ -0,0 +1,73 @@
+package into_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		pairSep   string
+		kvSep     string
+		want      map[string]string
+		wantIndex int
+		wantErr   bool
+	}{
+		{"simple", "a=1;b=2", ";", "=", map[string]string{"a": "1", "b": "2"}, 0, false},
+		{"spaces", " host = db ; port= 5432 ", ";", "=", map[string]string{"host": "db", "port": "5432"}, 0, false},
+		{"valueWithSep", "password=a=b", ";", "=", map[string]string{"password": "a=b"}, 0, false},
+		{"trailingSep", "a=1;", ";", "=", map[string]string{"a": "1"}, 0, false},
+		{"emptyValue", "a=", ";", "=", map[string]string{"a": ""}, 0, false},
+		{"tags", "env:prod,team:core", ",", ":", map[string]string{"env": "prod", "team": "core"}, 0, false},
+		{"duplicate", "a=1;a=2", ";", "=", map[string]string{"a": "2"}, 0, false},
+		{"empty", "", ";", "=", map[string]string{}, 0, false},
+		{"missingSep", "a=1;b", ";", "=", nil, 1, true},
+		{"emptyKey", "=1", ";", "=", nil, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToMap(tt.input, tt.pairSep, tt.kvSep)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToMap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				var indexErr *IndexError
+				if !errors.As(err, &indexErr) || indexErr.Index != tt.wantIndex {
+					t.Errorf("StringToMap() error = %v, want index %d", err, tt.wantIndex)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StringToMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := StringToMap("a=1", "", "="); err == nil {
+		t.Error("StringToMap() with an empty separator error = nil, want an error")
+	}
+}
+
+func TestStringToMapOf(t *testing.T) {
+	got, err := StringToMapOf[int]("retries=3, timeout=30", ",", "=")
+	if err != nil || !reflect.DeepEqual(got, map[string]int{"retries": 3, "timeout": 30}) {
+		t.Errorf("StringToMapOf() = %v, %v", got, err)
+	}
+	if _, err := StringToMapOf[uint8]("a=1,b=300", ",", "="); !errors.Is(err, ErrOverflow) {
+		t.Errorf("StringToMapOf() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := StringToMapOf[bool]("a=maybe", ",", "="); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("StringToMapOf() error = %v, want %v", err, strconv.ErrSyntax)
+	}
+	if _, err := StringToMapOf[int]("a", ",", "="); err == nil {
+		t.Error("StringToMapOf() with a malformed pair error = nil, want an error")
+	}
+}
@@ -0,0 +1,166 @@
+package into
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Locale holds the month and weekday names of a language for time parsing.
+//
+// Locale is a pluggable name table used by StringToTimeLocale to parse
+// dates such as "15 janvier 2024" or "Montag, 15. Januar 2024". Names are
+// matched case-insensitively as whole words, and a period following a name
+// (as in "janv.") is ignored. Callers can define a Locale for any language;
+// LocaleFrench, LocaleGerman and LocaleSpanish are provided.
+type Locale struct {
+	// Months holds the names of each month from January to December. Each
+	// entry lists every accepted spelling, e.g. full and abbreviated names.
+	Months [12][]string
+	// Weekdays holds the names of each weekday from Sunday to Saturday.
+	Weekdays [7][]string
+	// Fillers lists words that are dropped before parsing, such as "de" in
+	// the Spanish "15 de enero de 2024".
+	Fillers []string
+}
+
+var (
+	// LocaleFrench holds the French month and weekday names.
+	LocaleFrench = Locale{
+		Months: [12][]string{
+			{"janvier", "janv"}, {"février", "fevrier", "févr", "fevr"}, {"mars"}, {"avril", "avr"},
+			{"mai"}, {"juin"}, {"juillet", "juil"}, {"août", "aout"},
+			{"septembre", "sept"}, {"octobre", "oct"}, {"novembre", "nov"}, {"décembre", "decembre", "déc", "dec"},
+		},
+		Weekdays: [7][]string{
+			{"dimanche", "dim"}, {"lundi", "lun"}, {"mardi", "mar"}, {"mercredi", "mer"},
+			{"jeudi", "jeu"}, {"vendredi", "ven"}, {"samedi", "sam"},
+		},
+		Fillers: []string{"le"},
+	}
+
+	// LocaleGerman holds the German month and weekday names.
+	LocaleGerman = Locale{
+		Months: [12][]string{
+			{"januar", "jan", "jänner", "jän"}, {"februar", "feb"}, {"märz", "maerz", "mär"}, {"april", "apr"},
+			{"mai"}, {"juni", "jun"}, {"juli", "jul"}, {"august", "aug"},
+			{"september", "sep", "sept"}, {"oktober", "okt"}, {"november", "nov"}, {"dezember", "dez"},
+		},
+		Weekdays: [7][]string{
+			{"sonntag", "so"}, {"montag", "mo"}, {"dienstag", "di"}, {"mittwoch", "mi"},
+			{"donnerstag", "do"}, {"freitag", "fr"}, {"samstag", "sonnabend", "sa"},
+		},
+	}
+
+	// LocaleSpanish holds the Spanish month and weekday names.
+	LocaleSpanish = Locale{
+		Months: [12][]string{
+			{"enero", "ene"}, {"febrero", "feb"}, {"marzo", "mar"}, {"abril", "abr"},
+			{"mayo", "may"}, {"junio", "jun"}, {"julio", "jul"}, {"agosto", "ago"},
+			{"septiembre", "setiembre", "sept", "sep", "set"}, {"octubre", "oct"}, {"noviembre", "nov"}, {"diciembre", "dic"},
+		},
+		Weekdays: [7][]string{
+			{"domingo", "dom"}, {"lunes", "lun"}, {"martes", "mar"}, {"miércoles", "miercoles", "mié", "mie"},
+			{"jueves", "jue"}, {"viernes", "vie"}, {"sábado", "sabado", "sáb", "sab"},
+		},
+		Fillers: []string{"de", "del"},
+	}
+)
+
+// localeTimeFormats are the layouts tried by StringToTimeLocale after the
+// names of the locale have been replaced by English ones.
+var localeTimeFormats = []timeFormat{
+	{"2 January 2006", timeFormatNoTimezone},
+	{"2. January 2006", timeFormatNoTimezone},
+	{"Monday 2 January 2006", timeFormatNoTimezone},
+	{"Monday, 2 January 2006", timeFormatNoTimezone},
+	{"Monday, 2. January 2006", timeFormatNoTimezone},
+	{"2 January 2006 15:04", timeFormatNoTimezone},
+	{"2 January 2006 15:04:05", timeFormatNoTimezone},
+	{"2. January 2006 15:04", timeFormatNoTimezone},
+	{"2. January 2006 15:04:05", timeFormatNoTimezone},
+	{"January 2, 2006", timeFormatNoTimezone},
+	{"January 2 2006", timeFormatNoTimezone},
+	{"Monday, January 2, 2006", timeFormatNoTimezone},
+	{"January 2006", timeFormatNoTimezone},
+}
+
+// StringToTimeLocale converts the given string value to a time.Time value,
+// accepting month and weekday names of the given locale.
+//
+// StringToTimeLocale first tries the formats of StringToTime. If none
+// matches, it replaces the month and weekday names of locale with their
+// English equivalents, drops the filler words and parses the result with
+// common written layouts such as "2 January 2006", "2. January 2006" and
+// "Monday, 2 January 2006". As with time.Parse, a weekday name is accepted
+// but not checked against the date.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//   - locale: the month and weekday names to accept.
+//
+// Returns:
+//   - time.Time: the converted time.Time value, in UTC.
+//   - error: an error if the conversion fails.
+//
+// Example:
+//
+//	result, err := StringToTimeLocale("15 janvier 2024", LocaleFrench)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 2024-01-15 00:00:00 +0000 UTC
+func StringToTimeLocale(value string, locale Locale) (time.Time, error) {
+	if t, err := StringToTime(value); err == nil {
+		return t, nil
+	}
+	return parseDateWith(locale.translate(value), time.UTC, localeTimeFormats)
+}
+
+// translate replaces the names of l in s with their English equivalents,
+// drops filler words and collapses white space.
+func (l Locale) translate(s string) string {
+	names := make(map[string]string)
+	for i, spellings := range l.Months {
+		for _, name := range spellings {
+			names[strings.ToLower(name)] = time.Month(i + 1).String()
+		}
+	}
+	for i, spellings := range l.Weekdays {
+		for _, name := range spellings {
+			names[strings.ToLower(name)] = time.Weekday(i).String()
+		}
+	}
+	for _, filler := range l.Fillers {
+		names[strings.ToLower(filler)] = ""
+	}
+
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		if !unicode.IsLetter(runes[i]) {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(runes) && unicode.IsLetter(runes[j]) {
+			j++
+		}
+		word := string(runes[i:j])
+		english, ok := names[strings.ToLower(word)]
+		if !ok {
+			b.WriteString(word)
+			i = j
+			continue
+		}
+		b.WriteString(english)
+		// Drop the period of an abbreviation, but keep a comma or space.
+		if j < len(runes) && runes[j] == '.' && english != "" {
+			j++
+		}
+		i = j
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
@@ -0,0 +1,63 @@
+package into_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToTimeLocale(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		locale  Locale
+		want    time.Time
+		wantErr bool
+	}{
+		{"french", "15 janvier 2024", LocaleFrench, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"frenchAbbreviated", "15 janv. 2024", LocaleFrench, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"frenchAccent", "3 Février 2024", LocaleFrench, time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC), false},
+		{"frenchWeekday", "lundi 15 janvier 2024", LocaleFrench, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"frenchClock", "le 15 août 2024 14:30", LocaleFrench, time.Date(2024, 8, 15, 14, 30, 0, 0, time.UTC), false},
+		{"german", "15. Januar 2024", LocaleGerman, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"germanWeekday", "Montag, 15. Januar 2024", LocaleGerman, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"germanUmlaut", "1. März 2024", LocaleGerman, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{"spanish", "15 de enero de 2024", LocaleSpanish, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"spanishMonthYear", "septiembre de 2024", LocaleSpanish, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), false},
+		{"english", "2024-01-15", LocaleFrench, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"wrongLocale", "15 janvier 2024", LocaleGerman, time.Time{}, true},
+		{"unknownWord", "15 foo 2024", LocaleFrench, time.Time{}, true},
+		{"empty", "", LocaleFrench, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToTimeLocale(tt.input, tt.locale)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StringToTimeLocale(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("StringToTimeLocale(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocaleOption(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	if _, err := TryIntoTime("15 janvier 2024"); err == nil {
+		t.Error("TryIntoTime(\"15 janvier 2024\") without Locale error = nil")
+	}
+
+	SetDefaults(Options{Locale: &LocaleFrench, EpochStrings: true})
+	got, err := TryIntoTime("15 janvier 2024")
+	if err != nil || !got.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("TryIntoTime(\"15 janvier 2024\") = %v, %v", got, err)
+	}
+	got, err = TryIntoTime("1678867200")
+	if err != nil || !got.Equal(time.Unix(1678867200, 0)) {
+		t.Errorf("TryIntoTime(\"1678867200\") = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,224 @@
+// Code generated by convgen; DO NOT EDIT.
+
+package into
+
+import "reflect"
+
+// WidensToInt is satisfied by the types whose values all convert to int exactly
+// on every platform, so that their conversion to int cannot fail.
+type WidensToInt interface {
+	~int | ~int8 | ~int16 | ~int32 | ~uint8 | ~uint16
+}
+
+// WidensToInt8 is satisfied by the types whose values all convert to int8
+// exactly on every platform, so that their conversion to int8 cannot fail.
+type WidensToInt8 interface {
+	~int8
+}
+
+// WidensToInt16 is satisfied by the types whose values all convert to int16
+// exactly on every platform, so that their conversion to int16 cannot fail.
+type WidensToInt16 interface {
+	~int8 | ~int16 | ~uint8
+}
+
+// WidensToInt32 is satisfied by the types whose values all convert to int32
+// exactly on every platform, so that their conversion to int32 cannot fail.
+type WidensToInt32 interface {
+	~int8 | ~int16 | ~int32 | ~uint8 | ~uint16
+}
+
+// WidensToInt64 is satisfied by the types whose values all convert to int64
+// exactly on every platform, so that their conversion to int64 cannot fail.
+type WidensToInt64 interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32
+}
+
+// WidensToUint is satisfied by the types whose values all convert to uint
+// exactly on every platform, so that their conversion to uint cannot fail.
+type WidensToUint interface {
+	~uint | ~uint8 | ~uint16 | ~uint32
+}
+
+// WidensToUint8 is satisfied by the types whose values all convert to uint8
+// exactly on every platform, so that their conversion to uint8 cannot fail.
+type WidensToUint8 interface {
+	~uint8
+}
+
+// WidensToUint16 is satisfied by the types whose values all convert to uint16
+// exactly on every platform, so that their conversion to uint16 cannot fail.
+type WidensToUint16 interface {
+	~uint8 | ~uint16
+}
+
+// WidensToUint32 is satisfied by the types whose values all convert to uint32
+// exactly on every platform, so that their conversion to uint32 cannot fail.
+type WidensToUint32 interface {
+	~uint8 | ~uint16 | ~uint32
+}
+
+// WidensToUint64 is satisfied by the types whose values all convert to uint64
+// exactly on every platform, so that their conversion to uint64 cannot fail.
+type WidensToUint64 interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// WidensToFloat32 is satisfied by the types whose values all convert to float32
+// exactly on every platform, so that their conversion to float32 cannot fail.
+type WidensToFloat32 interface {
+	~int8 | ~int16 | ~uint8 | ~uint16 | ~float32
+}
+
+// WidensToFloat64 is satisfied by the types whose values all convert to float64
+// exactly on every platform, so that their conversion to float64 cannot fail.
+type WidensToFloat64 interface {
+	~int8 | ~int16 | ~int32 | ~uint8 | ~uint16 | ~uint32 | ~float32 | ~float64
+}
+
+// losslessKinds holds the pairs of kinds whose values all convert exactly
+// on every platform.
+var losslessKinds = map[[2]reflect.Kind]bool{
+	{reflect.Int, reflect.Int}:         true,
+	{reflect.Int, reflect.Int64}:       true,
+	{reflect.Int8, reflect.Int}:        true,
+	{reflect.Int8, reflect.Int8}:       true,
+	{reflect.Int8, reflect.Int16}:      true,
+	{reflect.Int8, reflect.Int32}:      true,
+	{reflect.Int8, reflect.Int64}:      true,
+	{reflect.Int8, reflect.Float32}:    true,
+	{reflect.Int8, reflect.Float64}:    true,
+	{reflect.Int16, reflect.Int}:       true,
+	{reflect.Int16, reflect.Int16}:     true,
+	{reflect.Int16, reflect.Int32}:     true,
+	{reflect.Int16, reflect.Int64}:     true,
+	{reflect.Int16, reflect.Float32}:   true,
+	{reflect.Int16, reflect.Float64}:   true,
+	{reflect.Int32, reflect.Int}:       true,
+	{reflect.Int32, reflect.Int32}:     true,
+	{reflect.Int32, reflect.Int64}:     true,
+	{reflect.Int32, reflect.Float64}:   true,
+	{reflect.Int64, reflect.Int64}:     true,
+	{reflect.Uint, reflect.Uint}:       true,
+	{reflect.Uint, reflect.Uint64}:     true,
+	{reflect.Uint8, reflect.Int}:       true,
+	{reflect.Uint8, reflect.Int16}:     true,
+	{reflect.Uint8, reflect.Int32}:     true,
+	{reflect.Uint8, reflect.Int64}:     true,
+	{reflect.Uint8, reflect.Uint}:      true,
+	{reflect.Uint8, reflect.Uint8}:     true,
+	{reflect.Uint8, reflect.Uint16}:    true,
+	{reflect.Uint8, reflect.Uint32}:    true,
+	{reflect.Uint8, reflect.Uint64}:    true,
+	{reflect.Uint8, reflect.Float32}:   true,
+	{reflect.Uint8, reflect.Float64}:   true,
+	{reflect.Uint16, reflect.Int}:      true,
+	{reflect.Uint16, reflect.Int32}:    true,
+	{reflect.Uint16, reflect.Int64}:    true,
+	{reflect.Uint16, reflect.Uint}:     true,
+	{reflect.Uint16, reflect.Uint16}:   true,
+	{reflect.Uint16, reflect.Uint32}:   true,
+	{reflect.Uint16, reflect.Uint64}:   true,
+	{reflect.Uint16, reflect.Float32}:  true,
+	{reflect.Uint16, reflect.Float64}:  true,
+	{reflect.Uint32, reflect.Int64}:    true,
+	{reflect.Uint32, reflect.Uint}:     true,
+	{reflect.Uint32, reflect.Uint32}:   true,
+	{reflect.Uint32, reflect.Uint64}:   true,
+	{reflect.Uint32, reflect.Float64}:  true,
+	{reflect.Uint64, reflect.Uint64}:   true,
+	{reflect.Float32, reflect.Float32}: true,
+	{reflect.Float32, reflect.Float64}: true,
+	{reflect.Float64, reflect.Float64}: true,
+}
+
+// infallibleKinds holds the pairs of different kinds whose values all convert
+// without an error on every platform, which have an XxxIntoYyy converter.
+var infallibleKinds = map[[2]reflect.Kind]bool{
+	{reflect.Bool, reflect.Int}:        true,
+	{reflect.Bool, reflect.Int8}:       true,
+	{reflect.Bool, reflect.Int16}:      true,
+	{reflect.Bool, reflect.Int32}:      true,
+	{reflect.Bool, reflect.Int64}:      true,
+	{reflect.Bool, reflect.Uint}:       true,
+	{reflect.Bool, reflect.Uint8}:      true,
+	{reflect.Bool, reflect.Uint16}:     true,
+	{reflect.Bool, reflect.Uint32}:     true,
+	{reflect.Bool, reflect.Uint64}:     true,
+	{reflect.Bool, reflect.Float32}:    true,
+	{reflect.Bool, reflect.Float64}:    true,
+	{reflect.Bool, reflect.String}:     true,
+	{reflect.Int, reflect.Bool}:        true,
+	{reflect.Int, reflect.Int64}:       true,
+	{reflect.Int, reflect.Float32}:     true,
+	{reflect.Int, reflect.Float64}:     true,
+	{reflect.Int, reflect.String}:      true,
+	{reflect.Int8, reflect.Bool}:       true,
+	{reflect.Int8, reflect.Int}:        true,
+	{reflect.Int8, reflect.Int16}:      true,
+	{reflect.Int8, reflect.Int32}:      true,
+	{reflect.Int8, reflect.Int64}:      true,
+	{reflect.Int8, reflect.Float32}:    true,
+	{reflect.Int8, reflect.Float64}:    true,
+	{reflect.Int8, reflect.String}:     true,
+	{reflect.Int16, reflect.Bool}:      true,
+	{reflect.Int16, reflect.Int}:       true,
+	{reflect.Int16, reflect.Int32}:     true,
+	{reflect.Int16, reflect.Int64}:     true,
+	{reflect.Int16, reflect.Float32}:   true,
+	{reflect.Int16, reflect.Float64}:   true,
+	{reflect.Int16, reflect.String}:    true,
+	{reflect.Int32, reflect.Bool}:      true,
+	{reflect.Int32, reflect.Int}:       true,
+	{reflect.Int32, reflect.Int64}:     true,
+	{reflect.Int32, reflect.Float32}:   true,
+	{reflect.Int32, reflect.Float64}:   true,
+	{reflect.Int32, reflect.String}:    true,
+	{reflect.Int64, reflect.Bool}:      true,
+	{reflect.Int64, reflect.Float32}:   true,
+	{reflect.Int64, reflect.Float64}:   true,
+	{reflect.Int64, reflect.String}:    true,
+	{reflect.Uint, reflect.Bool}:       true,
+	{reflect.Uint, reflect.Uint64}:     true,
+	{reflect.Uint, reflect.Float32}:    true,
+	{reflect.Uint, reflect.Float64}:    true,
+	{reflect.Uint, reflect.String}:     true,
+	{reflect.Uint8, reflect.Bool}:      true,
+	{reflect.Uint8, reflect.Int}:       true,
+	{reflect.Uint8, reflect.Int16}:     true,
+	{reflect.Uint8, reflect.Int32}:     true,
+	{reflect.Uint8, reflect.Int64}:     true,
+	{reflect.Uint8, reflect.Uint}:      true,
+	{reflect.Uint8, reflect.Uint16}:    true,
+	{reflect.Uint8, reflect.Uint32}:    true,
+	{reflect.Uint8, reflect.Uint64}:    true,
+	{reflect.Uint8, reflect.Float32}:   true,
+	{reflect.Uint8, reflect.Float64}:   true,
+	{reflect.Uint8, reflect.String}:    true,
+	{reflect.Uint16, reflect.Bool}:     true,
+	{reflect.Uint16, reflect.Int}:      true,
+	{reflect.Uint16, reflect.Int32}:    true,
+	{reflect.Uint16, reflect.Int64}:    true,
+	{reflect.Uint16, reflect.Uint}:     true,
+	{reflect.Uint16, reflect.Uint32}:   true,
+	{reflect.Uint16, reflect.Uint64}:   true,
+	{reflect.Uint16, reflect.Float32}:  true,
+	{reflect.Uint16, reflect.Float64}:  true,
+	{reflect.Uint16, reflect.String}:   true,
+	{reflect.Uint32, reflect.Bool}:     true,
+	{reflect.Uint32, reflect.Int64}:    true,
+	{reflect.Uint32, reflect.Uint}:     true,
+	{reflect.Uint32, reflect.Uint64}:   true,
+	{reflect.Uint32, reflect.Float32}:  true,
+	{reflect.Uint32, reflect.Float64}:  true,
+	{reflect.Uint32, reflect.String}:   true,
+	{reflect.Uint64, reflect.Bool}:     true,
+	{reflect.Uint64, reflect.Float32}:  true,
+	{reflect.Uint64, reflect.Float64}:  true,
+	{reflect.Uint64, reflect.String}:   true,
+	{reflect.Float32, reflect.Bool}:    true,
+	{reflect.Float32, reflect.Float64}: true,
+	{reflect.Float32, reflect.String}:  true,
+	{reflect.Float64, reflect.Bool}:    true,
+	{reflect.Float64, reflect.String}:  true,
+}
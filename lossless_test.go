@@ -0,0 +1,74 @@
+package into_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+// checkWiden checks that into agrees with the direct converter to on values.
+func checkWiden[U any, T comparable](t *testing.T, name string, into func(U) T, to func(U) (T, error), values ...U) {
+	t.Helper()
+	for _, v := range values {
+		want, err := to(v)
+		if err != nil {
+			t.Errorf("%s: direct converter error = %v for %v", name, err, v)
+			continue
+		}
+		if got := into(v); got != want {
+			t.Errorf("%s(%v) = %v, want %v", name, v, got, want)
+		}
+	}
+}
+
+func TestWidenConverters(t *testing.T) {
+	checkWiden(t, "Int8IntoInt64", Int8IntoInt64, Int8ToInt64, math.MinInt8, 0, math.MaxInt8)
+	checkWiden(t, "Int16IntoInt", Int16IntoInt, Int16ToInt, math.MinInt16, math.MaxInt16)
+	checkWiden(t, "Int32IntoFloat64", Int32IntoFloat64, Int32ToFloat64, math.MinInt32, math.MaxInt32)
+	checkWiden(t, "IntIntoInt64", IntIntoInt64, IntToInt64, math.MinInt32, math.MaxInt32)
+	checkWiden(t, "Uint8IntoInt16", Uint8IntoInt16, Uint8ToInt16, 0, math.MaxUint8)
+	checkWiden(t, "Uint16IntoFloat32", Uint16IntoFloat32, Uint16ToFloat32, 0, math.MaxUint16)
+	checkWiden(t, "Uint16IntoFloat64", Uint16IntoFloat64, Uint16ToFloat64, 0, math.MaxUint16)
+	checkWiden(t, "Uint32IntoInt64", Uint32IntoInt64, Uint32ToInt64, 0, math.MaxUint32)
+	checkWiden(t, "Uint32IntoUint", Uint32IntoUint, Uint32ToUint, 0, math.MaxUint32)
+	checkWiden(t, "UintIntoUint64", UintIntoUint64, UintToUint64, 0, math.MaxUint32)
+	checkWiden(t, "Float32IntoFloat64", Float32IntoFloat64, Float32ToFloat64,
+		-math.MaxFloat32, math.SmallestNonzeroFloat32, float32(math.Inf(1)))
+}
+
+func TestInfallibleConverters(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"BoolIntoInt8 true", BoolIntoInt8(true), int8(1)},
+		{"BoolIntoUint64 false", BoolIntoUint64(false), uint64(0)},
+		{"BoolIntoFloat32 true", BoolIntoFloat32(true), float32(1)},
+		{"Int64IntoFloat64 rounds", Int64IntoFloat64(1<<53 + 1), float64(1 << 53)},
+		{"Uint64IntoFloat32 max", Uint64IntoFloat32(math.MaxUint64), float32(math.MaxUint64)},
+		{"Int8IntoBool", Int8IntoBool(-1), true},
+		{"Float64IntoBool zero", Float64IntoBool(0), false},
+		{"Float64IntoBool NaN", Float64IntoBool(math.NaN()), true},
+		{"Uint64IntoBool", Uint64IntoBool(0), false},
+		{"BoolIntoString", BoolIntoString(true), "true"},
+		{"Int8IntoString", Int8IntoString(math.MinInt8), "-128"},
+		{"Uint64IntoString", Uint64IntoString(math.MaxUint64), "18446744073709551615"},
+		{"Float64IntoString", Float64IntoString(0.1), "0.1"},
+		{"Float32IntoString", Float32IntoString(0.1), "0.1"},
+		{"BoolIntoStringAs", BoolIntoStringAs(false, "on", "off"), "off"},
+		{"BytesIntoStringHex", BytesIntoStringHex([]byte{0xca, 0xfe}), "cafe"},
+		{"DurationIntoSecondsFloat64", DurationIntoSecondsFloat64(-1500 * time.Millisecond), -1.5},
+		{"DurationIntoMillisInt64", DurationIntoMillisInt64(1999 * time.Microsecond), int64(1)},
+		{"UUIDIntoString", UUIDIntoString([16]byte{15: 1}), "00000000-0000-0000-0000-000000000001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v (%T), want %v (%T)", tt.got, tt.got, tt.want, tt.want)
+			}
+		})
+	}
+}
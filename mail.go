@@ -0,0 +1,51 @@
+package into
+
+import "net/mail"
+
+func init() {
+	Register(StringToMailAddress)
+	Register(MailAddressToString)
+}
+
+// StringToMailAddress converts the given string value to a *mail.Address value.
+//
+// StringToMailAddress converts a single RFC 5322 address such as
+// "alice@example.com" or "Alice <alice@example.com>" to a *mail.Address
+// value. The registry converts strings to *mail.Address with it.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - *mail.Address: the converted *mail.Address value.
+//   - error: an error if the string is not a valid address.
+//
+// Example:
+//
+//	result, err := StringToMailAddress("Alice <alice@example.com>")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.Address) // Output: alice@example.com
+func StringToMailAddress(value string) (*mail.Address, error) {
+	return mail.ParseAddress(value)
+}
+
+// MailAddressToString converts the given *mail.Address value to a string value.
+//
+// MailAddressToString converts a *mail.Address value to a string that
+// StringToMailAddress accepts, quoting or encoding the name as needed, e.g.
+// "\"Alice\" <alice@example.com>".
+//
+// Parameters:
+//   - value: the *mail.Address value to be converted.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: ErrNil if the value is nil.
+func MailAddressToString(value *mail.Address) (string, error) {
+	if value == nil {
+		return "", ErrNil
+	}
+	return value.String(), nil
+}
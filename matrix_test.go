@@ -0,0 +1,1563 @@
+// Code generated by matrixgen; DO NOT EDIT.
+
+//go:build !386 && !arm && !mips && !mipsle
+
+package into_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+var conversionMatrix = []struct {
+	name    string
+	convert func() (any, error)
+	want    any
+	wantErr error
+}{
+	{"BoolToInt(false)", func() (any, error) { return BoolToInt(false) }, int(0), nil},
+	{"BoolToInt(true)", func() (any, error) { return BoolToInt(true) }, int(1), nil},
+	{"BoolToInt8(false)", func() (any, error) { return BoolToInt8(false) }, int8(0), nil},
+	{"BoolToInt8(true)", func() (any, error) { return BoolToInt8(true) }, int8(1), nil},
+	{"BoolToInt16(false)", func() (any, error) { return BoolToInt16(false) }, int16(0), nil},
+	{"BoolToInt16(true)", func() (any, error) { return BoolToInt16(true) }, int16(1), nil},
+	{"BoolToInt32(false)", func() (any, error) { return BoolToInt32(false) }, int32(0), nil},
+	{"BoolToInt32(true)", func() (any, error) { return BoolToInt32(true) }, int32(1), nil},
+	{"BoolToInt64(false)", func() (any, error) { return BoolToInt64(false) }, int64(0), nil},
+	{"BoolToInt64(true)", func() (any, error) { return BoolToInt64(true) }, int64(1), nil},
+	{"BoolToUint(false)", func() (any, error) { return BoolToUint(false) }, uint(0), nil},
+	{"BoolToUint(true)", func() (any, error) { return BoolToUint(true) }, uint(1), nil},
+	{"BoolToUint8(false)", func() (any, error) { return BoolToUint8(false) }, uint8(0), nil},
+	{"BoolToUint8(true)", func() (any, error) { return BoolToUint8(true) }, uint8(1), nil},
+	{"BoolToUint16(false)", func() (any, error) { return BoolToUint16(false) }, uint16(0), nil},
+	{"BoolToUint16(true)", func() (any, error) { return BoolToUint16(true) }, uint16(1), nil},
+	{"BoolToUint32(false)", func() (any, error) { return BoolToUint32(false) }, uint32(0), nil},
+	{"BoolToUint32(true)", func() (any, error) { return BoolToUint32(true) }, uint32(1), nil},
+	{"BoolToUint64(false)", func() (any, error) { return BoolToUint64(false) }, uint64(0), nil},
+	{"BoolToUint64(true)", func() (any, error) { return BoolToUint64(true) }, uint64(1), nil},
+	{"BoolToFloat32(false)", func() (any, error) { return BoolToFloat32(false) }, float32(0), nil},
+	{"BoolToFloat32(true)", func() (any, error) { return BoolToFloat32(true) }, float32(1), nil},
+	{"BoolToFloat64(false)", func() (any, error) { return BoolToFloat64(false) }, float64(0), nil},
+	{"BoolToFloat64(true)", func() (any, error) { return BoolToFloat64(true) }, float64(1), nil},
+	{"BoolToString(false)", func() (any, error) { return BoolToString(false) }, "false", nil},
+	{"BoolToString(true)", func() (any, error) { return BoolToString(true) }, "true", nil},
+	{"IntToBool(-9223372036854775808)", func() (any, error) { return IntToBool(-9223372036854775808) }, true, nil},
+	{"IntToBool(-1)", func() (any, error) { return IntToBool(-1) }, true, nil},
+	{"IntToBool(0)", func() (any, error) { return IntToBool(0) }, false, nil},
+	{"IntToBool(1)", func() (any, error) { return IntToBool(1) }, true, nil},
+	{"IntToBool(42)", func() (any, error) { return IntToBool(42) }, true, nil},
+	{"IntToBool(9223372036854775807)", func() (any, error) { return IntToBool(9223372036854775807) }, true, nil},
+	{"IntToInt8(-9223372036854775808)", func() (any, error) { return IntToInt8(-9223372036854775808) }, nil, ErrUnderflow},
+	{"IntToInt8(-1)", func() (any, error) { return IntToInt8(-1) }, int8(-1), nil},
+	{"IntToInt8(0)", func() (any, error) { return IntToInt8(0) }, int8(0), nil},
+	{"IntToInt8(1)", func() (any, error) { return IntToInt8(1) }, int8(1), nil},
+	{"IntToInt8(42)", func() (any, error) { return IntToInt8(42) }, int8(42), nil},
+	{"IntToInt8(9223372036854775807)", func() (any, error) { return IntToInt8(9223372036854775807) }, nil, ErrOverflow},
+	{"IntToInt16(-9223372036854775808)", func() (any, error) { return IntToInt16(-9223372036854775808) }, nil, ErrUnderflow},
+	{"IntToInt16(-1)", func() (any, error) { return IntToInt16(-1) }, int16(-1), nil},
+	{"IntToInt16(0)", func() (any, error) { return IntToInt16(0) }, int16(0), nil},
+	{"IntToInt16(1)", func() (any, error) { return IntToInt16(1) }, int16(1), nil},
+	{"IntToInt16(42)", func() (any, error) { return IntToInt16(42) }, int16(42), nil},
+	{"IntToInt16(9223372036854775807)", func() (any, error) { return IntToInt16(9223372036854775807) }, nil, ErrOverflow},
+	{"IntToInt32(-9223372036854775808)", func() (any, error) { return IntToInt32(-9223372036854775808) }, nil, ErrUnderflow},
+	{"IntToInt32(-1)", func() (any, error) { return IntToInt32(-1) }, int32(-1), nil},
+	{"IntToInt32(0)", func() (any, error) { return IntToInt32(0) }, int32(0), nil},
+	{"IntToInt32(1)", func() (any, error) { return IntToInt32(1) }, int32(1), nil},
+	{"IntToInt32(42)", func() (any, error) { return IntToInt32(42) }, int32(42), nil},
+	{"IntToInt32(9223372036854775807)", func() (any, error) { return IntToInt32(9223372036854775807) }, nil, ErrOverflow},
+	{"IntToInt64(-9223372036854775808)", func() (any, error) { return IntToInt64(-9223372036854775808) }, int64(-9223372036854775808), nil},
+	{"IntToInt64(-1)", func() (any, error) { return IntToInt64(-1) }, int64(-1), nil},
+	{"IntToInt64(0)", func() (any, error) { return IntToInt64(0) }, int64(0), nil},
+	{"IntToInt64(1)", func() (any, error) { return IntToInt64(1) }, int64(1), nil},
+	{"IntToInt64(42)", func() (any, error) { return IntToInt64(42) }, int64(42), nil},
+	{"IntToInt64(9223372036854775807)", func() (any, error) { return IntToInt64(9223372036854775807) }, int64(9223372036854775807), nil},
+	{"IntToUint(-9223372036854775808)", func() (any, error) { return IntToUint(-9223372036854775808) }, nil, ErrUnderflow},
+	{"IntToUint(-1)", func() (any, error) { return IntToUint(-1) }, nil, ErrUnderflow},
+	{"IntToUint(0)", func() (any, error) { return IntToUint(0) }, uint(0), nil},
+	{"IntToUint(1)", func() (any, error) { return IntToUint(1) }, uint(1), nil},
+	{"IntToUint(42)", func() (any, error) { return IntToUint(42) }, uint(42), nil},
+	{"IntToUint(9223372036854775807)", func() (any, error) { return IntToUint(9223372036854775807) }, uint(9223372036854775807), nil},
+	{"IntToUint8(-9223372036854775808)", func() (any, error) { return IntToUint8(-9223372036854775808) }, nil, ErrUnderflow},
+	{"IntToUint8(-1)", func() (any, error) { return IntToUint8(-1) }, nil, ErrUnderflow},
+	{"IntToUint8(0)", func() (any, error) { return IntToUint8(0) }, uint8(0), nil},
+	{"IntToUint8(1)", func() (any, error) { return IntToUint8(1) }, uint8(1), nil},
+	{"IntToUint8(42)", func() (any, error) { return IntToUint8(42) }, uint8(42), nil},
+	{"IntToUint8(9223372036854775807)", func() (any, error) { return IntToUint8(9223372036854775807) }, nil, ErrOverflow},
+	{"IntToUint16(-9223372036854775808)", func() (any, error) { return IntToUint16(-9223372036854775808) }, nil, ErrUnderflow},
+	{"IntToUint16(-1)", func() (any, error) { return IntToUint16(-1) }, nil, ErrUnderflow},
+	{"IntToUint16(0)", func() (any, error) { return IntToUint16(0) }, uint16(0), nil},
+	{"IntToUint16(1)", func() (any, error) { return IntToUint16(1) }, uint16(1), nil},
+	{"IntToUint16(42)", func() (any, error) { return IntToUint16(42) }, uint16(42), nil},
+	{"IntToUint16(9223372036854775807)", func() (any, error) { return IntToUint16(9223372036854775807) }, nil, ErrOverflow},
+	{"IntToUint32(-9223372036854775808)", func() (any, error) { return IntToUint32(-9223372036854775808) }, nil, ErrUnderflow},
+	{"IntToUint32(-1)", func() (any, error) { return IntToUint32(-1) }, nil, ErrUnderflow},
+	{"IntToUint32(0)", func() (any, error) { return IntToUint32(0) }, uint32(0), nil},
+	{"IntToUint32(1)", func() (any, error) { return IntToUint32(1) }, uint32(1), nil},
+	{"IntToUint32(42)", func() (any, error) { return IntToUint32(42) }, uint32(42), nil},
+	{"IntToUint32(9223372036854775807)", func() (any, error) { return IntToUint32(9223372036854775807) }, nil, ErrOverflow},
+	{"IntToUint64(-9223372036854775808)", func() (any, error) { return IntToUint64(-9223372036854775808) }, nil, ErrUnderflow},
+	{"IntToUint64(-1)", func() (any, error) { return IntToUint64(-1) }, nil, ErrUnderflow},
+	{"IntToUint64(0)", func() (any, error) { return IntToUint64(0) }, uint64(0), nil},
+	{"IntToUint64(1)", func() (any, error) { return IntToUint64(1) }, uint64(1), nil},
+	{"IntToUint64(42)", func() (any, error) { return IntToUint64(42) }, uint64(42), nil},
+	{"IntToUint64(9223372036854775807)", func() (any, error) { return IntToUint64(9223372036854775807) }, uint64(9223372036854775807), nil},
+	{"IntToFloat32(-9223372036854775808)", func() (any, error) { return IntToFloat32(-9223372036854775808) }, float32(-9.223372e+18), nil},
+	{"IntToFloat32(-1)", func() (any, error) { return IntToFloat32(-1) }, float32(-1), nil},
+	{"IntToFloat32(0)", func() (any, error) { return IntToFloat32(0) }, float32(0), nil},
+	{"IntToFloat32(1)", func() (any, error) { return IntToFloat32(1) }, float32(1), nil},
+	{"IntToFloat32(42)", func() (any, error) { return IntToFloat32(42) }, float32(42), nil},
+	{"IntToFloat32(9223372036854775807)", func() (any, error) { return IntToFloat32(9223372036854775807) }, float32(9.223372e+18), nil},
+	{"IntToFloat64(-9223372036854775808)", func() (any, error) { return IntToFloat64(-9223372036854775808) }, float64(-9.223372036854776e+18), nil},
+	{"IntToFloat64(-1)", func() (any, error) { return IntToFloat64(-1) }, float64(-1), nil},
+	{"IntToFloat64(0)", func() (any, error) { return IntToFloat64(0) }, float64(0), nil},
+	{"IntToFloat64(1)", func() (any, error) { return IntToFloat64(1) }, float64(1), nil},
+	{"IntToFloat64(42)", func() (any, error) { return IntToFloat64(42) }, float64(42), nil},
+	{"IntToFloat64(9223372036854775807)", func() (any, error) { return IntToFloat64(9223372036854775807) }, float64(9.223372036854776e+18), nil},
+	{"IntToString(-9223372036854775808)", func() (any, error) { return IntToString(-9223372036854775808) }, "-9223372036854775808", nil},
+	{"IntToString(-1)", func() (any, error) { return IntToString(-1) }, "-1", nil},
+	{"IntToString(0)", func() (any, error) { return IntToString(0) }, "0", nil},
+	{"IntToString(1)", func() (any, error) { return IntToString(1) }, "1", nil},
+	{"IntToString(42)", func() (any, error) { return IntToString(42) }, "42", nil},
+	{"IntToString(9223372036854775807)", func() (any, error) { return IntToString(9223372036854775807) }, "9223372036854775807", nil},
+	{"Int8ToBool(-128)", func() (any, error) { return Int8ToBool(-128) }, true, nil},
+	{"Int8ToBool(-1)", func() (any, error) { return Int8ToBool(-1) }, true, nil},
+	{"Int8ToBool(0)", func() (any, error) { return Int8ToBool(0) }, false, nil},
+	{"Int8ToBool(1)", func() (any, error) { return Int8ToBool(1) }, true, nil},
+	{"Int8ToBool(42)", func() (any, error) { return Int8ToBool(42) }, true, nil},
+	{"Int8ToBool(127)", func() (any, error) { return Int8ToBool(127) }, true, nil},
+	{"Int8ToInt(-128)", func() (any, error) { return Int8ToInt(-128) }, int(-128), nil},
+	{"Int8ToInt(-1)", func() (any, error) { return Int8ToInt(-1) }, int(-1), nil},
+	{"Int8ToInt(0)", func() (any, error) { return Int8ToInt(0) }, int(0), nil},
+	{"Int8ToInt(1)", func() (any, error) { return Int8ToInt(1) }, int(1), nil},
+	{"Int8ToInt(42)", func() (any, error) { return Int8ToInt(42) }, int(42), nil},
+	{"Int8ToInt(127)", func() (any, error) { return Int8ToInt(127) }, int(127), nil},
+	{"Int8ToInt16(-128)", func() (any, error) { return Int8ToInt16(-128) }, int16(-128), nil},
+	{"Int8ToInt16(-1)", func() (any, error) { return Int8ToInt16(-1) }, int16(-1), nil},
+	{"Int8ToInt16(0)", func() (any, error) { return Int8ToInt16(0) }, int16(0), nil},
+	{"Int8ToInt16(1)", func() (any, error) { return Int8ToInt16(1) }, int16(1), nil},
+	{"Int8ToInt16(42)", func() (any, error) { return Int8ToInt16(42) }, int16(42), nil},
+	{"Int8ToInt16(127)", func() (any, error) { return Int8ToInt16(127) }, int16(127), nil},
+	{"Int8ToInt32(-128)", func() (any, error) { return Int8ToInt32(-128) }, int32(-128), nil},
+	{"Int8ToInt32(-1)", func() (any, error) { return Int8ToInt32(-1) }, int32(-1), nil},
+	{"Int8ToInt32(0)", func() (any, error) { return Int8ToInt32(0) }, int32(0), nil},
+	{"Int8ToInt32(1)", func() (any, error) { return Int8ToInt32(1) }, int32(1), nil},
+	{"Int8ToInt32(42)", func() (any, error) { return Int8ToInt32(42) }, int32(42), nil},
+	{"Int8ToInt32(127)", func() (any, error) { return Int8ToInt32(127) }, int32(127), nil},
+	{"Int8ToInt64(-128)", func() (any, error) { return Int8ToInt64(-128) }, int64(-128), nil},
+	{"Int8ToInt64(-1)", func() (any, error) { return Int8ToInt64(-1) }, int64(-1), nil},
+	{"Int8ToInt64(0)", func() (any, error) { return Int8ToInt64(0) }, int64(0), nil},
+	{"Int8ToInt64(1)", func() (any, error) { return Int8ToInt64(1) }, int64(1), nil},
+	{"Int8ToInt64(42)", func() (any, error) { return Int8ToInt64(42) }, int64(42), nil},
+	{"Int8ToInt64(127)", func() (any, error) { return Int8ToInt64(127) }, int64(127), nil},
+	{"Int8ToUint(-128)", func() (any, error) { return Int8ToUint(-128) }, nil, ErrUnderflow},
+	{"Int8ToUint(-1)", func() (any, error) { return Int8ToUint(-1) }, nil, ErrUnderflow},
+	{"Int8ToUint(0)", func() (any, error) { return Int8ToUint(0) }, uint(0), nil},
+	{"Int8ToUint(1)", func() (any, error) { return Int8ToUint(1) }, uint(1), nil},
+	{"Int8ToUint(42)", func() (any, error) { return Int8ToUint(42) }, uint(42), nil},
+	{"Int8ToUint(127)", func() (any, error) { return Int8ToUint(127) }, uint(127), nil},
+	{"Int8ToUint8(-128)", func() (any, error) { return Int8ToUint8(-128) }, nil, ErrUnderflow},
+	{"Int8ToUint8(-1)", func() (any, error) { return Int8ToUint8(-1) }, nil, ErrUnderflow},
+	{"Int8ToUint8(0)", func() (any, error) { return Int8ToUint8(0) }, uint8(0), nil},
+	{"Int8ToUint8(1)", func() (any, error) { return Int8ToUint8(1) }, uint8(1), nil},
+	{"Int8ToUint8(42)", func() (any, error) { return Int8ToUint8(42) }, uint8(42), nil},
+	{"Int8ToUint8(127)", func() (any, error) { return Int8ToUint8(127) }, uint8(127), nil},
+	{"Int8ToUint16(-128)", func() (any, error) { return Int8ToUint16(-128) }, nil, ErrUnderflow},
+	{"Int8ToUint16(-1)", func() (any, error) { return Int8ToUint16(-1) }, nil, ErrUnderflow},
+	{"Int8ToUint16(0)", func() (any, error) { return Int8ToUint16(0) }, uint16(0), nil},
+	{"Int8ToUint16(1)", func() (any, error) { return Int8ToUint16(1) }, uint16(1), nil},
+	{"Int8ToUint16(42)", func() (any, error) { return Int8ToUint16(42) }, uint16(42), nil},
+	{"Int8ToUint16(127)", func() (any, error) { return Int8ToUint16(127) }, uint16(127), nil},
+	{"Int8ToUint32(-128)", func() (any, error) { return Int8ToUint32(-128) }, nil, ErrUnderflow},
+	{"Int8ToUint32(-1)", func() (any, error) { return Int8ToUint32(-1) }, nil, ErrUnderflow},
+	{"Int8ToUint32(0)", func() (any, error) { return Int8ToUint32(0) }, uint32(0), nil},
+	{"Int8ToUint32(1)", func() (any, error) { return Int8ToUint32(1) }, uint32(1), nil},
+	{"Int8ToUint32(42)", func() (any, error) { return Int8ToUint32(42) }, uint32(42), nil},
+	{"Int8ToUint32(127)", func() (any, error) { return Int8ToUint32(127) }, uint32(127), nil},
+	{"Int8ToUint64(-128)", func() (any, error) { return Int8ToUint64(-128) }, nil, ErrUnderflow},
+	{"Int8ToUint64(-1)", func() (any, error) { return Int8ToUint64(-1) }, nil, ErrUnderflow},
+	{"Int8ToUint64(0)", func() (any, error) { return Int8ToUint64(0) }, uint64(0), nil},
+	{"Int8ToUint64(1)", func() (any, error) { return Int8ToUint64(1) }, uint64(1), nil},
+	{"Int8ToUint64(42)", func() (any, error) { return Int8ToUint64(42) }, uint64(42), nil},
+	{"Int8ToUint64(127)", func() (any, error) { return Int8ToUint64(127) }, uint64(127), nil},
+	{"Int8ToFloat32(-128)", func() (any, error) { return Int8ToFloat32(-128) }, float32(-128), nil},
+	{"Int8ToFloat32(-1)", func() (any, error) { return Int8ToFloat32(-1) }, float32(-1), nil},
+	{"Int8ToFloat32(0)", func() (any, error) { return Int8ToFloat32(0) }, float32(0), nil},
+	{"Int8ToFloat32(1)", func() (any, error) { return Int8ToFloat32(1) }, float32(1), nil},
+	{"Int8ToFloat32(42)", func() (any, error) { return Int8ToFloat32(42) }, float32(42), nil},
+	{"Int8ToFloat32(127)", func() (any, error) { return Int8ToFloat32(127) }, float32(127), nil},
+	{"Int8ToFloat64(-128)", func() (any, error) { return Int8ToFloat64(-128) }, float64(-128), nil},
+	{"Int8ToFloat64(-1)", func() (any, error) { return Int8ToFloat64(-1) }, float64(-1), nil},
+	{"Int8ToFloat64(0)", func() (any, error) { return Int8ToFloat64(0) }, float64(0), nil},
+	{"Int8ToFloat64(1)", func() (any, error) { return Int8ToFloat64(1) }, float64(1), nil},
+	{"Int8ToFloat64(42)", func() (any, error) { return Int8ToFloat64(42) }, float64(42), nil},
+	{"Int8ToFloat64(127)", func() (any, error) { return Int8ToFloat64(127) }, float64(127), nil},
+	{"Int8ToString(-128)", func() (any, error) { return Int8ToString(-128) }, "-128", nil},
+	{"Int8ToString(-1)", func() (any, error) { return Int8ToString(-1) }, "-1", nil},
+	{"Int8ToString(0)", func() (any, error) { return Int8ToString(0) }, "0", nil},
+	{"Int8ToString(1)", func() (any, error) { return Int8ToString(1) }, "1", nil},
+	{"Int8ToString(42)", func() (any, error) { return Int8ToString(42) }, "42", nil},
+	{"Int8ToString(127)", func() (any, error) { return Int8ToString(127) }, "127", nil},
+	{"Int16ToBool(-32768)", func() (any, error) { return Int16ToBool(-32768) }, true, nil},
+	{"Int16ToBool(-1)", func() (any, error) { return Int16ToBool(-1) }, true, nil},
+	{"Int16ToBool(0)", func() (any, error) { return Int16ToBool(0) }, false, nil},
+	{"Int16ToBool(1)", func() (any, error) { return Int16ToBool(1) }, true, nil},
+	{"Int16ToBool(42)", func() (any, error) { return Int16ToBool(42) }, true, nil},
+	{"Int16ToBool(32767)", func() (any, error) { return Int16ToBool(32767) }, true, nil},
+	{"Int16ToInt(-32768)", func() (any, error) { return Int16ToInt(-32768) }, int(-32768), nil},
+	{"Int16ToInt(-1)", func() (any, error) { return Int16ToInt(-1) }, int(-1), nil},
+	{"Int16ToInt(0)", func() (any, error) { return Int16ToInt(0) }, int(0), nil},
+	{"Int16ToInt(1)", func() (any, error) { return Int16ToInt(1) }, int(1), nil},
+	{"Int16ToInt(42)", func() (any, error) { return Int16ToInt(42) }, int(42), nil},
+	{"Int16ToInt(32767)", func() (any, error) { return Int16ToInt(32767) }, int(32767), nil},
+	{"Int16ToInt8(-32768)", func() (any, error) { return Int16ToInt8(-32768) }, nil, ErrUnderflow},
+	{"Int16ToInt8(-1)", func() (any, error) { return Int16ToInt8(-1) }, int8(-1), nil},
+	{"Int16ToInt8(0)", func() (any, error) { return Int16ToInt8(0) }, int8(0), nil},
+	{"Int16ToInt8(1)", func() (any, error) { return Int16ToInt8(1) }, int8(1), nil},
+	{"Int16ToInt8(42)", func() (any, error) { return Int16ToInt8(42) }, int8(42), nil},
+	{"Int16ToInt8(32767)", func() (any, error) { return Int16ToInt8(32767) }, nil, ErrOverflow},
+	{"Int16ToInt32(-32768)", func() (any, error) { return Int16ToInt32(-32768) }, int32(-32768), nil},
+	{"Int16ToInt32(-1)", func() (any, error) { return Int16ToInt32(-1) }, int32(-1), nil},
+	{"Int16ToInt32(0)", func() (any, error) { return Int16ToInt32(0) }, int32(0), nil},
+	{"Int16ToInt32(1)", func() (any, error) { return Int16ToInt32(1) }, int32(1), nil},
+	{"Int16ToInt32(42)", func() (any, error) { return Int16ToInt32(42) }, int32(42), nil},
+	{"Int16ToInt32(32767)", func() (any, error) { return Int16ToInt32(32767) }, int32(32767), nil},
+	{"Int16ToInt64(-32768)", func() (any, error) { return Int16ToInt64(-32768) }, int64(-32768), nil},
+	{"Int16ToInt64(-1)", func() (any, error) { return Int16ToInt64(-1) }, int64(-1), nil},
+	{"Int16ToInt64(0)", func() (any, error) { return Int16ToInt64(0) }, int64(0), nil},
+	{"Int16ToInt64(1)", func() (any, error) { return Int16ToInt64(1) }, int64(1), nil},
+	{"Int16ToInt64(42)", func() (any, error) { return Int16ToInt64(42) }, int64(42), nil},
+	{"Int16ToInt64(32767)", func() (any, error) { return Int16ToInt64(32767) }, int64(32767), nil},
+	{"Int16ToUint(-32768)", func() (any, error) { return Int16ToUint(-32768) }, nil, ErrUnderflow},
+	{"Int16ToUint(-1)", func() (any, error) { return Int16ToUint(-1) }, nil, ErrUnderflow},
+	{"Int16ToUint(0)", func() (any, error) { return Int16ToUint(0) }, uint(0), nil},
+	{"Int16ToUint(1)", func() (any, error) { return Int16ToUint(1) }, uint(1), nil},
+	{"Int16ToUint(42)", func() (any, error) { return Int16ToUint(42) }, uint(42), nil},
+	{"Int16ToUint(32767)", func() (any, error) { return Int16ToUint(32767) }, uint(32767), nil},
+	{"Int16ToUint8(-32768)", func() (any, error) { return Int16ToUint8(-32768) }, nil, ErrUnderflow},
+	{"Int16ToUint8(-1)", func() (any, error) { return Int16ToUint8(-1) }, nil, ErrUnderflow},
+	{"Int16ToUint8(0)", func() (any, error) { return Int16ToUint8(0) }, uint8(0), nil},
+	{"Int16ToUint8(1)", func() (any, error) { return Int16ToUint8(1) }, uint8(1), nil},
+	{"Int16ToUint8(42)", func() (any, error) { return Int16ToUint8(42) }, uint8(42), nil},
+	{"Int16ToUint8(32767)", func() (any, error) { return Int16ToUint8(32767) }, nil, ErrOverflow},
+	{"Int16ToUint16(-32768)", func() (any, error) { return Int16ToUint16(-32768) }, nil, ErrUnderflow},
+	{"Int16ToUint16(-1)", func() (any, error) { return Int16ToUint16(-1) }, nil, ErrUnderflow},
+	{"Int16ToUint16(0)", func() (any, error) { return Int16ToUint16(0) }, uint16(0), nil},
+	{"Int16ToUint16(1)", func() (any, error) { return Int16ToUint16(1) }, uint16(1), nil},
+	{"Int16ToUint16(42)", func() (any, error) { return Int16ToUint16(42) }, uint16(42), nil},
+	{"Int16ToUint16(32767)", func() (any, error) { return Int16ToUint16(32767) }, uint16(32767), nil},
+	{"Int16ToUint32(-32768)", func() (any, error) { return Int16ToUint32(-32768) }, nil, ErrUnderflow},
+	{"Int16ToUint32(-1)", func() (any, error) { return Int16ToUint32(-1) }, nil, ErrUnderflow},
+	{"Int16ToUint32(0)", func() (any, error) { return Int16ToUint32(0) }, uint32(0), nil},
+	{"Int16ToUint32(1)", func() (any, error) { return Int16ToUint32(1) }, uint32(1), nil},
+	{"Int16ToUint32(42)", func() (any, error) { return Int16ToUint32(42) }, uint32(42), nil},
+	{"Int16ToUint32(32767)", func() (any, error) { return Int16ToUint32(32767) }, uint32(32767), nil},
+	{"Int16ToUint64(-32768)", func() (any, error) { return Int16ToUint64(-32768) }, nil, ErrUnderflow},
+	{"Int16ToUint64(-1)", func() (any, error) { return Int16ToUint64(-1) }, nil, ErrUnderflow},
+	{"Int16ToUint64(0)", func() (any, error) { return Int16ToUint64(0) }, uint64(0), nil},
+	{"Int16ToUint64(1)", func() (any, error) { return Int16ToUint64(1) }, uint64(1), nil},
+	{"Int16ToUint64(42)", func() (any, error) { return Int16ToUint64(42) }, uint64(42), nil},
+	{"Int16ToUint64(32767)", func() (any, error) { return Int16ToUint64(32767) }, uint64(32767), nil},
+	{"Int16ToFloat32(-32768)", func() (any, error) { return Int16ToFloat32(-32768) }, float32(-32768), nil},
+	{"Int16ToFloat32(-1)", func() (any, error) { return Int16ToFloat32(-1) }, float32(-1), nil},
+	{"Int16ToFloat32(0)", func() (any, error) { return Int16ToFloat32(0) }, float32(0), nil},
+	{"Int16ToFloat32(1)", func() (any, error) { return Int16ToFloat32(1) }, float32(1), nil},
+	{"Int16ToFloat32(42)", func() (any, error) { return Int16ToFloat32(42) }, float32(42), nil},
+	{"Int16ToFloat32(32767)", func() (any, error) { return Int16ToFloat32(32767) }, float32(32767), nil},
+	{"Int16ToFloat64(-32768)", func() (any, error) { return Int16ToFloat64(-32768) }, float64(-32768), nil},
+	{"Int16ToFloat64(-1)", func() (any, error) { return Int16ToFloat64(-1) }, float64(-1), nil},
+	{"Int16ToFloat64(0)", func() (any, error) { return Int16ToFloat64(0) }, float64(0), nil},
+	{"Int16ToFloat64(1)", func() (any, error) { return Int16ToFloat64(1) }, float64(1), nil},
+	{"Int16ToFloat64(42)", func() (any, error) { return Int16ToFloat64(42) }, float64(42), nil},
+	{"Int16ToFloat64(32767)", func() (any, error) { return Int16ToFloat64(32767) }, float64(32767), nil},
+	{"Int16ToString(-32768)", func() (any, error) { return Int16ToString(-32768) }, "-32768", nil},
+	{"Int16ToString(-1)", func() (any, error) { return Int16ToString(-1) }, "-1", nil},
+	{"Int16ToString(0)", func() (any, error) { return Int16ToString(0) }, "0", nil},
+	{"Int16ToString(1)", func() (any, error) { return Int16ToString(1) }, "1", nil},
+	{"Int16ToString(42)", func() (any, error) { return Int16ToString(42) }, "42", nil},
+	{"Int16ToString(32767)", func() (any, error) { return Int16ToString(32767) }, "32767", nil},
+	{"Int32ToBool(-2147483648)", func() (any, error) { return Int32ToBool(-2147483648) }, true, nil},
+	{"Int32ToBool(-1)", func() (any, error) { return Int32ToBool(-1) }, true, nil},
+	{"Int32ToBool(0)", func() (any, error) { return Int32ToBool(0) }, false, nil},
+	{"Int32ToBool(1)", func() (any, error) { return Int32ToBool(1) }, true, nil},
+	{"Int32ToBool(42)", func() (any, error) { return Int32ToBool(42) }, true, nil},
+	{"Int32ToBool(2147483647)", func() (any, error) { return Int32ToBool(2147483647) }, true, nil},
+	{"Int32ToInt(-2147483648)", func() (any, error) { return Int32ToInt(-2147483648) }, int(-2147483648), nil},
+	{"Int32ToInt(-1)", func() (any, error) { return Int32ToInt(-1) }, int(-1), nil},
+	{"Int32ToInt(0)", func() (any, error) { return Int32ToInt(0) }, int(0), nil},
+	{"Int32ToInt(1)", func() (any, error) { return Int32ToInt(1) }, int(1), nil},
+	{"Int32ToInt(42)", func() (any, error) { return Int32ToInt(42) }, int(42), nil},
+	{"Int32ToInt(2147483647)", func() (any, error) { return Int32ToInt(2147483647) }, int(2147483647), nil},
+	{"Int32ToInt8(-2147483648)", func() (any, error) { return Int32ToInt8(-2147483648) }, nil, ErrUnderflow},
+	{"Int32ToInt8(-1)", func() (any, error) { return Int32ToInt8(-1) }, int8(-1), nil},
+	{"Int32ToInt8(0)", func() (any, error) { return Int32ToInt8(0) }, int8(0), nil},
+	{"Int32ToInt8(1)", func() (any, error) { return Int32ToInt8(1) }, int8(1), nil},
+	{"Int32ToInt8(42)", func() (any, error) { return Int32ToInt8(42) }, int8(42), nil},
+	{"Int32ToInt8(2147483647)", func() (any, error) { return Int32ToInt8(2147483647) }, nil, ErrOverflow},
+	{"Int32ToInt16(-2147483648)", func() (any, error) { return Int32ToInt16(-2147483648) }, nil, ErrUnderflow},
+	{"Int32ToInt16(-1)", func() (any, error) { return Int32ToInt16(-1) }, int16(-1), nil},
+	{"Int32ToInt16(0)", func() (any, error) { return Int32ToInt16(0) }, int16(0), nil},
+	{"Int32ToInt16(1)", func() (any, error) { return Int32ToInt16(1) }, int16(1), nil},
+	{"Int32ToInt16(42)", func() (any, error) { return Int32ToInt16(42) }, int16(42), nil},
+	{"Int32ToInt16(2147483647)", func() (any, error) { return Int32ToInt16(2147483647) }, nil, ErrOverflow},
+	{"Int32ToInt64(-2147483648)", func() (any, error) { return Int32ToInt64(-2147483648) }, int64(-2147483648), nil},
+	{"Int32ToInt64(-1)", func() (any, error) { return Int32ToInt64(-1) }, int64(-1), nil},
+	{"Int32ToInt64(0)", func() (any, error) { return Int32ToInt64(0) }, int64(0), nil},
+	{"Int32ToInt64(1)", func() (any, error) { return Int32ToInt64(1) }, int64(1), nil},
+	{"Int32ToInt64(42)", func() (any, error) { return Int32ToInt64(42) }, int64(42), nil},
+	{"Int32ToInt64(2147483647)", func() (any, error) { return Int32ToInt64(2147483647) }, int64(2147483647), nil},
+	{"Int32ToUint(-2147483648)", func() (any, error) { return Int32ToUint(-2147483648) }, nil, ErrUnderflow},
+	{"Int32ToUint(-1)", func() (any, error) { return Int32ToUint(-1) }, nil, ErrUnderflow},
+	{"Int32ToUint(0)", func() (any, error) { return Int32ToUint(0) }, uint(0), nil},
+	{"Int32ToUint(1)", func() (any, error) { return Int32ToUint(1) }, uint(1), nil},
+	{"Int32ToUint(42)", func() (any, error) { return Int32ToUint(42) }, uint(42), nil},
+	{"Int32ToUint(2147483647)", func() (any, error) { return Int32ToUint(2147483647) }, uint(2147483647), nil},
+	{"Int32ToUint8(-2147483648)", func() (any, error) { return Int32ToUint8(-2147483648) }, nil, ErrUnderflow},
+	{"Int32ToUint8(-1)", func() (any, error) { return Int32ToUint8(-1) }, nil, ErrUnderflow},
+	{"Int32ToUint8(0)", func() (any, error) { return Int32ToUint8(0) }, uint8(0), nil},
+	{"Int32ToUint8(1)", func() (any, error) { return Int32ToUint8(1) }, uint8(1), nil},
+	{"Int32ToUint8(42)", func() (any, error) { return Int32ToUint8(42) }, uint8(42), nil},
+	{"Int32ToUint8(2147483647)", func() (any, error) { return Int32ToUint8(2147483647) }, nil, ErrOverflow},
+	{"Int32ToUint16(-2147483648)", func() (any, error) { return Int32ToUint16(-2147483648) }, nil, ErrUnderflow},
+	{"Int32ToUint16(-1)", func() (any, error) { return Int32ToUint16(-1) }, nil, ErrUnderflow},
+	{"Int32ToUint16(0)", func() (any, error) { return Int32ToUint16(0) }, uint16(0), nil},
+	{"Int32ToUint16(1)", func() (any, error) { return Int32ToUint16(1) }, uint16(1), nil},
+	{"Int32ToUint16(42)", func() (any, error) { return Int32ToUint16(42) }, uint16(42), nil},
+	{"Int32ToUint16(2147483647)", func() (any, error) { return Int32ToUint16(2147483647) }, nil, ErrOverflow},
+	{"Int32ToUint32(-2147483648)", func() (any, error) { return Int32ToUint32(-2147483648) }, nil, ErrUnderflow},
+	{"Int32ToUint32(-1)", func() (any, error) { return Int32ToUint32(-1) }, nil, ErrUnderflow},
+	{"Int32ToUint32(0)", func() (any, error) { return Int32ToUint32(0) }, uint32(0), nil},
+	{"Int32ToUint32(1)", func() (any, error) { return Int32ToUint32(1) }, uint32(1), nil},
+	{"Int32ToUint32(42)", func() (any, error) { return Int32ToUint32(42) }, uint32(42), nil},
+	{"Int32ToUint32(2147483647)", func() (any, error) { return Int32ToUint32(2147483647) }, uint32(2147483647), nil},
+	{"Int32ToUint64(-2147483648)", func() (any, error) { return Int32ToUint64(-2147483648) }, nil, ErrUnderflow},
+	{"Int32ToUint64(-1)", func() (any, error) { return Int32ToUint64(-1) }, nil, ErrUnderflow},
+	{"Int32ToUint64(0)", func() (any, error) { return Int32ToUint64(0) }, uint64(0), nil},
+	{"Int32ToUint64(1)", func() (any, error) { return Int32ToUint64(1) }, uint64(1), nil},
+	{"Int32ToUint64(42)", func() (any, error) { return Int32ToUint64(42) }, uint64(42), nil},
+	{"Int32ToUint64(2147483647)", func() (any, error) { return Int32ToUint64(2147483647) }, uint64(2147483647), nil},
+	{"Int32ToFloat32(-2147483648)", func() (any, error) { return Int32ToFloat32(-2147483648) }, float32(-2.1474836e+09), nil},
+	{"Int32ToFloat32(-1)", func() (any, error) { return Int32ToFloat32(-1) }, float32(-1), nil},
+	{"Int32ToFloat32(0)", func() (any, error) { return Int32ToFloat32(0) }, float32(0), nil},
+	{"Int32ToFloat32(1)", func() (any, error) { return Int32ToFloat32(1) }, float32(1), nil},
+	{"Int32ToFloat32(42)", func() (any, error) { return Int32ToFloat32(42) }, float32(42), nil},
+	{"Int32ToFloat32(2147483647)", func() (any, error) { return Int32ToFloat32(2147483647) }, float32(2.1474836e+09), nil},
+	{"Int32ToFloat64(-2147483648)", func() (any, error) { return Int32ToFloat64(-2147483648) }, float64(-2.147483648e+09), nil},
+	{"Int32ToFloat64(-1)", func() (any, error) { return Int32ToFloat64(-1) }, float64(-1), nil},
+	{"Int32ToFloat64(0)", func() (any, error) { return Int32ToFloat64(0) }, float64(0), nil},
+	{"Int32ToFloat64(1)", func() (any, error) { return Int32ToFloat64(1) }, float64(1), nil},
+	{"Int32ToFloat64(42)", func() (any, error) { return Int32ToFloat64(42) }, float64(42), nil},
+	{"Int32ToFloat64(2147483647)", func() (any, error) { return Int32ToFloat64(2147483647) }, float64(2.147483647e+09), nil},
+	{"Int32ToString(-2147483648)", func() (any, error) { return Int32ToString(-2147483648) }, "-2147483648", nil},
+	{"Int32ToString(-1)", func() (any, error) { return Int32ToString(-1) }, "-1", nil},
+	{"Int32ToString(0)", func() (any, error) { return Int32ToString(0) }, "0", nil},
+	{"Int32ToString(1)", func() (any, error) { return Int32ToString(1) }, "1", nil},
+	{"Int32ToString(42)", func() (any, error) { return Int32ToString(42) }, "42", nil},
+	{"Int32ToString(2147483647)", func() (any, error) { return Int32ToString(2147483647) }, "2147483647", nil},
+	{"Int64ToBool(-9223372036854775808)", func() (any, error) { return Int64ToBool(-9223372036854775808) }, true, nil},
+	{"Int64ToBool(-1)", func() (any, error) { return Int64ToBool(-1) }, true, nil},
+	{"Int64ToBool(0)", func() (any, error) { return Int64ToBool(0) }, false, nil},
+	{"Int64ToBool(1)", func() (any, error) { return Int64ToBool(1) }, true, nil},
+	{"Int64ToBool(42)", func() (any, error) { return Int64ToBool(42) }, true, nil},
+	{"Int64ToBool(9223372036854775807)", func() (any, error) { return Int64ToBool(9223372036854775807) }, true, nil},
+	{"Int64ToInt(-9223372036854775808)", func() (any, error) { return Int64ToInt(-9223372036854775808) }, int(-9223372036854775808), nil},
+	{"Int64ToInt(-1)", func() (any, error) { return Int64ToInt(-1) }, int(-1), nil},
+	{"Int64ToInt(0)", func() (any, error) { return Int64ToInt(0) }, int(0), nil},
+	{"Int64ToInt(1)", func() (any, error) { return Int64ToInt(1) }, int(1), nil},
+	{"Int64ToInt(42)", func() (any, error) { return Int64ToInt(42) }, int(42), nil},
+	{"Int64ToInt(9223372036854775807)", func() (any, error) { return Int64ToInt(9223372036854775807) }, int(9223372036854775807), nil},
+	{"Int64ToInt8(-9223372036854775808)", func() (any, error) { return Int64ToInt8(-9223372036854775808) }, nil, ErrUnderflow},
+	{"Int64ToInt8(-1)", func() (any, error) { return Int64ToInt8(-1) }, int8(-1), nil},
+	{"Int64ToInt8(0)", func() (any, error) { return Int64ToInt8(0) }, int8(0), nil},
+	{"Int64ToInt8(1)", func() (any, error) { return Int64ToInt8(1) }, int8(1), nil},
+	{"Int64ToInt8(42)", func() (any, error) { return Int64ToInt8(42) }, int8(42), nil},
+	{"Int64ToInt8(9223372036854775807)", func() (any, error) { return Int64ToInt8(9223372036854775807) }, nil, ErrOverflow},
+	{"Int64ToInt16(-9223372036854775808)", func() (any, error) { return Int64ToInt16(-9223372036854775808) }, nil, ErrUnderflow},
+	{"Int64ToInt16(-1)", func() (any, error) { return Int64ToInt16(-1) }, int16(-1), nil},
+	{"Int64ToInt16(0)", func() (any, error) { return Int64ToInt16(0) }, int16(0), nil},
+	{"Int64ToInt16(1)", func() (any, error) { return Int64ToInt16(1) }, int16(1), nil},
+	{"Int64ToInt16(42)", func() (any, error) { return Int64ToInt16(42) }, int16(42), nil},
+	{"Int64ToInt16(9223372036854775807)", func() (any, error) { return Int64ToInt16(9223372036854775807) }, nil, ErrOverflow},
+	{"Int64ToInt32(-9223372036854775808)", func() (any, error) { return Int64ToInt32(-9223372036854775808) }, nil, ErrUnderflow},
+	{"Int64ToInt32(-1)", func() (any, error) { return Int64ToInt32(-1) }, int32(-1), nil},
+	{"Int64ToInt32(0)", func() (any, error) { return Int64ToInt32(0) }, int32(0), nil},
+	{"Int64ToInt32(1)", func() (any, error) { return Int64ToInt32(1) }, int32(1), nil},
+	{"Int64ToInt32(42)", func() (any, error) { return Int64ToInt32(42) }, int32(42), nil},
+	{"Int64ToInt32(9223372036854775807)", func() (any, error) { return Int64ToInt32(9223372036854775807) }, nil, ErrOverflow},
+	{"Int64ToUint(-9223372036854775808)", func() (any, error) { return Int64ToUint(-9223372036854775808) }, nil, ErrUnderflow},
+	{"Int64ToUint(-1)", func() (any, error) { return Int64ToUint(-1) }, nil, ErrUnderflow},
+	{"Int64ToUint(0)", func() (any, error) { return Int64ToUint(0) }, uint(0), nil},
+	{"Int64ToUint(1)", func() (any, error) { return Int64ToUint(1) }, uint(1), nil},
+	{"Int64ToUint(42)", func() (any, error) { return Int64ToUint(42) }, uint(42), nil},
+	{"Int64ToUint(9223372036854775807)", func() (any, error) { return Int64ToUint(9223372036854775807) }, uint(9223372036854775807), nil},
+	{"Int64ToUint8(-9223372036854775808)", func() (any, error) { return Int64ToUint8(-9223372036854775808) }, nil, ErrUnderflow},
+	{"Int64ToUint8(-1)", func() (any, error) { return Int64ToUint8(-1) }, nil, ErrUnderflow},
+	{"Int64ToUint8(0)", func() (any, error) { return Int64ToUint8(0) }, uint8(0), nil},
+	{"Int64ToUint8(1)", func() (any, error) { return Int64ToUint8(1) }, uint8(1), nil},
+	{"Int64ToUint8(42)", func() (any, error) { return Int64ToUint8(42) }, uint8(42), nil},
+	{"Int64ToUint8(9223372036854775807)", func() (any, error) { return Int64ToUint8(9223372036854775807) }, nil, ErrOverflow},
+	{"Int64ToUint16(-9223372036854775808)", func() (any, error) { return Int64ToUint16(-9223372036854775808) }, nil, ErrUnderflow},
+	{"Int64ToUint16(-1)", func() (any, error) { return Int64ToUint16(-1) }, nil, ErrUnderflow},
+	{"Int64ToUint16(0)", func() (any, error) { return Int64ToUint16(0) }, uint16(0), nil},
+	{"Int64ToUint16(1)", func() (any, error) { return Int64ToUint16(1) }, uint16(1), nil},
+	{"Int64ToUint16(42)", func() (any, error) { return Int64ToUint16(42) }, uint16(42), nil},
+	{"Int64ToUint16(9223372036854775807)", func() (any, error) { return Int64ToUint16(9223372036854775807) }, nil, ErrOverflow},
+	{"Int64ToUint32(-9223372036854775808)", func() (any, error) { return Int64ToUint32(-9223372036854775808) }, nil, ErrUnderflow},
+	{"Int64ToUint32(-1)", func() (any, error) { return Int64ToUint32(-1) }, nil, ErrUnderflow},
+	{"Int64ToUint32(0)", func() (any, error) { return Int64ToUint32(0) }, uint32(0), nil},
+	{"Int64ToUint32(1)", func() (any, error) { return Int64ToUint32(1) }, uint32(1), nil},
+	{"Int64ToUint32(42)", func() (any, error) { return Int64ToUint32(42) }, uint32(42), nil},
+	{"Int64ToUint32(9223372036854775807)", func() (any, error) { return Int64ToUint32(9223372036854775807) }, nil, ErrOverflow},
+	{"Int64ToUint64(-9223372036854775808)", func() (any, error) { return Int64ToUint64(-9223372036854775808) }, nil, ErrUnderflow},
+	{"Int64ToUint64(-1)", func() (any, error) { return Int64ToUint64(-1) }, nil, ErrUnderflow},
+	{"Int64ToUint64(0)", func() (any, error) { return Int64ToUint64(0) }, uint64(0), nil},
+	{"Int64ToUint64(1)", func() (any, error) { return Int64ToUint64(1) }, uint64(1), nil},
+	{"Int64ToUint64(42)", func() (any, error) { return Int64ToUint64(42) }, uint64(42), nil},
+	{"Int64ToUint64(9223372036854775807)", func() (any, error) { return Int64ToUint64(9223372036854775807) }, uint64(9223372036854775807), nil},
+	{"Int64ToFloat32(-9223372036854775808)", func() (any, error) { return Int64ToFloat32(-9223372036854775808) }, float32(-9.223372e+18), nil},
+	{"Int64ToFloat32(-1)", func() (any, error) { return Int64ToFloat32(-1) }, float32(-1), nil},
+	{"Int64ToFloat32(0)", func() (any, error) { return Int64ToFloat32(0) }, float32(0), nil},
+	{"Int64ToFloat32(1)", func() (any, error) { return Int64ToFloat32(1) }, float32(1), nil},
+	{"Int64ToFloat32(42)", func() (any, error) { return Int64ToFloat32(42) }, float32(42), nil},
+	{"Int64ToFloat32(9223372036854775807)", func() (any, error) { return Int64ToFloat32(9223372036854775807) }, float32(9.223372e+18), nil},
+	{"Int64ToFloat64(-9223372036854775808)", func() (any, error) { return Int64ToFloat64(-9223372036854775808) }, float64(-9.223372036854776e+18), nil},
+	{"Int64ToFloat64(-1)", func() (any, error) { return Int64ToFloat64(-1) }, float64(-1), nil},
+	{"Int64ToFloat64(0)", func() (any, error) { return Int64ToFloat64(0) }, float64(0), nil},
+	{"Int64ToFloat64(1)", func() (any, error) { return Int64ToFloat64(1) }, float64(1), nil},
+	{"Int64ToFloat64(42)", func() (any, error) { return Int64ToFloat64(42) }, float64(42), nil},
+	{"Int64ToFloat64(9223372036854775807)", func() (any, error) { return Int64ToFloat64(9223372036854775807) }, float64(9.223372036854776e+18), nil},
+	{"Int64ToString(-9223372036854775808)", func() (any, error) { return Int64ToString(-9223372036854775808) }, "-9223372036854775808", nil},
+	{"Int64ToString(-1)", func() (any, error) { return Int64ToString(-1) }, "-1", nil},
+	{"Int64ToString(0)", func() (any, error) { return Int64ToString(0) }, "0", nil},
+	{"Int64ToString(1)", func() (any, error) { return Int64ToString(1) }, "1", nil},
+	{"Int64ToString(42)", func() (any, error) { return Int64ToString(42) }, "42", nil},
+	{"Int64ToString(9223372036854775807)", func() (any, error) { return Int64ToString(9223372036854775807) }, "9223372036854775807", nil},
+	{"UintToBool(0)", func() (any, error) { return UintToBool(0) }, false, nil},
+	{"UintToBool(0)", func() (any, error) { return UintToBool(0) }, false, nil},
+	{"UintToBool(1)", func() (any, error) { return UintToBool(1) }, true, nil},
+	{"UintToBool(42)", func() (any, error) { return UintToBool(42) }, true, nil},
+	{"UintToBool(18446744073709551615)", func() (any, error) { return UintToBool(18446744073709551615) }, true, nil},
+	{"UintToInt(0)", func() (any, error) { return UintToInt(0) }, int(0), nil},
+	{"UintToInt(0)", func() (any, error) { return UintToInt(0) }, int(0), nil},
+	{"UintToInt(1)", func() (any, error) { return UintToInt(1) }, int(1), nil},
+	{"UintToInt(42)", func() (any, error) { return UintToInt(42) }, int(42), nil},
+	{"UintToInt(18446744073709551615)", func() (any, error) { return UintToInt(18446744073709551615) }, nil, ErrOverflow},
+	{"UintToInt8(0)", func() (any, error) { return UintToInt8(0) }, int8(0), nil},
+	{"UintToInt8(0)", func() (any, error) { return UintToInt8(0) }, int8(0), nil},
+	{"UintToInt8(1)", func() (any, error) { return UintToInt8(1) }, int8(1), nil},
+	{"UintToInt8(42)", func() (any, error) { return UintToInt8(42) }, int8(42), nil},
+	{"UintToInt8(18446744073709551615)", func() (any, error) { return UintToInt8(18446744073709551615) }, nil, ErrOverflow},
+	{"UintToInt16(0)", func() (any, error) { return UintToInt16(0) }, int16(0), nil},
+	{"UintToInt16(0)", func() (any, error) { return UintToInt16(0) }, int16(0), nil},
+	{"UintToInt16(1)", func() (any, error) { return UintToInt16(1) }, int16(1), nil},
+	{"UintToInt16(42)", func() (any, error) { return UintToInt16(42) }, int16(42), nil},
+	{"UintToInt16(18446744073709551615)", func() (any, error) { return UintToInt16(18446744073709551615) }, nil, ErrOverflow},
+	{"UintToInt32(0)", func() (any, error) { return UintToInt32(0) }, int32(0), nil},
+	{"UintToInt32(0)", func() (any, error) { return UintToInt32(0) }, int32(0), nil},
+	{"UintToInt32(1)", func() (any, error) { return UintToInt32(1) }, int32(1), nil},
+	{"UintToInt32(42)", func() (any, error) { return UintToInt32(42) }, int32(42), nil},
+	{"UintToInt32(18446744073709551615)", func() (any, error) { return UintToInt32(18446744073709551615) }, nil, ErrOverflow},
+	{"UintToInt64(0)", func() (any, error) { return UintToInt64(0) }, int64(0), nil},
+	{"UintToInt64(0)", func() (any, error) { return UintToInt64(0) }, int64(0), nil},
+	{"UintToInt64(1)", func() (any, error) { return UintToInt64(1) }, int64(1), nil},
+	{"UintToInt64(42)", func() (any, error) { return UintToInt64(42) }, int64(42), nil},
+	{"UintToInt64(18446744073709551615)", func() (any, error) { return UintToInt64(18446744073709551615) }, nil, ErrOverflow},
+	{"UintToUint8(0)", func() (any, error) { return UintToUint8(0) }, uint8(0), nil},
+	{"UintToUint8(0)", func() (any, error) { return UintToUint8(0) }, uint8(0), nil},
+	{"UintToUint8(1)", func() (any, error) { return UintToUint8(1) }, uint8(1), nil},
+	{"UintToUint8(42)", func() (any, error) { return UintToUint8(42) }, uint8(42), nil},
+	{"UintToUint8(18446744073709551615)", func() (any, error) { return UintToUint8(18446744073709551615) }, nil, ErrOverflow},
+	{"UintToUint16(0)", func() (any, error) { return UintToUint16(0) }, uint16(0), nil},
+	{"UintToUint16(0)", func() (any, error) { return UintToUint16(0) }, uint16(0), nil},
+	{"UintToUint16(1)", func() (any, error) { return UintToUint16(1) }, uint16(1), nil},
+	{"UintToUint16(42)", func() (any, error) { return UintToUint16(42) }, uint16(42), nil},
+	{"UintToUint16(18446744073709551615)", func() (any, error) { return UintToUint16(18446744073709551615) }, nil, ErrOverflow},
+	{"UintToUint32(0)", func() (any, error) { return UintToUint32(0) }, uint32(0), nil},
+	{"UintToUint32(0)", func() (any, error) { return UintToUint32(0) }, uint32(0), nil},
+	{"UintToUint32(1)", func() (any, error) { return UintToUint32(1) }, uint32(1), nil},
+	{"UintToUint32(42)", func() (any, error) { return UintToUint32(42) }, uint32(42), nil},
+	{"UintToUint32(18446744073709551615)", func() (any, error) { return UintToUint32(18446744073709551615) }, nil, ErrOverflow},
+	{"UintToUint64(0)", func() (any, error) { return UintToUint64(0) }, uint64(0), nil},
+	{"UintToUint64(0)", func() (any, error) { return UintToUint64(0) }, uint64(0), nil},
+	{"UintToUint64(1)", func() (any, error) { return UintToUint64(1) }, uint64(1), nil},
+	{"UintToUint64(42)", func() (any, error) { return UintToUint64(42) }, uint64(42), nil},
+	{"UintToUint64(18446744073709551615)", func() (any, error) { return UintToUint64(18446744073709551615) }, uint64(18446744073709551615), nil},
+	{"UintToFloat32(0)", func() (any, error) { return UintToFloat32(0) }, float32(0), nil},
+	{"UintToFloat32(0)", func() (any, error) { return UintToFloat32(0) }, float32(0), nil},
+	{"UintToFloat32(1)", func() (any, error) { return UintToFloat32(1) }, float32(1), nil},
+	{"UintToFloat32(42)", func() (any, error) { return UintToFloat32(42) }, float32(42), nil},
+	{"UintToFloat32(18446744073709551615)", func() (any, error) { return UintToFloat32(18446744073709551615) }, float32(1.8446744e+19), nil},
+	{"UintToFloat64(0)", func() (any, error) { return UintToFloat64(0) }, float64(0), nil},
+	{"UintToFloat64(0)", func() (any, error) { return UintToFloat64(0) }, float64(0), nil},
+	{"UintToFloat64(1)", func() (any, error) { return UintToFloat64(1) }, float64(1), nil},
+	{"UintToFloat64(42)", func() (any, error) { return UintToFloat64(42) }, float64(42), nil},
+	{"UintToFloat64(18446744073709551615)", func() (any, error) { return UintToFloat64(18446744073709551615) }, float64(1.8446744073709552e+19), nil},
+	{"UintToString(0)", func() (any, error) { return UintToString(0) }, "0", nil},
+	{"UintToString(0)", func() (any, error) { return UintToString(0) }, "0", nil},
+	{"UintToString(1)", func() (any, error) { return UintToString(1) }, "1", nil},
+	{"UintToString(42)", func() (any, error) { return UintToString(42) }, "42", nil},
+	{"UintToString(18446744073709551615)", func() (any, error) { return UintToString(18446744073709551615) }, "18446744073709551615", nil},
+	{"Uint8ToBool(0)", func() (any, error) { return Uint8ToBool(0) }, false, nil},
+	{"Uint8ToBool(0)", func() (any, error) { return Uint8ToBool(0) }, false, nil},
+	{"Uint8ToBool(1)", func() (any, error) { return Uint8ToBool(1) }, true, nil},
+	{"Uint8ToBool(42)", func() (any, error) { return Uint8ToBool(42) }, true, nil},
+	{"Uint8ToBool(255)", func() (any, error) { return Uint8ToBool(255) }, true, nil},
+	{"Uint8ToInt(0)", func() (any, error) { return Uint8ToInt(0) }, int(0), nil},
+	{"Uint8ToInt(0)", func() (any, error) { return Uint8ToInt(0) }, int(0), nil},
+	{"Uint8ToInt(1)", func() (any, error) { return Uint8ToInt(1) }, int(1), nil},
+	{"Uint8ToInt(42)", func() (any, error) { return Uint8ToInt(42) }, int(42), nil},
+	{"Uint8ToInt(255)", func() (any, error) { return Uint8ToInt(255) }, int(255), nil},
+	{"Uint8ToInt8(0)", func() (any, error) { return Uint8ToInt8(0) }, int8(0), nil},
+	{"Uint8ToInt8(0)", func() (any, error) { return Uint8ToInt8(0) }, int8(0), nil},
+	{"Uint8ToInt8(1)", func() (any, error) { return Uint8ToInt8(1) }, int8(1), nil},
+	{"Uint8ToInt8(42)", func() (any, error) { return Uint8ToInt8(42) }, int8(42), nil},
+	{"Uint8ToInt8(255)", func() (any, error) { return Uint8ToInt8(255) }, nil, ErrOverflow},
+	{"Uint8ToInt16(0)", func() (any, error) { return Uint8ToInt16(0) }, int16(0), nil},
+	{"Uint8ToInt16(0)", func() (any, error) { return Uint8ToInt16(0) }, int16(0), nil},
+	{"Uint8ToInt16(1)", func() (any, error) { return Uint8ToInt16(1) }, int16(1), nil},
+	{"Uint8ToInt16(42)", func() (any, error) { return Uint8ToInt16(42) }, int16(42), nil},
+	{"Uint8ToInt16(255)", func() (any, error) { return Uint8ToInt16(255) }, int16(255), nil},
+	{"Uint8ToInt32(0)", func() (any, error) { return Uint8ToInt32(0) }, int32(0), nil},
+	{"Uint8ToInt32(0)", func() (any, error) { return Uint8ToInt32(0) }, int32(0), nil},
+	{"Uint8ToInt32(1)", func() (any, error) { return Uint8ToInt32(1) }, int32(1), nil},
+	{"Uint8ToInt32(42)", func() (any, error) { return Uint8ToInt32(42) }, int32(42), nil},
+	{"Uint8ToInt32(255)", func() (any, error) { return Uint8ToInt32(255) }, int32(255), nil},
+	{"Uint8ToInt64(0)", func() (any, error) { return Uint8ToInt64(0) }, int64(0), nil},
+	{"Uint8ToInt64(0)", func() (any, error) { return Uint8ToInt64(0) }, int64(0), nil},
+	{"Uint8ToInt64(1)", func() (any, error) { return Uint8ToInt64(1) }, int64(1), nil},
+	{"Uint8ToInt64(42)", func() (any, error) { return Uint8ToInt64(42) }, int64(42), nil},
+	{"Uint8ToInt64(255)", func() (any, error) { return Uint8ToInt64(255) }, int64(255), nil},
+	{"Uint8ToUint(0)", func() (any, error) { return Uint8ToUint(0) }, uint(0), nil},
+	{"Uint8ToUint(0)", func() (any, error) { return Uint8ToUint(0) }, uint(0), nil},
+	{"Uint8ToUint(1)", func() (any, error) { return Uint8ToUint(1) }, uint(1), nil},
+	{"Uint8ToUint(42)", func() (any, error) { return Uint8ToUint(42) }, uint(42), nil},
+	{"Uint8ToUint(255)", func() (any, error) { return Uint8ToUint(255) }, uint(255), nil},
+	{"Uint8ToUint16(0)", func() (any, error) { return Uint8ToUint16(0) }, uint16(0), nil},
+	{"Uint8ToUint16(0)", func() (any, error) { return Uint8ToUint16(0) }, uint16(0), nil},
+	{"Uint8ToUint16(1)", func() (any, error) { return Uint8ToUint16(1) }, uint16(1), nil},
+	{"Uint8ToUint16(42)", func() (any, error) { return Uint8ToUint16(42) }, uint16(42), nil},
+	{"Uint8ToUint16(255)", func() (any, error) { return Uint8ToUint16(255) }, uint16(255), nil},
+	{"Uint8ToUint32(0)", func() (any, error) { return Uint8ToUint32(0) }, uint32(0), nil},
+	{"Uint8ToUint32(0)", func() (any, error) { return Uint8ToUint32(0) }, uint32(0), nil},
+	{"Uint8ToUint32(1)", func() (any, error) { return Uint8ToUint32(1) }, uint32(1), nil},
+	{"Uint8ToUint32(42)", func() (any, error) { return Uint8ToUint32(42) }, uint32(42), nil},
+	{"Uint8ToUint32(255)", func() (any, error) { return Uint8ToUint32(255) }, uint32(255), nil},
+	{"Uint8ToUint64(0)", func() (any, error) { return Uint8ToUint64(0) }, uint64(0), nil},
+	{"Uint8ToUint64(0)", func() (any, error) { return Uint8ToUint64(0) }, uint64(0), nil},
+	{"Uint8ToUint64(1)", func() (any, error) { return Uint8ToUint64(1) }, uint64(1), nil},
+	{"Uint8ToUint64(42)", func() (any, error) { return Uint8ToUint64(42) }, uint64(42), nil},
+	{"Uint8ToUint64(255)", func() (any, error) { return Uint8ToUint64(255) }, uint64(255), nil},
+	{"Uint8ToFloat32(0)", func() (any, error) { return Uint8ToFloat32(0) }, float32(0), nil},
+	{"Uint8ToFloat32(0)", func() (any, error) { return Uint8ToFloat32(0) }, float32(0), nil},
+	{"Uint8ToFloat32(1)", func() (any, error) { return Uint8ToFloat32(1) }, float32(1), nil},
+	{"Uint8ToFloat32(42)", func() (any, error) { return Uint8ToFloat32(42) }, float32(42), nil},
+	{"Uint8ToFloat32(255)", func() (any, error) { return Uint8ToFloat32(255) }, float32(255), nil},
+	{"Uint8ToFloat64(0)", func() (any, error) { return Uint8ToFloat64(0) }, float64(0), nil},
+	{"Uint8ToFloat64(0)", func() (any, error) { return Uint8ToFloat64(0) }, float64(0), nil},
+	{"Uint8ToFloat64(1)", func() (any, error) { return Uint8ToFloat64(1) }, float64(1), nil},
+	{"Uint8ToFloat64(42)", func() (any, error) { return Uint8ToFloat64(42) }, float64(42), nil},
+	{"Uint8ToFloat64(255)", func() (any, error) { return Uint8ToFloat64(255) }, float64(255), nil},
+	{"Uint8ToString(0)", func() (any, error) { return Uint8ToString(0) }, "0", nil},
+	{"Uint8ToString(0)", func() (any, error) { return Uint8ToString(0) }, "0", nil},
+	{"Uint8ToString(1)", func() (any, error) { return Uint8ToString(1) }, "1", nil},
+	{"Uint8ToString(42)", func() (any, error) { return Uint8ToString(42) }, "42", nil},
+	{"Uint8ToString(255)", func() (any, error) { return Uint8ToString(255) }, "255", nil},
+	{"Uint16ToBool(0)", func() (any, error) { return Uint16ToBool(0) }, false, nil},
+	{"Uint16ToBool(0)", func() (any, error) { return Uint16ToBool(0) }, false, nil},
+	{"Uint16ToBool(1)", func() (any, error) { return Uint16ToBool(1) }, true, nil},
+	{"Uint16ToBool(42)", func() (any, error) { return Uint16ToBool(42) }, true, nil},
+	{"Uint16ToBool(65535)", func() (any, error) { return Uint16ToBool(65535) }, true, nil},
+	{"Uint16ToInt(0)", func() (any, error) { return Uint16ToInt(0) }, int(0), nil},
+	{"Uint16ToInt(0)", func() (any, error) { return Uint16ToInt(0) }, int(0), nil},
+	{"Uint16ToInt(1)", func() (any, error) { return Uint16ToInt(1) }, int(1), nil},
+	{"Uint16ToInt(42)", func() (any, error) { return Uint16ToInt(42) }, int(42), nil},
+	{"Uint16ToInt(65535)", func() (any, error) { return Uint16ToInt(65535) }, int(65535), nil},
+	{"Uint16ToInt8(0)", func() (any, error) { return Uint16ToInt8(0) }, int8(0), nil},
+	{"Uint16ToInt8(0)", func() (any, error) { return Uint16ToInt8(0) }, int8(0), nil},
+	{"Uint16ToInt8(1)", func() (any, error) { return Uint16ToInt8(1) }, int8(1), nil},
+	{"Uint16ToInt8(42)", func() (any, error) { return Uint16ToInt8(42) }, int8(42), nil},
+	{"Uint16ToInt8(65535)", func() (any, error) { return Uint16ToInt8(65535) }, nil, ErrOverflow},
+	{"Uint16ToInt16(0)", func() (any, error) { return Uint16ToInt16(0) }, int16(0), nil},
+	{"Uint16ToInt16(0)", func() (any, error) { return Uint16ToInt16(0) }, int16(0), nil},
+	{"Uint16ToInt16(1)", func() (any, error) { return Uint16ToInt16(1) }, int16(1), nil},
+	{"Uint16ToInt16(42)", func() (any, error) { return Uint16ToInt16(42) }, int16(42), nil},
+	{"Uint16ToInt16(65535)", func() (any, error) { return Uint16ToInt16(65535) }, nil, ErrOverflow},
+	{"Uint16ToInt32(0)", func() (any, error) { return Uint16ToInt32(0) }, int32(0), nil},
+	{"Uint16ToInt32(0)", func() (any, error) { return Uint16ToInt32(0) }, int32(0), nil},
+	{"Uint16ToInt32(1)", func() (any, error) { return Uint16ToInt32(1) }, int32(1), nil},
+	{"Uint16ToInt32(42)", func() (any, error) { return Uint16ToInt32(42) }, int32(42), nil},
+	{"Uint16ToInt32(65535)", func() (any, error) { return Uint16ToInt32(65535) }, int32(65535), nil},
+	{"Uint16ToInt64(0)", func() (any, error) { return Uint16ToInt64(0) }, int64(0), nil},
+	{"Uint16ToInt64(0)", func() (any, error) { return Uint16ToInt64(0) }, int64(0), nil},
+	{"Uint16ToInt64(1)", func() (any, error) { return Uint16ToInt64(1) }, int64(1), nil},
+	{"Uint16ToInt64(42)", func() (any, error) { return Uint16ToInt64(42) }, int64(42), nil},
+	{"Uint16ToInt64(65535)", func() (any, error) { return Uint16ToInt64(65535) }, int64(65535), nil},
+	{"Uint16ToUint(0)", func() (any, error) { return Uint16ToUint(0) }, uint(0), nil},
+	{"Uint16ToUint(0)", func() (any, error) { return Uint16ToUint(0) }, uint(0), nil},
+	{"Uint16ToUint(1)", func() (any, error) { return Uint16ToUint(1) }, uint(1), nil},
+	{"Uint16ToUint(42)", func() (any, error) { return Uint16ToUint(42) }, uint(42), nil},
+	{"Uint16ToUint(65535)", func() (any, error) { return Uint16ToUint(65535) }, uint(65535), nil},
+	{"Uint16ToUint8(0)", func() (any, error) { return Uint16ToUint8(0) }, uint8(0), nil},
+	{"Uint16ToUint8(0)", func() (any, error) { return Uint16ToUint8(0) }, uint8(0), nil},
+	{"Uint16ToUint8(1)", func() (any, error) { return Uint16ToUint8(1) }, uint8(1), nil},
+	{"Uint16ToUint8(42)", func() (any, error) { return Uint16ToUint8(42) }, uint8(42), nil},
+	{"Uint16ToUint8(65535)", func() (any, error) { return Uint16ToUint8(65535) }, nil, ErrOverflow},
+	{"Uint16ToUint32(0)", func() (any, error) { return Uint16ToUint32(0) }, uint32(0), nil},
+	{"Uint16ToUint32(0)", func() (any, error) { return Uint16ToUint32(0) }, uint32(0), nil},
+	{"Uint16ToUint32(1)", func() (any, error) { return Uint16ToUint32(1) }, uint32(1), nil},
+	{"Uint16ToUint32(42)", func() (any, error) { return Uint16ToUint32(42) }, uint32(42), nil},
+	{"Uint16ToUint32(65535)", func() (any, error) { return Uint16ToUint32(65535) }, uint32(65535), nil},
+	{"Uint16ToUint64(0)", func() (any, error) { return Uint16ToUint64(0) }, uint64(0), nil},
+	{"Uint16ToUint64(0)", func() (any, error) { return Uint16ToUint64(0) }, uint64(0), nil},
+	{"Uint16ToUint64(1)", func() (any, error) { return Uint16ToUint64(1) }, uint64(1), nil},
+	{"Uint16ToUint64(42)", func() (any, error) { return Uint16ToUint64(42) }, uint64(42), nil},
+	{"Uint16ToUint64(65535)", func() (any, error) { return Uint16ToUint64(65535) }, uint64(65535), nil},
+	{"Uint16ToFloat32(0)", func() (any, error) { return Uint16ToFloat32(0) }, float32(0), nil},
+	{"Uint16ToFloat32(0)", func() (any, error) { return Uint16ToFloat32(0) }, float32(0), nil},
+	{"Uint16ToFloat32(1)", func() (any, error) { return Uint16ToFloat32(1) }, float32(1), nil},
+	{"Uint16ToFloat32(42)", func() (any, error) { return Uint16ToFloat32(42) }, float32(42), nil},
+	{"Uint16ToFloat32(65535)", func() (any, error) { return Uint16ToFloat32(65535) }, float32(65535), nil},
+	{"Uint16ToFloat64(0)", func() (any, error) { return Uint16ToFloat64(0) }, float64(0), nil},
+	{"Uint16ToFloat64(0)", func() (any, error) { return Uint16ToFloat64(0) }, float64(0), nil},
+	{"Uint16ToFloat64(1)", func() (any, error) { return Uint16ToFloat64(1) }, float64(1), nil},
+	{"Uint16ToFloat64(42)", func() (any, error) { return Uint16ToFloat64(42) }, float64(42), nil},
+	{"Uint16ToFloat64(65535)", func() (any, error) { return Uint16ToFloat64(65535) }, float64(65535), nil},
+	{"Uint16ToString(0)", func() (any, error) { return Uint16ToString(0) }, "0", nil},
+	{"Uint16ToString(0)", func() (any, error) { return Uint16ToString(0) }, "0", nil},
+	{"Uint16ToString(1)", func() (any, error) { return Uint16ToString(1) }, "1", nil},
+	{"Uint16ToString(42)", func() (any, error) { return Uint16ToString(42) }, "42", nil},
+	{"Uint16ToString(65535)", func() (any, error) { return Uint16ToString(65535) }, "65535", nil},
+	{"Uint32ToBool(0)", func() (any, error) { return Uint32ToBool(0) }, false, nil},
+	{"Uint32ToBool(0)", func() (any, error) { return Uint32ToBool(0) }, false, nil},
+	{"Uint32ToBool(1)", func() (any, error) { return Uint32ToBool(1) }, true, nil},
+	{"Uint32ToBool(42)", func() (any, error) { return Uint32ToBool(42) }, true, nil},
+	{"Uint32ToBool(4294967295)", func() (any, error) { return Uint32ToBool(4294967295) }, true, nil},
+	{"Uint32ToInt(0)", func() (any, error) { return Uint32ToInt(0) }, int(0), nil},
+	{"Uint32ToInt(0)", func() (any, error) { return Uint32ToInt(0) }, int(0), nil},
+	{"Uint32ToInt(1)", func() (any, error) { return Uint32ToInt(1) }, int(1), nil},
+	{"Uint32ToInt(42)", func() (any, error) { return Uint32ToInt(42) }, int(42), nil},
+	{"Uint32ToInt(4294967295)", func() (any, error) { return Uint32ToInt(4294967295) }, int(4294967295), nil},
+	{"Uint32ToInt8(0)", func() (any, error) { return Uint32ToInt8(0) }, int8(0), nil},
+	{"Uint32ToInt8(0)", func() (any, error) { return Uint32ToInt8(0) }, int8(0), nil},
+	{"Uint32ToInt8(1)", func() (any, error) { return Uint32ToInt8(1) }, int8(1), nil},
+	{"Uint32ToInt8(42)", func() (any, error) { return Uint32ToInt8(42) }, int8(42), nil},
+	{"Uint32ToInt8(4294967295)", func() (any, error) { return Uint32ToInt8(4294967295) }, nil, ErrOverflow},
+	{"Uint32ToInt16(0)", func() (any, error) { return Uint32ToInt16(0) }, int16(0), nil},
+	{"Uint32ToInt16(0)", func() (any, error) { return Uint32ToInt16(0) }, int16(0), nil},
+	{"Uint32ToInt16(1)", func() (any, error) { return Uint32ToInt16(1) }, int16(1), nil},
+	{"Uint32ToInt16(42)", func() (any, error) { return Uint32ToInt16(42) }, int16(42), nil},
+	{"Uint32ToInt16(4294967295)", func() (any, error) { return Uint32ToInt16(4294967295) }, nil, ErrOverflow},
+	{"Uint32ToInt32(0)", func() (any, error) { return Uint32ToInt32(0) }, int32(0), nil},
+	{"Uint32ToInt32(0)", func() (any, error) { return Uint32ToInt32(0) }, int32(0), nil},
+	{"Uint32ToInt32(1)", func() (any, error) { return Uint32ToInt32(1) }, int32(1), nil},
+	{"Uint32ToInt32(42)", func() (any, error) { return Uint32ToInt32(42) }, int32(42), nil},
+	{"Uint32ToInt32(4294967295)", func() (any, error) { return Uint32ToInt32(4294967295) }, nil, ErrOverflow},
+	{"Uint32ToInt64(0)", func() (any, error) { return Uint32ToInt64(0) }, int64(0), nil},
+	{"Uint32ToInt64(0)", func() (any, error) { return Uint32ToInt64(0) }, int64(0), nil},
+	{"Uint32ToInt64(1)", func() (any, error) { return Uint32ToInt64(1) }, int64(1), nil},
+	{"Uint32ToInt64(42)", func() (any, error) { return Uint32ToInt64(42) }, int64(42), nil},
+	{"Uint32ToInt64(4294967295)", func() (any, error) { return Uint32ToInt64(4294967295) }, int64(4294967295), nil},
+	{"Uint32ToUint(0)", func() (any, error) { return Uint32ToUint(0) }, uint(0), nil},
+	{"Uint32ToUint(0)", func() (any, error) { return Uint32ToUint(0) }, uint(0), nil},
+	{"Uint32ToUint(1)", func() (any, error) { return Uint32ToUint(1) }, uint(1), nil},
+	{"Uint32ToUint(42)", func() (any, error) { return Uint32ToUint(42) }, uint(42), nil},
+	{"Uint32ToUint(4294967295)", func() (any, error) { return Uint32ToUint(4294967295) }, uint(4294967295), nil},
+	{"Uint32ToUint8(0)", func() (any, error) { return Uint32ToUint8(0) }, uint8(0), nil},
+	{"Uint32ToUint8(0)", func() (any, error) { return Uint32ToUint8(0) }, uint8(0), nil},
+	{"Uint32ToUint8(1)", func() (any, error) { return Uint32ToUint8(1) }, uint8(1), nil},
+	{"Uint32ToUint8(42)", func() (any, error) { return Uint32ToUint8(42) }, uint8(42), nil},
+	{"Uint32ToUint8(4294967295)", func() (any, error) { return Uint32ToUint8(4294967295) }, nil, ErrOverflow},
+	{"Uint32ToUint16(0)", func() (any, error) { return Uint32ToUint16(0) }, uint16(0), nil},
+	{"Uint32ToUint16(0)", func() (any, error) { return Uint32ToUint16(0) }, uint16(0), nil},
+	{"Uint32ToUint16(1)", func() (any, error) { return Uint32ToUint16(1) }, uint16(1), nil},
+	{"Uint32ToUint16(42)", func() (any, error) { return Uint32ToUint16(42) }, uint16(42), nil},
+	{"Uint32ToUint16(4294967295)", func() (any, error) { return Uint32ToUint16(4294967295) }, nil, ErrOverflow},
+	{"Uint32ToUint64(0)", func() (any, error) { return Uint32ToUint64(0) }, uint64(0), nil},
+	{"Uint32ToUint64(0)", func() (any, error) { return Uint32ToUint64(0) }, uint64(0), nil},
+	{"Uint32ToUint64(1)", func() (any, error) { return Uint32ToUint64(1) }, uint64(1), nil},
+	{"Uint32ToUint64(42)", func() (any, error) { return Uint32ToUint64(42) }, uint64(42), nil},
+	{"Uint32ToUint64(4294967295)", func() (any, error) { return Uint32ToUint64(4294967295) }, uint64(4294967295), nil},
+	{"Uint32ToFloat32(0)", func() (any, error) { return Uint32ToFloat32(0) }, float32(0), nil},
+	{"Uint32ToFloat32(0)", func() (any, error) { return Uint32ToFloat32(0) }, float32(0), nil},
+	{"Uint32ToFloat32(1)", func() (any, error) { return Uint32ToFloat32(1) }, float32(1), nil},
+	{"Uint32ToFloat32(42)", func() (any, error) { return Uint32ToFloat32(42) }, float32(42), nil},
+	{"Uint32ToFloat32(4294967295)", func() (any, error) { return Uint32ToFloat32(4294967295) }, float32(4.2949673e+09), nil},
+	{"Uint32ToFloat64(0)", func() (any, error) { return Uint32ToFloat64(0) }, float64(0), nil},
+	{"Uint32ToFloat64(0)", func() (any, error) { return Uint32ToFloat64(0) }, float64(0), nil},
+	{"Uint32ToFloat64(1)", func() (any, error) { return Uint32ToFloat64(1) }, float64(1), nil},
+	{"Uint32ToFloat64(42)", func() (any, error) { return Uint32ToFloat64(42) }, float64(42), nil},
+	{"Uint32ToFloat64(4294967295)", func() (any, error) { return Uint32ToFloat64(4294967295) }, float64(4.294967295e+09), nil},
+	{"Uint32ToString(0)", func() (any, error) { return Uint32ToString(0) }, "0", nil},
+	{"Uint32ToString(0)", func() (any, error) { return Uint32ToString(0) }, "0", nil},
+	{"Uint32ToString(1)", func() (any, error) { return Uint32ToString(1) }, "1", nil},
+	{"Uint32ToString(42)", func() (any, error) { return Uint32ToString(42) }, "42", nil},
+	{"Uint32ToString(4294967295)", func() (any, error) { return Uint32ToString(4294967295) }, "4294967295", nil},
+	{"Uint64ToBool(0)", func() (any, error) { return Uint64ToBool(0) }, false, nil},
+	{"Uint64ToBool(0)", func() (any, error) { return Uint64ToBool(0) }, false, nil},
+	{"Uint64ToBool(1)", func() (any, error) { return Uint64ToBool(1) }, true, nil},
+	{"Uint64ToBool(42)", func() (any, error) { return Uint64ToBool(42) }, true, nil},
+	{"Uint64ToBool(18446744073709551615)", func() (any, error) { return Uint64ToBool(18446744073709551615) }, true, nil},
+	{"Uint64ToInt(0)", func() (any, error) { return Uint64ToInt(0) }, int(0), nil},
+	{"Uint64ToInt(0)", func() (any, error) { return Uint64ToInt(0) }, int(0), nil},
+	{"Uint64ToInt(1)", func() (any, error) { return Uint64ToInt(1) }, int(1), nil},
+	{"Uint64ToInt(42)", func() (any, error) { return Uint64ToInt(42) }, int(42), nil},
+	{"Uint64ToInt(18446744073709551615)", func() (any, error) { return Uint64ToInt(18446744073709551615) }, nil, ErrOverflow},
+	{"Uint64ToInt8(0)", func() (any, error) { return Uint64ToInt8(0) }, int8(0), nil},
+	{"Uint64ToInt8(0)", func() (any, error) { return Uint64ToInt8(0) }, int8(0), nil},
+	{"Uint64ToInt8(1)", func() (any, error) { return Uint64ToInt8(1) }, int8(1), nil},
+	{"Uint64ToInt8(42)", func() (any, error) { return Uint64ToInt8(42) }, int8(42), nil},
+	{"Uint64ToInt8(18446744073709551615)", func() (any, error) { return Uint64ToInt8(18446744073709551615) }, nil, ErrOverflow},
+	{"Uint64ToInt16(0)", func() (any, error) { return Uint64ToInt16(0) }, int16(0), nil},
+	{"Uint64ToInt16(0)", func() (any, error) { return Uint64ToInt16(0) }, int16(0), nil},
+	{"Uint64ToInt16(1)", func() (any, error) { return Uint64ToInt16(1) }, int16(1), nil},
+	{"Uint64ToInt16(42)", func() (any, error) { return Uint64ToInt16(42) }, int16(42), nil},
+	{"Uint64ToInt16(18446744073709551615)", func() (any, error) { return Uint64ToInt16(18446744073709551615) }, nil, ErrOverflow},
+	{"Uint64ToInt32(0)", func() (any, error) { return Uint64ToInt32(0) }, int32(0), nil},
+	{"Uint64ToInt32(0)", func() (any, error) { return Uint64ToInt32(0) }, int32(0), nil},
+	{"Uint64ToInt32(1)", func() (any, error) { return Uint64ToInt32(1) }, int32(1), nil},
+	{"Uint64ToInt32(42)", func() (any, error) { return Uint64ToInt32(42) }, int32(42), nil},
+	{"Uint64ToInt32(18446744073709551615)", func() (any, error) { return Uint64ToInt32(18446744073709551615) }, nil, ErrOverflow},
+	{"Uint64ToInt64(0)", func() (any, error) { return Uint64ToInt64(0) }, int64(0), nil},
+	{"Uint64ToInt64(0)", func() (any, error) { return Uint64ToInt64(0) }, int64(0), nil},
+	{"Uint64ToInt64(1)", func() (any, error) { return Uint64ToInt64(1) }, int64(1), nil},
+	{"Uint64ToInt64(42)", func() (any, error) { return Uint64ToInt64(42) }, int64(42), nil},
+	{"Uint64ToInt64(18446744073709551615)", func() (any, error) { return Uint64ToInt64(18446744073709551615) }, nil, ErrOverflow},
+	{"Uint64ToUint(0)", func() (any, error) { return Uint64ToUint(0) }, uint(0), nil},
+	{"Uint64ToUint(0)", func() (any, error) { return Uint64ToUint(0) }, uint(0), nil},
+	{"Uint64ToUint(1)", func() (any, error) { return Uint64ToUint(1) }, uint(1), nil},
+	{"Uint64ToUint(42)", func() (any, error) { return Uint64ToUint(42) }, uint(42), nil},
+	{"Uint64ToUint(18446744073709551615)", func() (any, error) { return Uint64ToUint(18446744073709551615) }, uint(18446744073709551615), nil},
+	{"Uint64ToUint8(0)", func() (any, error) { return Uint64ToUint8(0) }, uint8(0), nil},
+	{"Uint64ToUint8(0)", func() (any, error) { return Uint64ToUint8(0) }, uint8(0), nil},
+	{"Uint64ToUint8(1)", func() (any, error) { return Uint64ToUint8(1) }, uint8(1), nil},
+	{"Uint64ToUint8(42)", func() (any, error) { return Uint64ToUint8(42) }, uint8(42), nil},
+	{"Uint64ToUint8(18446744073709551615)", func() (any, error) { return Uint64ToUint8(18446744073709551615) }, nil, ErrOverflow},
+	{"Uint64ToUint16(0)", func() (any, error) { return Uint64ToUint16(0) }, uint16(0), nil},
+	{"Uint64ToUint16(0)", func() (any, error) { return Uint64ToUint16(0) }, uint16(0), nil},
+	{"Uint64ToUint16(1)", func() (any, error) { return Uint64ToUint16(1) }, uint16(1), nil},
+	{"Uint64ToUint16(42)", func() (any, error) { return Uint64ToUint16(42) }, uint16(42), nil},
+	{"Uint64ToUint16(18446744073709551615)", func() (any, error) { return Uint64ToUint16(18446744073709551615) }, nil, ErrOverflow},
+	{"Uint64ToUint32(0)", func() (any, error) { return Uint64ToUint32(0) }, uint32(0), nil},
+	{"Uint64ToUint32(0)", func() (any, error) { return Uint64ToUint32(0) }, uint32(0), nil},
+	{"Uint64ToUint32(1)", func() (any, error) { return Uint64ToUint32(1) }, uint32(1), nil},
+	{"Uint64ToUint32(42)", func() (any, error) { return Uint64ToUint32(42) }, uint32(42), nil},
+	{"Uint64ToUint32(18446744073709551615)", func() (any, error) { return Uint64ToUint32(18446744073709551615) }, nil, ErrOverflow},
+	{"Uint64ToFloat32(0)", func() (any, error) { return Uint64ToFloat32(0) }, float32(0), nil},
+	{"Uint64ToFloat32(0)", func() (any, error) { return Uint64ToFloat32(0) }, float32(0), nil},
+	{"Uint64ToFloat32(1)", func() (any, error) { return Uint64ToFloat32(1) }, float32(1), nil},
+	{"Uint64ToFloat32(42)", func() (any, error) { return Uint64ToFloat32(42) }, float32(42), nil},
+	{"Uint64ToFloat32(18446744073709551615)", func() (any, error) { return Uint64ToFloat32(18446744073709551615) }, float32(1.8446744e+19), nil},
+	{"Uint64ToFloat64(0)", func() (any, error) { return Uint64ToFloat64(0) }, float64(0), nil},
+	{"Uint64ToFloat64(0)", func() (any, error) { return Uint64ToFloat64(0) }, float64(0), nil},
+	{"Uint64ToFloat64(1)", func() (any, error) { return Uint64ToFloat64(1) }, float64(1), nil},
+	{"Uint64ToFloat64(42)", func() (any, error) { return Uint64ToFloat64(42) }, float64(42), nil},
+	{"Uint64ToFloat64(18446744073709551615)", func() (any, error) { return Uint64ToFloat64(18446744073709551615) }, float64(1.8446744073709552e+19), nil},
+	{"Uint64ToString(0)", func() (any, error) { return Uint64ToString(0) }, "0", nil},
+	{"Uint64ToString(0)", func() (any, error) { return Uint64ToString(0) }, "0", nil},
+	{"Uint64ToString(1)", func() (any, error) { return Uint64ToString(1) }, "1", nil},
+	{"Uint64ToString(42)", func() (any, error) { return Uint64ToString(42) }, "42", nil},
+	{"Uint64ToString(18446744073709551615)", func() (any, error) { return Uint64ToString(18446744073709551615) }, "18446744073709551615", nil},
+	{"Float32ToBool(float32(math.Inf(-1)))", func() (any, error) { return Float32ToBool(float32(math.Inf(-1))) }, true, nil},
+	{"Float32ToBool(float32(-3.4028235e+38))", func() (any, error) { return Float32ToBool(float32(-3.4028235e+38)) }, true, nil},
+	{"Float32ToBool(float32(-42.5))", func() (any, error) { return Float32ToBool(float32(-42.5)) }, true, nil},
+	{"Float32ToBool(float32(-1))", func() (any, error) { return Float32ToBool(float32(-1)) }, true, nil},
+	{"Float32ToBool(float32(0))", func() (any, error) { return Float32ToBool(float32(0)) }, false, nil},
+	{"Float32ToBool(float32(1))", func() (any, error) { return Float32ToBool(float32(1)) }, true, nil},
+	{"Float32ToBool(float32(42.5))", func() (any, error) { return Float32ToBool(float32(42.5)) }, true, nil},
+	{"Float32ToBool(float32(3.4028235e+38))", func() (any, error) { return Float32ToBool(float32(3.4028235e+38)) }, true, nil},
+	{"Float32ToBool(float32(math.Inf(1)))", func() (any, error) { return Float32ToBool(float32(math.Inf(1))) }, true, nil},
+	{"Float32ToBool(float32(math.NaN()))", func() (any, error) { return Float32ToBool(float32(math.NaN())) }, true, nil},
+	{"Float32ToInt(float32(math.Inf(-1)))", func() (any, error) { return Float32ToInt(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToInt(float32(-3.4028235e+38))", func() (any, error) { return Float32ToInt(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToInt(float32(-42.5))", func() (any, error) { return Float32ToInt(float32(-42.5)) }, int(-42), nil},
+	{"Float32ToInt(float32(-1))", func() (any, error) { return Float32ToInt(float32(-1)) }, int(-1), nil},
+	{"Float32ToInt(float32(0))", func() (any, error) { return Float32ToInt(float32(0)) }, int(0), nil},
+	{"Float32ToInt(float32(1))", func() (any, error) { return Float32ToInt(float32(1)) }, int(1), nil},
+	{"Float32ToInt(float32(42.5))", func() (any, error) { return Float32ToInt(float32(42.5)) }, int(42), nil},
+	{"Float32ToInt(float32(3.4028235e+38))", func() (any, error) { return Float32ToInt(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToInt(float32(math.Inf(1)))", func() (any, error) { return Float32ToInt(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToInt(float32(math.NaN()))", func() (any, error) { return Float32ToInt(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToInt8(float32(math.Inf(-1)))", func() (any, error) { return Float32ToInt8(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToInt8(float32(-3.4028235e+38))", func() (any, error) { return Float32ToInt8(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToInt8(float32(-42.5))", func() (any, error) { return Float32ToInt8(float32(-42.5)) }, int8(-42), nil},
+	{"Float32ToInt8(float32(-1))", func() (any, error) { return Float32ToInt8(float32(-1)) }, int8(-1), nil},
+	{"Float32ToInt8(float32(0))", func() (any, error) { return Float32ToInt8(float32(0)) }, int8(0), nil},
+	{"Float32ToInt8(float32(1))", func() (any, error) { return Float32ToInt8(float32(1)) }, int8(1), nil},
+	{"Float32ToInt8(float32(42.5))", func() (any, error) { return Float32ToInt8(float32(42.5)) }, int8(42), nil},
+	{"Float32ToInt8(float32(3.4028235e+38))", func() (any, error) { return Float32ToInt8(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToInt8(float32(math.Inf(1)))", func() (any, error) { return Float32ToInt8(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToInt8(float32(math.NaN()))", func() (any, error) { return Float32ToInt8(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToInt16(float32(math.Inf(-1)))", func() (any, error) { return Float32ToInt16(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToInt16(float32(-3.4028235e+38))", func() (any, error) { return Float32ToInt16(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToInt16(float32(-42.5))", func() (any, error) { return Float32ToInt16(float32(-42.5)) }, int16(-42), nil},
+	{"Float32ToInt16(float32(-1))", func() (any, error) { return Float32ToInt16(float32(-1)) }, int16(-1), nil},
+	{"Float32ToInt16(float32(0))", func() (any, error) { return Float32ToInt16(float32(0)) }, int16(0), nil},
+	{"Float32ToInt16(float32(1))", func() (any, error) { return Float32ToInt16(float32(1)) }, int16(1), nil},
+	{"Float32ToInt16(float32(42.5))", func() (any, error) { return Float32ToInt16(float32(42.5)) }, int16(42), nil},
+	{"Float32ToInt16(float32(3.4028235e+38))", func() (any, error) { return Float32ToInt16(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToInt16(float32(math.Inf(1)))", func() (any, error) { return Float32ToInt16(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToInt16(float32(math.NaN()))", func() (any, error) { return Float32ToInt16(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToInt32(float32(math.Inf(-1)))", func() (any, error) { return Float32ToInt32(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToInt32(float32(-3.4028235e+38))", func() (any, error) { return Float32ToInt32(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToInt32(float32(-42.5))", func() (any, error) { return Float32ToInt32(float32(-42.5)) }, int32(-42), nil},
+	{"Float32ToInt32(float32(-1))", func() (any, error) { return Float32ToInt32(float32(-1)) }, int32(-1), nil},
+	{"Float32ToInt32(float32(0))", func() (any, error) { return Float32ToInt32(float32(0)) }, int32(0), nil},
+	{"Float32ToInt32(float32(1))", func() (any, error) { return Float32ToInt32(float32(1)) }, int32(1), nil},
+	{"Float32ToInt32(float32(42.5))", func() (any, error) { return Float32ToInt32(float32(42.5)) }, int32(42), nil},
+	{"Float32ToInt32(float32(3.4028235e+38))", func() (any, error) { return Float32ToInt32(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToInt32(float32(math.Inf(1)))", func() (any, error) { return Float32ToInt32(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToInt32(float32(math.NaN()))", func() (any, error) { return Float32ToInt32(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToInt64(float32(math.Inf(-1)))", func() (any, error) { return Float32ToInt64(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToInt64(float32(-3.4028235e+38))", func() (any, error) { return Float32ToInt64(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToInt64(float32(-42.5))", func() (any, error) { return Float32ToInt64(float32(-42.5)) }, int64(-42), nil},
+	{"Float32ToInt64(float32(-1))", func() (any, error) { return Float32ToInt64(float32(-1)) }, int64(-1), nil},
+	{"Float32ToInt64(float32(0))", func() (any, error) { return Float32ToInt64(float32(0)) }, int64(0), nil},
+	{"Float32ToInt64(float32(1))", func() (any, error) { return Float32ToInt64(float32(1)) }, int64(1), nil},
+	{"Float32ToInt64(float32(42.5))", func() (any, error) { return Float32ToInt64(float32(42.5)) }, int64(42), nil},
+	{"Float32ToInt64(float32(3.4028235e+38))", func() (any, error) { return Float32ToInt64(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToInt64(float32(math.Inf(1)))", func() (any, error) { return Float32ToInt64(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToInt64(float32(math.NaN()))", func() (any, error) { return Float32ToInt64(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToUint(float32(math.Inf(-1)))", func() (any, error) { return Float32ToUint(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToUint(float32(-3.4028235e+38))", func() (any, error) { return Float32ToUint(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToUint(float32(-42.5))", func() (any, error) { return Float32ToUint(float32(-42.5)) }, nil, ErrUnderflow},
+	{"Float32ToUint(float32(-1))", func() (any, error) { return Float32ToUint(float32(-1)) }, nil, ErrUnderflow},
+	{"Float32ToUint(float32(0))", func() (any, error) { return Float32ToUint(float32(0)) }, uint(0), nil},
+	{"Float32ToUint(float32(1))", func() (any, error) { return Float32ToUint(float32(1)) }, uint(1), nil},
+	{"Float32ToUint(float32(42.5))", func() (any, error) { return Float32ToUint(float32(42.5)) }, uint(42), nil},
+	{"Float32ToUint(float32(3.4028235e+38))", func() (any, error) { return Float32ToUint(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToUint(float32(math.Inf(1)))", func() (any, error) { return Float32ToUint(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToUint(float32(math.NaN()))", func() (any, error) { return Float32ToUint(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToUint8(float32(math.Inf(-1)))", func() (any, error) { return Float32ToUint8(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToUint8(float32(-3.4028235e+38))", func() (any, error) { return Float32ToUint8(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToUint8(float32(-42.5))", func() (any, error) { return Float32ToUint8(float32(-42.5)) }, nil, ErrUnderflow},
+	{"Float32ToUint8(float32(-1))", func() (any, error) { return Float32ToUint8(float32(-1)) }, nil, ErrUnderflow},
+	{"Float32ToUint8(float32(0))", func() (any, error) { return Float32ToUint8(float32(0)) }, uint8(0), nil},
+	{"Float32ToUint8(float32(1))", func() (any, error) { return Float32ToUint8(float32(1)) }, uint8(1), nil},
+	{"Float32ToUint8(float32(42.5))", func() (any, error) { return Float32ToUint8(float32(42.5)) }, uint8(42), nil},
+	{"Float32ToUint8(float32(3.4028235e+38))", func() (any, error) { return Float32ToUint8(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToUint8(float32(math.Inf(1)))", func() (any, error) { return Float32ToUint8(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToUint8(float32(math.NaN()))", func() (any, error) { return Float32ToUint8(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToUint16(float32(math.Inf(-1)))", func() (any, error) { return Float32ToUint16(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToUint16(float32(-3.4028235e+38))", func() (any, error) { return Float32ToUint16(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToUint16(float32(-42.5))", func() (any, error) { return Float32ToUint16(float32(-42.5)) }, nil, ErrUnderflow},
+	{"Float32ToUint16(float32(-1))", func() (any, error) { return Float32ToUint16(float32(-1)) }, nil, ErrUnderflow},
+	{"Float32ToUint16(float32(0))", func() (any, error) { return Float32ToUint16(float32(0)) }, uint16(0), nil},
+	{"Float32ToUint16(float32(1))", func() (any, error) { return Float32ToUint16(float32(1)) }, uint16(1), nil},
+	{"Float32ToUint16(float32(42.5))", func() (any, error) { return Float32ToUint16(float32(42.5)) }, uint16(42), nil},
+	{"Float32ToUint16(float32(3.4028235e+38))", func() (any, error) { return Float32ToUint16(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToUint16(float32(math.Inf(1)))", func() (any, error) { return Float32ToUint16(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToUint16(float32(math.NaN()))", func() (any, error) { return Float32ToUint16(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToUint32(float32(math.Inf(-1)))", func() (any, error) { return Float32ToUint32(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToUint32(float32(-3.4028235e+38))", func() (any, error) { return Float32ToUint32(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToUint32(float32(-42.5))", func() (any, error) { return Float32ToUint32(float32(-42.5)) }, nil, ErrUnderflow},
+	{"Float32ToUint32(float32(-1))", func() (any, error) { return Float32ToUint32(float32(-1)) }, nil, ErrUnderflow},
+	{"Float32ToUint32(float32(0))", func() (any, error) { return Float32ToUint32(float32(0)) }, uint32(0), nil},
+	{"Float32ToUint32(float32(1))", func() (any, error) { return Float32ToUint32(float32(1)) }, uint32(1), nil},
+	{"Float32ToUint32(float32(42.5))", func() (any, error) { return Float32ToUint32(float32(42.5)) }, uint32(42), nil},
+	{"Float32ToUint32(float32(3.4028235e+38))", func() (any, error) { return Float32ToUint32(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToUint32(float32(math.Inf(1)))", func() (any, error) { return Float32ToUint32(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToUint32(float32(math.NaN()))", func() (any, error) { return Float32ToUint32(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToUint64(float32(math.Inf(-1)))", func() (any, error) { return Float32ToUint64(float32(math.Inf(-1))) }, nil, ErrUnderflow},
+	{"Float32ToUint64(float32(-3.4028235e+38))", func() (any, error) { return Float32ToUint64(float32(-3.4028235e+38)) }, nil, ErrUnderflow},
+	{"Float32ToUint64(float32(-42.5))", func() (any, error) { return Float32ToUint64(float32(-42.5)) }, nil, ErrUnderflow},
+	{"Float32ToUint64(float32(-1))", func() (any, error) { return Float32ToUint64(float32(-1)) }, nil, ErrUnderflow},
+	{"Float32ToUint64(float32(0))", func() (any, error) { return Float32ToUint64(float32(0)) }, uint64(0), nil},
+	{"Float32ToUint64(float32(1))", func() (any, error) { return Float32ToUint64(float32(1)) }, uint64(1), nil},
+	{"Float32ToUint64(float32(42.5))", func() (any, error) { return Float32ToUint64(float32(42.5)) }, uint64(42), nil},
+	{"Float32ToUint64(float32(3.4028235e+38))", func() (any, error) { return Float32ToUint64(float32(3.4028235e+38)) }, nil, ErrOverflow},
+	{"Float32ToUint64(float32(math.Inf(1)))", func() (any, error) { return Float32ToUint64(float32(math.Inf(1))) }, nil, ErrOverflow},
+	{"Float32ToUint64(float32(math.NaN()))", func() (any, error) { return Float32ToUint64(float32(math.NaN())) }, nil, ErrNaN},
+	{"Float32ToFloat64(float32(math.Inf(-1)))", func() (any, error) { return Float32ToFloat64(float32(math.Inf(-1))) }, math.Inf(-1), nil},
+	{"Float32ToFloat64(float32(-3.4028235e+38))", func() (any, error) { return Float32ToFloat64(float32(-3.4028235e+38)) }, float64(-3.4028234663852886e+38), nil},
+	{"Float32ToFloat64(float32(-42.5))", func() (any, error) { return Float32ToFloat64(float32(-42.5)) }, float64(-42.5), nil},
+	{"Float32ToFloat64(float32(-1))", func() (any, error) { return Float32ToFloat64(float32(-1)) }, float64(-1), nil},
+	{"Float32ToFloat64(float32(0))", func() (any, error) { return Float32ToFloat64(float32(0)) }, float64(0), nil},
+	{"Float32ToFloat64(float32(1))", func() (any, error) { return Float32ToFloat64(float32(1)) }, float64(1), nil},
+	{"Float32ToFloat64(float32(42.5))", func() (any, error) { return Float32ToFloat64(float32(42.5)) }, float64(42.5), nil},
+	{"Float32ToFloat64(float32(3.4028235e+38))", func() (any, error) { return Float32ToFloat64(float32(3.4028235e+38)) }, float64(3.4028234663852886e+38), nil},
+	{"Float32ToFloat64(float32(math.Inf(1)))", func() (any, error) { return Float32ToFloat64(float32(math.Inf(1))) }, math.Inf(1), nil},
+	{"Float32ToFloat64(float32(math.NaN()))", func() (any, error) { return Float32ToFloat64(float32(math.NaN())) }, math.NaN(), nil},
+	{"Float32ToString(float32(math.Inf(-1)))", func() (any, error) { return Float32ToString(float32(math.Inf(-1))) }, "-Inf", nil},
+	{"Float32ToString(float32(-3.4028235e+38))", func() (any, error) { return Float32ToString(float32(-3.4028235e+38)) }, "-340282350000000000000000000000000000000", nil},
+	{"Float32ToString(float32(-42.5))", func() (any, error) { return Float32ToString(float32(-42.5)) }, "-42.5", nil},
+	{"Float32ToString(float32(-1))", func() (any, error) { return Float32ToString(float32(-1)) }, "-1", nil},
+	{"Float32ToString(float32(0))", func() (any, error) { return Float32ToString(float32(0)) }, "0", nil},
+	{"Float32ToString(float32(1))", func() (any, error) { return Float32ToString(float32(1)) }, "1", nil},
+	{"Float32ToString(float32(42.5))", func() (any, error) { return Float32ToString(float32(42.5)) }, "42.5", nil},
+	{"Float32ToString(float32(3.4028235e+38))", func() (any, error) { return Float32ToString(float32(3.4028235e+38)) }, "340282350000000000000000000000000000000", nil},
+	{"Float32ToString(float32(math.Inf(1)))", func() (any, error) { return Float32ToString(float32(math.Inf(1))) }, "+Inf", nil},
+	{"Float32ToString(float32(math.NaN()))", func() (any, error) { return Float32ToString(float32(math.NaN())) }, "NaN", nil},
+	{"Float64ToBool(math.Inf(-1))", func() (any, error) { return Float64ToBool(math.Inf(-1)) }, true, nil},
+	{"Float64ToBool(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToBool(float64(-1.7976931348623157e+308)) }, true, nil},
+	{"Float64ToBool(float64(-42.5))", func() (any, error) { return Float64ToBool(float64(-42.5)) }, true, nil},
+	{"Float64ToBool(float64(-1))", func() (any, error) { return Float64ToBool(float64(-1)) }, true, nil},
+	{"Float64ToBool(float64(0))", func() (any, error) { return Float64ToBool(float64(0)) }, false, nil},
+	{"Float64ToBool(float64(1))", func() (any, error) { return Float64ToBool(float64(1)) }, true, nil},
+	{"Float64ToBool(float64(42.5))", func() (any, error) { return Float64ToBool(float64(42.5)) }, true, nil},
+	{"Float64ToBool(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToBool(float64(1.7976931348623157e+308)) }, true, nil},
+	{"Float64ToBool(math.Inf(1))", func() (any, error) { return Float64ToBool(math.Inf(1)) }, true, nil},
+	{"Float64ToBool(math.NaN())", func() (any, error) { return Float64ToBool(math.NaN()) }, true, nil},
+	{"Float64ToInt(math.Inf(-1))", func() (any, error) { return Float64ToInt(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToInt(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToInt(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToInt(float64(-42.5))", func() (any, error) { return Float64ToInt(float64(-42.5)) }, int(-42), nil},
+	{"Float64ToInt(float64(-1))", func() (any, error) { return Float64ToInt(float64(-1)) }, int(-1), nil},
+	{"Float64ToInt(float64(0))", func() (any, error) { return Float64ToInt(float64(0)) }, int(0), nil},
+	{"Float64ToInt(float64(1))", func() (any, error) { return Float64ToInt(float64(1)) }, int(1), nil},
+	{"Float64ToInt(float64(42.5))", func() (any, error) { return Float64ToInt(float64(42.5)) }, int(42), nil},
+	{"Float64ToInt(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToInt(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToInt(math.Inf(1))", func() (any, error) { return Float64ToInt(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToInt(math.NaN())", func() (any, error) { return Float64ToInt(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToInt8(math.Inf(-1))", func() (any, error) { return Float64ToInt8(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToInt8(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToInt8(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToInt8(float64(-42.5))", func() (any, error) { return Float64ToInt8(float64(-42.5)) }, int8(-42), nil},
+	{"Float64ToInt8(float64(-1))", func() (any, error) { return Float64ToInt8(float64(-1)) }, int8(-1), nil},
+	{"Float64ToInt8(float64(0))", func() (any, error) { return Float64ToInt8(float64(0)) }, int8(0), nil},
+	{"Float64ToInt8(float64(1))", func() (any, error) { return Float64ToInt8(float64(1)) }, int8(1), nil},
+	{"Float64ToInt8(float64(42.5))", func() (any, error) { return Float64ToInt8(float64(42.5)) }, int8(42), nil},
+	{"Float64ToInt8(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToInt8(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToInt8(math.Inf(1))", func() (any, error) { return Float64ToInt8(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToInt8(math.NaN())", func() (any, error) { return Float64ToInt8(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToInt16(math.Inf(-1))", func() (any, error) { return Float64ToInt16(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToInt16(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToInt16(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToInt16(float64(-42.5))", func() (any, error) { return Float64ToInt16(float64(-42.5)) }, int16(-42), nil},
+	{"Float64ToInt16(float64(-1))", func() (any, error) { return Float64ToInt16(float64(-1)) }, int16(-1), nil},
+	{"Float64ToInt16(float64(0))", func() (any, error) { return Float64ToInt16(float64(0)) }, int16(0), nil},
+	{"Float64ToInt16(float64(1))", func() (any, error) { return Float64ToInt16(float64(1)) }, int16(1), nil},
+	{"Float64ToInt16(float64(42.5))", func() (any, error) { return Float64ToInt16(float64(42.5)) }, int16(42), nil},
+	{"Float64ToInt16(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToInt16(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToInt16(math.Inf(1))", func() (any, error) { return Float64ToInt16(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToInt16(math.NaN())", func() (any, error) { return Float64ToInt16(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToInt32(math.Inf(-1))", func() (any, error) { return Float64ToInt32(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToInt32(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToInt32(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToInt32(float64(-42.5))", func() (any, error) { return Float64ToInt32(float64(-42.5)) }, int32(-42), nil},
+	{"Float64ToInt32(float64(-1))", func() (any, error) { return Float64ToInt32(float64(-1)) }, int32(-1), nil},
+	{"Float64ToInt32(float64(0))", func() (any, error) { return Float64ToInt32(float64(0)) }, int32(0), nil},
+	{"Float64ToInt32(float64(1))", func() (any, error) { return Float64ToInt32(float64(1)) }, int32(1), nil},
+	{"Float64ToInt32(float64(42.5))", func() (any, error) { return Float64ToInt32(float64(42.5)) }, int32(42), nil},
+	{"Float64ToInt32(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToInt32(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToInt32(math.Inf(1))", func() (any, error) { return Float64ToInt32(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToInt32(math.NaN())", func() (any, error) { return Float64ToInt32(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToInt64(math.Inf(-1))", func() (any, error) { return Float64ToInt64(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToInt64(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToInt64(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToInt64(float64(-42.5))", func() (any, error) { return Float64ToInt64(float64(-42.5)) }, int64(-42), nil},
+	{"Float64ToInt64(float64(-1))", func() (any, error) { return Float64ToInt64(float64(-1)) }, int64(-1), nil},
+	{"Float64ToInt64(float64(0))", func() (any, error) { return Float64ToInt64(float64(0)) }, int64(0), nil},
+	{"Float64ToInt64(float64(1))", func() (any, error) { return Float64ToInt64(float64(1)) }, int64(1), nil},
+	{"Float64ToInt64(float64(42.5))", func() (any, error) { return Float64ToInt64(float64(42.5)) }, int64(42), nil},
+	{"Float64ToInt64(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToInt64(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToInt64(math.Inf(1))", func() (any, error) { return Float64ToInt64(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToInt64(math.NaN())", func() (any, error) { return Float64ToInt64(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToUint(math.Inf(-1))", func() (any, error) { return Float64ToUint(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToUint(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToUint(float64(-42.5))", func() (any, error) { return Float64ToUint(float64(-42.5)) }, nil, ErrUnderflow},
+	{"Float64ToUint(float64(-1))", func() (any, error) { return Float64ToUint(float64(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint(float64(0))", func() (any, error) { return Float64ToUint(float64(0)) }, uint(0), nil},
+	{"Float64ToUint(float64(1))", func() (any, error) { return Float64ToUint(float64(1)) }, uint(1), nil},
+	{"Float64ToUint(float64(42.5))", func() (any, error) { return Float64ToUint(float64(42.5)) }, uint(42), nil},
+	{"Float64ToUint(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToUint(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToUint(math.Inf(1))", func() (any, error) { return Float64ToUint(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToUint(math.NaN())", func() (any, error) { return Float64ToUint(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToUint8(math.Inf(-1))", func() (any, error) { return Float64ToUint8(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint8(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToUint8(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToUint8(float64(-42.5))", func() (any, error) { return Float64ToUint8(float64(-42.5)) }, nil, ErrUnderflow},
+	{"Float64ToUint8(float64(-1))", func() (any, error) { return Float64ToUint8(float64(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint8(float64(0))", func() (any, error) { return Float64ToUint8(float64(0)) }, uint8(0), nil},
+	{"Float64ToUint8(float64(1))", func() (any, error) { return Float64ToUint8(float64(1)) }, uint8(1), nil},
+	{"Float64ToUint8(float64(42.5))", func() (any, error) { return Float64ToUint8(float64(42.5)) }, uint8(42), nil},
+	{"Float64ToUint8(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToUint8(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToUint8(math.Inf(1))", func() (any, error) { return Float64ToUint8(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToUint8(math.NaN())", func() (any, error) { return Float64ToUint8(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToUint16(math.Inf(-1))", func() (any, error) { return Float64ToUint16(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint16(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToUint16(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToUint16(float64(-42.5))", func() (any, error) { return Float64ToUint16(float64(-42.5)) }, nil, ErrUnderflow},
+	{"Float64ToUint16(float64(-1))", func() (any, error) { return Float64ToUint16(float64(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint16(float64(0))", func() (any, error) { return Float64ToUint16(float64(0)) }, uint16(0), nil},
+	{"Float64ToUint16(float64(1))", func() (any, error) { return Float64ToUint16(float64(1)) }, uint16(1), nil},
+	{"Float64ToUint16(float64(42.5))", func() (any, error) { return Float64ToUint16(float64(42.5)) }, uint16(42), nil},
+	{"Float64ToUint16(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToUint16(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToUint16(math.Inf(1))", func() (any, error) { return Float64ToUint16(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToUint16(math.NaN())", func() (any, error) { return Float64ToUint16(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToUint32(math.Inf(-1))", func() (any, error) { return Float64ToUint32(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint32(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToUint32(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToUint32(float64(-42.5))", func() (any, error) { return Float64ToUint32(float64(-42.5)) }, nil, ErrUnderflow},
+	{"Float64ToUint32(float64(-1))", func() (any, error) { return Float64ToUint32(float64(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint32(float64(0))", func() (any, error) { return Float64ToUint32(float64(0)) }, uint32(0), nil},
+	{"Float64ToUint32(float64(1))", func() (any, error) { return Float64ToUint32(float64(1)) }, uint32(1), nil},
+	{"Float64ToUint32(float64(42.5))", func() (any, error) { return Float64ToUint32(float64(42.5)) }, uint32(42), nil},
+	{"Float64ToUint32(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToUint32(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToUint32(math.Inf(1))", func() (any, error) { return Float64ToUint32(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToUint32(math.NaN())", func() (any, error) { return Float64ToUint32(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToUint64(math.Inf(-1))", func() (any, error) { return Float64ToUint64(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint64(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToUint64(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToUint64(float64(-42.5))", func() (any, error) { return Float64ToUint64(float64(-42.5)) }, nil, ErrUnderflow},
+	{"Float64ToUint64(float64(-1))", func() (any, error) { return Float64ToUint64(float64(-1)) }, nil, ErrUnderflow},
+	{"Float64ToUint64(float64(0))", func() (any, error) { return Float64ToUint64(float64(0)) }, uint64(0), nil},
+	{"Float64ToUint64(float64(1))", func() (any, error) { return Float64ToUint64(float64(1)) }, uint64(1), nil},
+	{"Float64ToUint64(float64(42.5))", func() (any, error) { return Float64ToUint64(float64(42.5)) }, uint64(42), nil},
+	{"Float64ToUint64(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToUint64(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToUint64(math.Inf(1))", func() (any, error) { return Float64ToUint64(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToUint64(math.NaN())", func() (any, error) { return Float64ToUint64(math.NaN()) }, nil, ErrNaN},
+	{"Float64ToFloat32(math.Inf(-1))", func() (any, error) { return Float64ToFloat32(math.Inf(-1)) }, nil, ErrUnderflow},
+	{"Float64ToFloat32(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToFloat32(float64(-1.7976931348623157e+308)) }, nil, ErrUnderflow},
+	{"Float64ToFloat32(float64(-42.5))", func() (any, error) { return Float64ToFloat32(float64(-42.5)) }, float32(-42.5), nil},
+	{"Float64ToFloat32(float64(-1))", func() (any, error) { return Float64ToFloat32(float64(-1)) }, float32(-1), nil},
+	{"Float64ToFloat32(float64(0))", func() (any, error) { return Float64ToFloat32(float64(0)) }, float32(0), nil},
+	{"Float64ToFloat32(float64(1))", func() (any, error) { return Float64ToFloat32(float64(1)) }, float32(1), nil},
+	{"Float64ToFloat32(float64(42.5))", func() (any, error) { return Float64ToFloat32(float64(42.5)) }, float32(42.5), nil},
+	{"Float64ToFloat32(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToFloat32(float64(1.7976931348623157e+308)) }, nil, ErrOverflow},
+	{"Float64ToFloat32(math.Inf(1))", func() (any, error) { return Float64ToFloat32(math.Inf(1)) }, nil, ErrOverflow},
+	{"Float64ToFloat32(math.NaN())", func() (any, error) { return Float64ToFloat32(math.NaN()) }, float32(math.NaN()), nil},
+	{"Float64ToString(math.Inf(-1))", func() (any, error) { return Float64ToString(math.Inf(-1)) }, "-Inf", nil},
+	{"Float64ToString(float64(-1.7976931348623157e+308))", func() (any, error) { return Float64ToString(float64(-1.7976931348623157e+308)) }, "-179769313486231570000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", nil},
+	{"Float64ToString(float64(-42.5))", func() (any, error) { return Float64ToString(float64(-42.5)) }, "-42.5", nil},
+	{"Float64ToString(float64(-1))", func() (any, error) { return Float64ToString(float64(-1)) }, "-1", nil},
+	{"Float64ToString(float64(0))", func() (any, error) { return Float64ToString(float64(0)) }, "0", nil},
+	{"Float64ToString(float64(1))", func() (any, error) { return Float64ToString(float64(1)) }, "1", nil},
+	{"Float64ToString(float64(42.5))", func() (any, error) { return Float64ToString(float64(42.5)) }, "42.5", nil},
+	{"Float64ToString(float64(1.7976931348623157e+308))", func() (any, error) { return Float64ToString(float64(1.7976931348623157e+308)) }, "179769313486231570000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", nil},
+	{"Float64ToString(math.Inf(1))", func() (any, error) { return Float64ToString(math.Inf(1)) }, "+Inf", nil},
+	{"Float64ToString(math.NaN())", func() (any, error) { return Float64ToString(math.NaN()) }, "NaN", nil},
+	{"StringToBool(\"\")", func() (any, error) { return StringToBool("") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"0\")", func() (any, error) { return StringToBool("0") }, false, nil},
+	{"StringToBool(\"1\")", func() (any, error) { return StringToBool("1") }, true, nil},
+	{"StringToBool(\"-1\")", func() (any, error) { return StringToBool("-1") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"42\")", func() (any, error) { return StringToBool("42") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"+42\")", func() (any, error) { return StringToBool("+42") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"42.5\")", func() (any, error) { return StringToBool("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"-42.5\")", func() (any, error) { return StringToBool("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"1e3\")", func() (any, error) { return StringToBool("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"abc\")", func() (any, error) { return StringToBool("abc") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"true\")", func() (any, error) { return StringToBool("true") }, true, nil},
+	{"StringToBool(\"false\")", func() (any, error) { return StringToBool("false") }, false, nil},
+	{"StringToBool(\"127\")", func() (any, error) { return StringToBool("127") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"128\")", func() (any, error) { return StringToBool("128") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"-128\")", func() (any, error) { return StringToBool("-128") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"-129\")", func() (any, error) { return StringToBool("-129") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"255\")", func() (any, error) { return StringToBool("255") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"256\")", func() (any, error) { return StringToBool("256") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"32767\")", func() (any, error) { return StringToBool("32767") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"32768\")", func() (any, error) { return StringToBool("32768") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"65535\")", func() (any, error) { return StringToBool("65535") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"65536\")", func() (any, error) { return StringToBool("65536") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"2147483647\")", func() (any, error) { return StringToBool("2147483647") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"2147483648\")", func() (any, error) { return StringToBool("2147483648") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"-2147483649\")", func() (any, error) { return StringToBool("-2147483649") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"4294967295\")", func() (any, error) { return StringToBool("4294967295") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"4294967296\")", func() (any, error) { return StringToBool("4294967296") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"9223372036854775807\")", func() (any, error) { return StringToBool("9223372036854775807") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"9223372036854775808\")", func() (any, error) { return StringToBool("9223372036854775808") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"-9223372036854775808\")", func() (any, error) { return StringToBool("-9223372036854775808") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"-9223372036854775809\")", func() (any, error) { return StringToBool("-9223372036854775809") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"18446744073709551615\")", func() (any, error) { return StringToBool("18446744073709551615") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"18446744073709551616\")", func() (any, error) { return StringToBool("18446744073709551616") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"3.4028235e38\")", func() (any, error) { return StringToBool("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"3.5e38\")", func() (any, error) { return StringToBool("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"1.7976931348623157e308\")", func() (any, error) { return StringToBool("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"1e309\")", func() (any, error) { return StringToBool("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"NaN\")", func() (any, error) { return StringToBool("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"Inf\")", func() (any, error) { return StringToBool("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToBool(\"-Inf\")", func() (any, error) { return StringToBool("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"\")", func() (any, error) { return StringToInt("") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"0\")", func() (any, error) { return StringToInt("0") }, int(0), nil},
+	{"StringToInt(\"1\")", func() (any, error) { return StringToInt("1") }, int(1), nil},
+	{"StringToInt(\"-1\")", func() (any, error) { return StringToInt("-1") }, int(-1), nil},
+	{"StringToInt(\"42\")", func() (any, error) { return StringToInt("42") }, int(42), nil},
+	{"StringToInt(\"+42\")", func() (any, error) { return StringToInt("+42") }, int(42), nil},
+	{"StringToInt(\"42.5\")", func() (any, error) { return StringToInt("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"-42.5\")", func() (any, error) { return StringToInt("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"1e3\")", func() (any, error) { return StringToInt("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"abc\")", func() (any, error) { return StringToInt("abc") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"true\")", func() (any, error) { return StringToInt("true") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"false\")", func() (any, error) { return StringToInt("false") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"127\")", func() (any, error) { return StringToInt("127") }, int(127), nil},
+	{"StringToInt(\"128\")", func() (any, error) { return StringToInt("128") }, int(128), nil},
+	{"StringToInt(\"-128\")", func() (any, error) { return StringToInt("-128") }, int(-128), nil},
+	{"StringToInt(\"-129\")", func() (any, error) { return StringToInt("-129") }, int(-129), nil},
+	{"StringToInt(\"255\")", func() (any, error) { return StringToInt("255") }, int(255), nil},
+	{"StringToInt(\"256\")", func() (any, error) { return StringToInt("256") }, int(256), nil},
+	{"StringToInt(\"32767\")", func() (any, error) { return StringToInt("32767") }, int(32767), nil},
+	{"StringToInt(\"32768\")", func() (any, error) { return StringToInt("32768") }, int(32768), nil},
+	{"StringToInt(\"65535\")", func() (any, error) { return StringToInt("65535") }, int(65535), nil},
+	{"StringToInt(\"65536\")", func() (any, error) { return StringToInt("65536") }, int(65536), nil},
+	{"StringToInt(\"2147483647\")", func() (any, error) { return StringToInt("2147483647") }, int(2147483647), nil},
+	{"StringToInt(\"2147483648\")", func() (any, error) { return StringToInt("2147483648") }, int(2147483648), nil},
+	{"StringToInt(\"-2147483649\")", func() (any, error) { return StringToInt("-2147483649") }, int(-2147483649), nil},
+	{"StringToInt(\"4294967295\")", func() (any, error) { return StringToInt("4294967295") }, int(4294967295), nil},
+	{"StringToInt(\"4294967296\")", func() (any, error) { return StringToInt("4294967296") }, int(4294967296), nil},
+	{"StringToInt(\"9223372036854775807\")", func() (any, error) { return StringToInt("9223372036854775807") }, int(9223372036854775807), nil},
+	{"StringToInt(\"9223372036854775808\")", func() (any, error) { return StringToInt("9223372036854775808") }, nil, ErrOverflow},
+	{"StringToInt(\"-9223372036854775808\")", func() (any, error) { return StringToInt("-9223372036854775808") }, int(-9223372036854775808), nil},
+	{"StringToInt(\"-9223372036854775809\")", func() (any, error) { return StringToInt("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToInt(\"18446744073709551615\")", func() (any, error) { return StringToInt("18446744073709551615") }, nil, ErrOverflow},
+	{"StringToInt(\"18446744073709551616\")", func() (any, error) { return StringToInt("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToInt(\"3.4028235e38\")", func() (any, error) { return StringToInt("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"3.5e38\")", func() (any, error) { return StringToInt("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"1.7976931348623157e308\")", func() (any, error) { return StringToInt("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"1e309\")", func() (any, error) { return StringToInt("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"NaN\")", func() (any, error) { return StringToInt("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"Inf\")", func() (any, error) { return StringToInt("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt(\"-Inf\")", func() (any, error) { return StringToInt("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"\")", func() (any, error) { return StringToInt8("") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"0\")", func() (any, error) { return StringToInt8("0") }, int8(0), nil},
+	{"StringToInt8(\"1\")", func() (any, error) { return StringToInt8("1") }, int8(1), nil},
+	{"StringToInt8(\"-1\")", func() (any, error) { return StringToInt8("-1") }, int8(-1), nil},
+	{"StringToInt8(\"42\")", func() (any, error) { return StringToInt8("42") }, int8(42), nil},
+	{"StringToInt8(\"+42\")", func() (any, error) { return StringToInt8("+42") }, int8(42), nil},
+	{"StringToInt8(\"42.5\")", func() (any, error) { return StringToInt8("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"-42.5\")", func() (any, error) { return StringToInt8("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"1e3\")", func() (any, error) { return StringToInt8("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"abc\")", func() (any, error) { return StringToInt8("abc") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"true\")", func() (any, error) { return StringToInt8("true") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"false\")", func() (any, error) { return StringToInt8("false") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"127\")", func() (any, error) { return StringToInt8("127") }, int8(127), nil},
+	{"StringToInt8(\"128\")", func() (any, error) { return StringToInt8("128") }, nil, ErrOverflow},
+	{"StringToInt8(\"-128\")", func() (any, error) { return StringToInt8("-128") }, int8(-128), nil},
+	{"StringToInt8(\"-129\")", func() (any, error) { return StringToInt8("-129") }, nil, ErrUnderflow},
+	{"StringToInt8(\"255\")", func() (any, error) { return StringToInt8("255") }, nil, ErrOverflow},
+	{"StringToInt8(\"256\")", func() (any, error) { return StringToInt8("256") }, nil, ErrOverflow},
+	{"StringToInt8(\"32767\")", func() (any, error) { return StringToInt8("32767") }, nil, ErrOverflow},
+	{"StringToInt8(\"32768\")", func() (any, error) { return StringToInt8("32768") }, nil, ErrOverflow},
+	{"StringToInt8(\"65535\")", func() (any, error) { return StringToInt8("65535") }, nil, ErrOverflow},
+	{"StringToInt8(\"65536\")", func() (any, error) { return StringToInt8("65536") }, nil, ErrOverflow},
+	{"StringToInt8(\"2147483647\")", func() (any, error) { return StringToInt8("2147483647") }, nil, ErrOverflow},
+	{"StringToInt8(\"2147483648\")", func() (any, error) { return StringToInt8("2147483648") }, nil, ErrOverflow},
+	{"StringToInt8(\"-2147483649\")", func() (any, error) { return StringToInt8("-2147483649") }, nil, ErrUnderflow},
+	{"StringToInt8(\"4294967295\")", func() (any, error) { return StringToInt8("4294967295") }, nil, ErrOverflow},
+	{"StringToInt8(\"4294967296\")", func() (any, error) { return StringToInt8("4294967296") }, nil, ErrOverflow},
+	{"StringToInt8(\"9223372036854775807\")", func() (any, error) { return StringToInt8("9223372036854775807") }, nil, ErrOverflow},
+	{"StringToInt8(\"9223372036854775808\")", func() (any, error) { return StringToInt8("9223372036854775808") }, nil, ErrOverflow},
+	{"StringToInt8(\"-9223372036854775808\")", func() (any, error) { return StringToInt8("-9223372036854775808") }, nil, ErrUnderflow},
+	{"StringToInt8(\"-9223372036854775809\")", func() (any, error) { return StringToInt8("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToInt8(\"18446744073709551615\")", func() (any, error) { return StringToInt8("18446744073709551615") }, nil, ErrOverflow},
+	{"StringToInt8(\"18446744073709551616\")", func() (any, error) { return StringToInt8("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToInt8(\"3.4028235e38\")", func() (any, error) { return StringToInt8("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"3.5e38\")", func() (any, error) { return StringToInt8("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"1.7976931348623157e308\")", func() (any, error) { return StringToInt8("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"1e309\")", func() (any, error) { return StringToInt8("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"NaN\")", func() (any, error) { return StringToInt8("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"Inf\")", func() (any, error) { return StringToInt8("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt8(\"-Inf\")", func() (any, error) { return StringToInt8("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"\")", func() (any, error) { return StringToInt16("") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"0\")", func() (any, error) { return StringToInt16("0") }, int16(0), nil},
+	{"StringToInt16(\"1\")", func() (any, error) { return StringToInt16("1") }, int16(1), nil},
+	{"StringToInt16(\"-1\")", func() (any, error) { return StringToInt16("-1") }, int16(-1), nil},
+	{"StringToInt16(\"42\")", func() (any, error) { return StringToInt16("42") }, int16(42), nil},
+	{"StringToInt16(\"+42\")", func() (any, error) { return StringToInt16("+42") }, int16(42), nil},
+	{"StringToInt16(\"42.5\")", func() (any, error) { return StringToInt16("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"-42.5\")", func() (any, error) { return StringToInt16("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"1e3\")", func() (any, error) { return StringToInt16("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"abc\")", func() (any, error) { return StringToInt16("abc") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"true\")", func() (any, error) { return StringToInt16("true") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"false\")", func() (any, error) { return StringToInt16("false") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"127\")", func() (any, error) { return StringToInt16("127") }, int16(127), nil},
+	{"StringToInt16(\"128\")", func() (any, error) { return StringToInt16("128") }, int16(128), nil},
+	{"StringToInt16(\"-128\")", func() (any, error) { return StringToInt16("-128") }, int16(-128), nil},
+	{"StringToInt16(\"-129\")", func() (any, error) { return StringToInt16("-129") }, int16(-129), nil},
+	{"StringToInt16(\"255\")", func() (any, error) { return StringToInt16("255") }, int16(255), nil},
+	{"StringToInt16(\"256\")", func() (any, error) { return StringToInt16("256") }, int16(256), nil},
+	{"StringToInt16(\"32767\")", func() (any, error) { return StringToInt16("32767") }, int16(32767), nil},
+	{"StringToInt16(\"32768\")", func() (any, error) { return StringToInt16("32768") }, nil, ErrOverflow},
+	{"StringToInt16(\"65535\")", func() (any, error) { return StringToInt16("65535") }, nil, ErrOverflow},
+	{"StringToInt16(\"65536\")", func() (any, error) { return StringToInt16("65536") }, nil, ErrOverflow},
+	{"StringToInt16(\"2147483647\")", func() (any, error) { return StringToInt16("2147483647") }, nil, ErrOverflow},
+	{"StringToInt16(\"2147483648\")", func() (any, error) { return StringToInt16("2147483648") }, nil, ErrOverflow},
+	{"StringToInt16(\"-2147483649\")", func() (any, error) { return StringToInt16("-2147483649") }, nil, ErrUnderflow},
+	{"StringToInt16(\"4294967295\")", func() (any, error) { return StringToInt16("4294967295") }, nil, ErrOverflow},
+	{"StringToInt16(\"4294967296\")", func() (any, error) { return StringToInt16("4294967296") }, nil, ErrOverflow},
+	{"StringToInt16(\"9223372036854775807\")", func() (any, error) { return StringToInt16("9223372036854775807") }, nil, ErrOverflow},
+	{"StringToInt16(\"9223372036854775808\")", func() (any, error) { return StringToInt16("9223372036854775808") }, nil, ErrOverflow},
+	{"StringToInt16(\"-9223372036854775808\")", func() (any, error) { return StringToInt16("-9223372036854775808") }, nil, ErrUnderflow},
+	{"StringToInt16(\"-9223372036854775809\")", func() (any, error) { return StringToInt16("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToInt16(\"18446744073709551615\")", func() (any, error) { return StringToInt16("18446744073709551615") }, nil, ErrOverflow},
+	{"StringToInt16(\"18446744073709551616\")", func() (any, error) { return StringToInt16("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToInt16(\"3.4028235e38\")", func() (any, error) { return StringToInt16("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"3.5e38\")", func() (any, error) { return StringToInt16("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"1.7976931348623157e308\")", func() (any, error) { return StringToInt16("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"1e309\")", func() (any, error) { return StringToInt16("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"NaN\")", func() (any, error) { return StringToInt16("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"Inf\")", func() (any, error) { return StringToInt16("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt16(\"-Inf\")", func() (any, error) { return StringToInt16("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"\")", func() (any, error) { return StringToInt32("") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"0\")", func() (any, error) { return StringToInt32("0") }, int32(0), nil},
+	{"StringToInt32(\"1\")", func() (any, error) { return StringToInt32("1") }, int32(1), nil},
+	{"StringToInt32(\"-1\")", func() (any, error) { return StringToInt32("-1") }, int32(-1), nil},
+	{"StringToInt32(\"42\")", func() (any, error) { return StringToInt32("42") }, int32(42), nil},
+	{"StringToInt32(\"+42\")", func() (any, error) { return StringToInt32("+42") }, int32(42), nil},
+	{"StringToInt32(\"42.5\")", func() (any, error) { return StringToInt32("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"-42.5\")", func() (any, error) { return StringToInt32("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"1e3\")", func() (any, error) { return StringToInt32("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"abc\")", func() (any, error) { return StringToInt32("abc") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"true\")", func() (any, error) { return StringToInt32("true") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"false\")", func() (any, error) { return StringToInt32("false") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"127\")", func() (any, error) { return StringToInt32("127") }, int32(127), nil},
+	{"StringToInt32(\"128\")", func() (any, error) { return StringToInt32("128") }, int32(128), nil},
+	{"StringToInt32(\"-128\")", func() (any, error) { return StringToInt32("-128") }, int32(-128), nil},
+	{"StringToInt32(\"-129\")", func() (any, error) { return StringToInt32("-129") }, int32(-129), nil},
+	{"StringToInt32(\"255\")", func() (any, error) { return StringToInt32("255") }, int32(255), nil},
+	{"StringToInt32(\"256\")", func() (any, error) { return StringToInt32("256") }, int32(256), nil},
+	{"StringToInt32(\"32767\")", func() (any, error) { return StringToInt32("32767") }, int32(32767), nil},
+	{"StringToInt32(\"32768\")", func() (any, error) { return StringToInt32("32768") }, int32(32768), nil},
+	{"StringToInt32(\"65535\")", func() (any, error) { return StringToInt32("65535") }, int32(65535), nil},
+	{"StringToInt32(\"65536\")", func() (any, error) { return StringToInt32("65536") }, int32(65536), nil},
+	{"StringToInt32(\"2147483647\")", func() (any, error) { return StringToInt32("2147483647") }, int32(2147483647), nil},
+	{"StringToInt32(\"2147483648\")", func() (any, error) { return StringToInt32("2147483648") }, nil, ErrOverflow},
+	{"StringToInt32(\"-2147483649\")", func() (any, error) { return StringToInt32("-2147483649") }, nil, ErrUnderflow},
+	{"StringToInt32(\"4294967295\")", func() (any, error) { return StringToInt32("4294967295") }, nil, ErrOverflow},
+	{"StringToInt32(\"4294967296\")", func() (any, error) { return StringToInt32("4294967296") }, nil, ErrOverflow},
+	{"StringToInt32(\"9223372036854775807\")", func() (any, error) { return StringToInt32("9223372036854775807") }, nil, ErrOverflow},
+	{"StringToInt32(\"9223372036854775808\")", func() (any, error) { return StringToInt32("9223372036854775808") }, nil, ErrOverflow},
+	{"StringToInt32(\"-9223372036854775808\")", func() (any, error) { return StringToInt32("-9223372036854775808") }, nil, ErrUnderflow},
+	{"StringToInt32(\"-9223372036854775809\")", func() (any, error) { return StringToInt32("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToInt32(\"18446744073709551615\")", func() (any, error) { return StringToInt32("18446744073709551615") }, nil, ErrOverflow},
+	{"StringToInt32(\"18446744073709551616\")", func() (any, error) { return StringToInt32("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToInt32(\"3.4028235e38\")", func() (any, error) { return StringToInt32("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"3.5e38\")", func() (any, error) { return StringToInt32("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"1.7976931348623157e308\")", func() (any, error) { return StringToInt32("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"1e309\")", func() (any, error) { return StringToInt32("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"NaN\")", func() (any, error) { return StringToInt32("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"Inf\")", func() (any, error) { return StringToInt32("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt32(\"-Inf\")", func() (any, error) { return StringToInt32("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"\")", func() (any, error) { return StringToInt64("") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"0\")", func() (any, error) { return StringToInt64("0") }, int64(0), nil},
+	{"StringToInt64(\"1\")", func() (any, error) { return StringToInt64("1") }, int64(1), nil},
+	{"StringToInt64(\"-1\")", func() (any, error) { return StringToInt64("-1") }, int64(-1), nil},
+	{"StringToInt64(\"42\")", func() (any, error) { return StringToInt64("42") }, int64(42), nil},
+	{"StringToInt64(\"+42\")", func() (any, error) { return StringToInt64("+42") }, int64(42), nil},
+	{"StringToInt64(\"42.5\")", func() (any, error) { return StringToInt64("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"-42.5\")", func() (any, error) { return StringToInt64("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"1e3\")", func() (any, error) { return StringToInt64("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"abc\")", func() (any, error) { return StringToInt64("abc") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"true\")", func() (any, error) { return StringToInt64("true") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"false\")", func() (any, error) { return StringToInt64("false") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"127\")", func() (any, error) { return StringToInt64("127") }, int64(127), nil},
+	{"StringToInt64(\"128\")", func() (any, error) { return StringToInt64("128") }, int64(128), nil},
+	{"StringToInt64(\"-128\")", func() (any, error) { return StringToInt64("-128") }, int64(-128), nil},
+	{"StringToInt64(\"-129\")", func() (any, error) { return StringToInt64("-129") }, int64(-129), nil},
+	{"StringToInt64(\"255\")", func() (any, error) { return StringToInt64("255") }, int64(255), nil},
+	{"StringToInt64(\"256\")", func() (any, error) { return StringToInt64("256") }, int64(256), nil},
+	{"StringToInt64(\"32767\")", func() (any, error) { return StringToInt64("32767") }, int64(32767), nil},
+	{"StringToInt64(\"32768\")", func() (any, error) { return StringToInt64("32768") }, int64(32768), nil},
+	{"StringToInt64(\"65535\")", func() (any, error) { return StringToInt64("65535") }, int64(65535), nil},
+	{"StringToInt64(\"65536\")", func() (any, error) { return StringToInt64("65536") }, int64(65536), nil},
+	{"StringToInt64(\"2147483647\")", func() (any, error) { return StringToInt64("2147483647") }, int64(2147483647), nil},
+	{"StringToInt64(\"2147483648\")", func() (any, error) { return StringToInt64("2147483648") }, int64(2147483648), nil},
+	{"StringToInt64(\"-2147483649\")", func() (any, error) { return StringToInt64("-2147483649") }, int64(-2147483649), nil},
+	{"StringToInt64(\"4294967295\")", func() (any, error) { return StringToInt64("4294967295") }, int64(4294967295), nil},
+	{"StringToInt64(\"4294967296\")", func() (any, error) { return StringToInt64("4294967296") }, int64(4294967296), nil},
+	{"StringToInt64(\"9223372036854775807\")", func() (any, error) { return StringToInt64("9223372036854775807") }, int64(9223372036854775807), nil},
+	{"StringToInt64(\"9223372036854775808\")", func() (any, error) { return StringToInt64("9223372036854775808") }, nil, ErrOverflow},
+	{"StringToInt64(\"-9223372036854775808\")", func() (any, error) { return StringToInt64("-9223372036854775808") }, int64(-9223372036854775808), nil},
+	{"StringToInt64(\"-9223372036854775809\")", func() (any, error) { return StringToInt64("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToInt64(\"18446744073709551615\")", func() (any, error) { return StringToInt64("18446744073709551615") }, nil, ErrOverflow},
+	{"StringToInt64(\"18446744073709551616\")", func() (any, error) { return StringToInt64("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToInt64(\"3.4028235e38\")", func() (any, error) { return StringToInt64("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"3.5e38\")", func() (any, error) { return StringToInt64("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"1.7976931348623157e308\")", func() (any, error) { return StringToInt64("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"1e309\")", func() (any, error) { return StringToInt64("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"NaN\")", func() (any, error) { return StringToInt64("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"Inf\")", func() (any, error) { return StringToInt64("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToInt64(\"-Inf\")", func() (any, error) { return StringToInt64("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"\")", func() (any, error) { return StringToUint("") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"0\")", func() (any, error) { return StringToUint("0") }, uint(0), nil},
+	{"StringToUint(\"1\")", func() (any, error) { return StringToUint("1") }, uint(1), nil},
+	{"StringToUint(\"-1\")", func() (any, error) { return StringToUint("-1") }, nil, ErrUnderflow},
+	{"StringToUint(\"42\")", func() (any, error) { return StringToUint("42") }, uint(42), nil},
+	{"StringToUint(\"+42\")", func() (any, error) { return StringToUint("+42") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"42.5\")", func() (any, error) { return StringToUint("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"-42.5\")", func() (any, error) { return StringToUint("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"1e3\")", func() (any, error) { return StringToUint("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"abc\")", func() (any, error) { return StringToUint("abc") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"true\")", func() (any, error) { return StringToUint("true") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"false\")", func() (any, error) { return StringToUint("false") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"127\")", func() (any, error) { return StringToUint("127") }, uint(127), nil},
+	{"StringToUint(\"128\")", func() (any, error) { return StringToUint("128") }, uint(128), nil},
+	{"StringToUint(\"-128\")", func() (any, error) { return StringToUint("-128") }, nil, ErrUnderflow},
+	{"StringToUint(\"-129\")", func() (any, error) { return StringToUint("-129") }, nil, ErrUnderflow},
+	{"StringToUint(\"255\")", func() (any, error) { return StringToUint("255") }, uint(255), nil},
+	{"StringToUint(\"256\")", func() (any, error) { return StringToUint("256") }, uint(256), nil},
+	{"StringToUint(\"32767\")", func() (any, error) { return StringToUint("32767") }, uint(32767), nil},
+	{"StringToUint(\"32768\")", func() (any, error) { return StringToUint("32768") }, uint(32768), nil},
+	{"StringToUint(\"65535\")", func() (any, error) { return StringToUint("65535") }, uint(65535), nil},
+	{"StringToUint(\"65536\")", func() (any, error) { return StringToUint("65536") }, uint(65536), nil},
+	{"StringToUint(\"2147483647\")", func() (any, error) { return StringToUint("2147483647") }, uint(2147483647), nil},
+	{"StringToUint(\"2147483648\")", func() (any, error) { return StringToUint("2147483648") }, uint(2147483648), nil},
+	{"StringToUint(\"-2147483649\")", func() (any, error) { return StringToUint("-2147483649") }, nil, ErrUnderflow},
+	{"StringToUint(\"4294967295\")", func() (any, error) { return StringToUint("4294967295") }, uint(4294967295), nil},
+	{"StringToUint(\"4294967296\")", func() (any, error) { return StringToUint("4294967296") }, uint(4294967296), nil},
+	{"StringToUint(\"9223372036854775807\")", func() (any, error) { return StringToUint("9223372036854775807") }, uint(9223372036854775807), nil},
+	{"StringToUint(\"9223372036854775808\")", func() (any, error) { return StringToUint("9223372036854775808") }, uint(9223372036854775808), nil},
+	{"StringToUint(\"-9223372036854775808\")", func() (any, error) { return StringToUint("-9223372036854775808") }, nil, ErrUnderflow},
+	{"StringToUint(\"-9223372036854775809\")", func() (any, error) { return StringToUint("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToUint(\"18446744073709551615\")", func() (any, error) { return StringToUint("18446744073709551615") }, uint(18446744073709551615), nil},
+	{"StringToUint(\"18446744073709551616\")", func() (any, error) { return StringToUint("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToUint(\"3.4028235e38\")", func() (any, error) { return StringToUint("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"3.5e38\")", func() (any, error) { return StringToUint("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"1.7976931348623157e308\")", func() (any, error) { return StringToUint("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"1e309\")", func() (any, error) { return StringToUint("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"NaN\")", func() (any, error) { return StringToUint("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"Inf\")", func() (any, error) { return StringToUint("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint(\"-Inf\")", func() (any, error) { return StringToUint("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"\")", func() (any, error) { return StringToUint8("") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"0\")", func() (any, error) { return StringToUint8("0") }, uint8(0), nil},
+	{"StringToUint8(\"1\")", func() (any, error) { return StringToUint8("1") }, uint8(1), nil},
+	{"StringToUint8(\"-1\")", func() (any, error) { return StringToUint8("-1") }, nil, ErrUnderflow},
+	{"StringToUint8(\"42\")", func() (any, error) { return StringToUint8("42") }, uint8(42), nil},
+	{"StringToUint8(\"+42\")", func() (any, error) { return StringToUint8("+42") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"42.5\")", func() (any, error) { return StringToUint8("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"-42.5\")", func() (any, error) { return StringToUint8("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"1e3\")", func() (any, error) { return StringToUint8("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"abc\")", func() (any, error) { return StringToUint8("abc") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"true\")", func() (any, error) { return StringToUint8("true") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"false\")", func() (any, error) { return StringToUint8("false") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"127\")", func() (any, error) { return StringToUint8("127") }, uint8(127), nil},
+	{"StringToUint8(\"128\")", func() (any, error) { return StringToUint8("128") }, uint8(128), nil},
+	{"StringToUint8(\"-128\")", func() (any, error) { return StringToUint8("-128") }, nil, ErrUnderflow},
+	{"StringToUint8(\"-129\")", func() (any, error) { return StringToUint8("-129") }, nil, ErrUnderflow},
+	{"StringToUint8(\"255\")", func() (any, error) { return StringToUint8("255") }, uint8(255), nil},
+	{"StringToUint8(\"256\")", func() (any, error) { return StringToUint8("256") }, nil, ErrOverflow},
+	{"StringToUint8(\"32767\")", func() (any, error) { return StringToUint8("32767") }, nil, ErrOverflow},
+	{"StringToUint8(\"32768\")", func() (any, error) { return StringToUint8("32768") }, nil, ErrOverflow},
+	{"StringToUint8(\"65535\")", func() (any, error) { return StringToUint8("65535") }, nil, ErrOverflow},
+	{"StringToUint8(\"65536\")", func() (any, error) { return StringToUint8("65536") }, nil, ErrOverflow},
+	{"StringToUint8(\"2147483647\")", func() (any, error) { return StringToUint8("2147483647") }, nil, ErrOverflow},
+	{"StringToUint8(\"2147483648\")", func() (any, error) { return StringToUint8("2147483648") }, nil, ErrOverflow},
+	{"StringToUint8(\"-2147483649\")", func() (any, error) { return StringToUint8("-2147483649") }, nil, ErrUnderflow},
+	{"StringToUint8(\"4294967295\")", func() (any, error) { return StringToUint8("4294967295") }, nil, ErrOverflow},
+	{"StringToUint8(\"4294967296\")", func() (any, error) { return StringToUint8("4294967296") }, nil, ErrOverflow},
+	{"StringToUint8(\"9223372036854775807\")", func() (any, error) { return StringToUint8("9223372036854775807") }, nil, ErrOverflow},
+	{"StringToUint8(\"9223372036854775808\")", func() (any, error) { return StringToUint8("9223372036854775808") }, nil, ErrOverflow},
+	{"StringToUint8(\"-9223372036854775808\")", func() (any, error) { return StringToUint8("-9223372036854775808") }, nil, ErrUnderflow},
+	{"StringToUint8(\"-9223372036854775809\")", func() (any, error) { return StringToUint8("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToUint8(\"18446744073709551615\")", func() (any, error) { return StringToUint8("18446744073709551615") }, nil, ErrOverflow},
+	{"StringToUint8(\"18446744073709551616\")", func() (any, error) { return StringToUint8("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToUint8(\"3.4028235e38\")", func() (any, error) { return StringToUint8("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"3.5e38\")", func() (any, error) { return StringToUint8("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"1.7976931348623157e308\")", func() (any, error) { return StringToUint8("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"1e309\")", func() (any, error) { return StringToUint8("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"NaN\")", func() (any, error) { return StringToUint8("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"Inf\")", func() (any, error) { return StringToUint8("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint8(\"-Inf\")", func() (any, error) { return StringToUint8("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"\")", func() (any, error) { return StringToUint16("") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"0\")", func() (any, error) { return StringToUint16("0") }, uint16(0), nil},
+	{"StringToUint16(\"1\")", func() (any, error) { return StringToUint16("1") }, uint16(1), nil},
+	{"StringToUint16(\"-1\")", func() (any, error) { return StringToUint16("-1") }, nil, ErrUnderflow},
+	{"StringToUint16(\"42\")", func() (any, error) { return StringToUint16("42") }, uint16(42), nil},
+	{"StringToUint16(\"+42\")", func() (any, error) { return StringToUint16("+42") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"42.5\")", func() (any, error) { return StringToUint16("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"-42.5\")", func() (any, error) { return StringToUint16("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"1e3\")", func() (any, error) { return StringToUint16("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"abc\")", func() (any, error) { return StringToUint16("abc") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"true\")", func() (any, error) { return StringToUint16("true") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"false\")", func() (any, error) { return StringToUint16("false") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"127\")", func() (any, error) { return StringToUint16("127") }, uint16(127), nil},
+	{"StringToUint16(\"128\")", func() (any, error) { return StringToUint16("128") }, uint16(128), nil},
+	{"StringToUint16(\"-128\")", func() (any, error) { return StringToUint16("-128") }, nil, ErrUnderflow},
+	{"StringToUint16(\"-129\")", func() (any, error) { return StringToUint16("-129") }, nil, ErrUnderflow},
+	{"StringToUint16(\"255\")", func() (any, error) { return StringToUint16("255") }, uint16(255), nil},
+	{"StringToUint16(\"256\")", func() (any, error) { return StringToUint16("256") }, uint16(256), nil},
+	{"StringToUint16(\"32767\")", func() (any, error) { return StringToUint16("32767") }, uint16(32767), nil},
+	{"StringToUint16(\"32768\")", func() (any, error) { return StringToUint16("32768") }, uint16(32768), nil},
+	{"StringToUint16(\"65535\")", func() (any, error) { return StringToUint16("65535") }, uint16(65535), nil},
+	{"StringToUint16(\"65536\")", func() (any, error) { return StringToUint16("65536") }, nil, ErrOverflow},
+	{"StringToUint16(\"2147483647\")", func() (any, error) { return StringToUint16("2147483647") }, nil, ErrOverflow},
+	{"StringToUint16(\"2147483648\")", func() (any, error) { return StringToUint16("2147483648") }, nil, ErrOverflow},
+	{"StringToUint16(\"-2147483649\")", func() (any, error) { return StringToUint16("-2147483649") }, nil, ErrUnderflow},
+	{"StringToUint16(\"4294967295\")", func() (any, error) { return StringToUint16("4294967295") }, nil, ErrOverflow},
+	{"StringToUint16(\"4294967296\")", func() (any, error) { return StringToUint16("4294967296") }, nil, ErrOverflow},
+	{"StringToUint16(\"9223372036854775807\")", func() (any, error) { return StringToUint16("9223372036854775807") }, nil, ErrOverflow},
+	{"StringToUint16(\"9223372036854775808\")", func() (any, error) { return StringToUint16("9223372036854775808") }, nil, ErrOverflow},
+	{"StringToUint16(\"-9223372036854775808\")", func() (any, error) { return StringToUint16("-9223372036854775808") }, nil, ErrUnderflow},
+	{"StringToUint16(\"-9223372036854775809\")", func() (any, error) { return StringToUint16("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToUint16(\"18446744073709551615\")", func() (any, error) { return StringToUint16("18446744073709551615") }, nil, ErrOverflow},
+	{"StringToUint16(\"18446744073709551616\")", func() (any, error) { return StringToUint16("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToUint16(\"3.4028235e38\")", func() (any, error) { return StringToUint16("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"3.5e38\")", func() (any, error) { return StringToUint16("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"1.7976931348623157e308\")", func() (any, error) { return StringToUint16("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"1e309\")", func() (any, error) { return StringToUint16("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"NaN\")", func() (any, error) { return StringToUint16("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"Inf\")", func() (any, error) { return StringToUint16("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint16(\"-Inf\")", func() (any, error) { return StringToUint16("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"\")", func() (any, error) { return StringToUint32("") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"0\")", func() (any, error) { return StringToUint32("0") }, uint32(0), nil},
+	{"StringToUint32(\"1\")", func() (any, error) { return StringToUint32("1") }, uint32(1), nil},
+	{"StringToUint32(\"-1\")", func() (any, error) { return StringToUint32("-1") }, nil, ErrUnderflow},
+	{"StringToUint32(\"42\")", func() (any, error) { return StringToUint32("42") }, uint32(42), nil},
+	{"StringToUint32(\"+42\")", func() (any, error) { return StringToUint32("+42") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"42.5\")", func() (any, error) { return StringToUint32("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"-42.5\")", func() (any, error) { return StringToUint32("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"1e3\")", func() (any, error) { return StringToUint32("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"abc\")", func() (any, error) { return StringToUint32("abc") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"true\")", func() (any, error) { return StringToUint32("true") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"false\")", func() (any, error) { return StringToUint32("false") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"127\")", func() (any, error) { return StringToUint32("127") }, uint32(127), nil},
+	{"StringToUint32(\"128\")", func() (any, error) { return StringToUint32("128") }, uint32(128), nil},
+	{"StringToUint32(\"-128\")", func() (any, error) { return StringToUint32("-128") }, nil, ErrUnderflow},
+	{"StringToUint32(\"-129\")", func() (any, error) { return StringToUint32("-129") }, nil, ErrUnderflow},
+	{"StringToUint32(\"255\")", func() (any, error) { return StringToUint32("255") }, uint32(255), nil},
+	{"StringToUint32(\"256\")", func() (any, error) { return StringToUint32("256") }, uint32(256), nil},
+	{"StringToUint32(\"32767\")", func() (any, error) { return StringToUint32("32767") }, uint32(32767), nil},
+	{"StringToUint32(\"32768\")", func() (any, error) { return StringToUint32("32768") }, uint32(32768), nil},
+	{"StringToUint32(\"65535\")", func() (any, error) { return StringToUint32("65535") }, uint32(65535), nil},
+	{"StringToUint32(\"65536\")", func() (any, error) { return StringToUint32("65536") }, uint32(65536), nil},
+	{"StringToUint32(\"2147483647\")", func() (any, error) { return StringToUint32("2147483647") }, uint32(2147483647), nil},
+	{"StringToUint32(\"2147483648\")", func() (any, error) { return StringToUint32("2147483648") }, uint32(2147483648), nil},
+	{"StringToUint32(\"-2147483649\")", func() (any, error) { return StringToUint32("-2147483649") }, nil, ErrUnderflow},
+	{"StringToUint32(\"4294967295\")", func() (any, error) { return StringToUint32("4294967295") }, uint32(4294967295), nil},
+	{"StringToUint32(\"4294967296\")", func() (any, error) { return StringToUint32("4294967296") }, nil, ErrOverflow},
+	{"StringToUint32(\"9223372036854775807\")", func() (any, error) { return StringToUint32("9223372036854775807") }, nil, ErrOverflow},
+	{"StringToUint32(\"9223372036854775808\")", func() (any, error) { return StringToUint32("9223372036854775808") }, nil, ErrOverflow},
+	{"StringToUint32(\"-9223372036854775808\")", func() (any, error) { return StringToUint32("-9223372036854775808") }, nil, ErrUnderflow},
+	{"StringToUint32(\"-9223372036854775809\")", func() (any, error) { return StringToUint32("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToUint32(\"18446744073709551615\")", func() (any, error) { return StringToUint32("18446744073709551615") }, nil, ErrOverflow},
+	{"StringToUint32(\"18446744073709551616\")", func() (any, error) { return StringToUint32("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToUint32(\"3.4028235e38\")", func() (any, error) { return StringToUint32("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"3.5e38\")", func() (any, error) { return StringToUint32("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"1.7976931348623157e308\")", func() (any, error) { return StringToUint32("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"1e309\")", func() (any, error) { return StringToUint32("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"NaN\")", func() (any, error) { return StringToUint32("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"Inf\")", func() (any, error) { return StringToUint32("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint32(\"-Inf\")", func() (any, error) { return StringToUint32("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"\")", func() (any, error) { return StringToUint64("") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"0\")", func() (any, error) { return StringToUint64("0") }, uint64(0), nil},
+	{"StringToUint64(\"1\")", func() (any, error) { return StringToUint64("1") }, uint64(1), nil},
+	{"StringToUint64(\"-1\")", func() (any, error) { return StringToUint64("-1") }, nil, ErrUnderflow},
+	{"StringToUint64(\"42\")", func() (any, error) { return StringToUint64("42") }, uint64(42), nil},
+	{"StringToUint64(\"+42\")", func() (any, error) { return StringToUint64("+42") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"42.5\")", func() (any, error) { return StringToUint64("42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"-42.5\")", func() (any, error) { return StringToUint64("-42.5") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"1e3\")", func() (any, error) { return StringToUint64("1e3") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"abc\")", func() (any, error) { return StringToUint64("abc") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"true\")", func() (any, error) { return StringToUint64("true") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"false\")", func() (any, error) { return StringToUint64("false") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"127\")", func() (any, error) { return StringToUint64("127") }, uint64(127), nil},
+	{"StringToUint64(\"128\")", func() (any, error) { return StringToUint64("128") }, uint64(128), nil},
+	{"StringToUint64(\"-128\")", func() (any, error) { return StringToUint64("-128") }, nil, ErrUnderflow},
+	{"StringToUint64(\"-129\")", func() (any, error) { return StringToUint64("-129") }, nil, ErrUnderflow},
+	{"StringToUint64(\"255\")", func() (any, error) { return StringToUint64("255") }, uint64(255), nil},
+	{"StringToUint64(\"256\")", func() (any, error) { return StringToUint64("256") }, uint64(256), nil},
+	{"StringToUint64(\"32767\")", func() (any, error) { return StringToUint64("32767") }, uint64(32767), nil},
+	{"StringToUint64(\"32768\")", func() (any, error) { return StringToUint64("32768") }, uint64(32768), nil},
+	{"StringToUint64(\"65535\")", func() (any, error) { return StringToUint64("65535") }, uint64(65535), nil},
+	{"StringToUint64(\"65536\")", func() (any, error) { return StringToUint64("65536") }, uint64(65536), nil},
+	{"StringToUint64(\"2147483647\")", func() (any, error) { return StringToUint64("2147483647") }, uint64(2147483647), nil},
+	{"StringToUint64(\"2147483648\")", func() (any, error) { return StringToUint64("2147483648") }, uint64(2147483648), nil},
+	{"StringToUint64(\"-2147483649\")", func() (any, error) { return StringToUint64("-2147483649") }, nil, ErrUnderflow},
+	{"StringToUint64(\"4294967295\")", func() (any, error) { return StringToUint64("4294967295") }, uint64(4294967295), nil},
+	{"StringToUint64(\"4294967296\")", func() (any, error) { return StringToUint64("4294967296") }, uint64(4294967296), nil},
+	{"StringToUint64(\"9223372036854775807\")", func() (any, error) { return StringToUint64("9223372036854775807") }, uint64(9223372036854775807), nil},
+	{"StringToUint64(\"9223372036854775808\")", func() (any, error) { return StringToUint64("9223372036854775808") }, uint64(9223372036854775808), nil},
+	{"StringToUint64(\"-9223372036854775808\")", func() (any, error) { return StringToUint64("-9223372036854775808") }, nil, ErrUnderflow},
+	{"StringToUint64(\"-9223372036854775809\")", func() (any, error) { return StringToUint64("-9223372036854775809") }, nil, ErrUnderflow},
+	{"StringToUint64(\"18446744073709551615\")", func() (any, error) { return StringToUint64("18446744073709551615") }, uint64(18446744073709551615), nil},
+	{"StringToUint64(\"18446744073709551616\")", func() (any, error) { return StringToUint64("18446744073709551616") }, nil, ErrOverflow},
+	{"StringToUint64(\"3.4028235e38\")", func() (any, error) { return StringToUint64("3.4028235e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"3.5e38\")", func() (any, error) { return StringToUint64("3.5e38") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"1.7976931348623157e308\")", func() (any, error) { return StringToUint64("1.7976931348623157e308") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"1e309\")", func() (any, error) { return StringToUint64("1e309") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"NaN\")", func() (any, error) { return StringToUint64("NaN") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"Inf\")", func() (any, error) { return StringToUint64("Inf") }, nil, strconv.ErrSyntax},
+	{"StringToUint64(\"-Inf\")", func() (any, error) { return StringToUint64("-Inf") }, nil, strconv.ErrSyntax},
+	{"StringToFloat32(\"\")", func() (any, error) { return StringToFloat32("") }, nil, strconv.ErrSyntax},
+	{"StringToFloat32(\"0\")", func() (any, error) { return StringToFloat32("0") }, float32(0), nil},
+	{"StringToFloat32(\"1\")", func() (any, error) { return StringToFloat32("1") }, float32(1), nil},
+	{"StringToFloat32(\"-1\")", func() (any, error) { return StringToFloat32("-1") }, float32(-1), nil},
+	{"StringToFloat32(\"42\")", func() (any, error) { return StringToFloat32("42") }, float32(42), nil},
+	{"StringToFloat32(\"+42\")", func() (any, error) { return StringToFloat32("+42") }, float32(42), nil},
+	{"StringToFloat32(\"42.5\")", func() (any, error) { return StringToFloat32("42.5") }, float32(42.5), nil},
+	{"StringToFloat32(\"-42.5\")", func() (any, error) { return StringToFloat32("-42.5") }, float32(-42.5), nil},
+	{"StringToFloat32(\"1e3\")", func() (any, error) { return StringToFloat32("1e3") }, float32(1000), nil},
+	{"StringToFloat32(\"abc\")", func() (any, error) { return StringToFloat32("abc") }, nil, strconv.ErrSyntax},
+	{"StringToFloat32(\"true\")", func() (any, error) { return StringToFloat32("true") }, nil, strconv.ErrSyntax},
+	{"StringToFloat32(\"false\")", func() (any, error) { return StringToFloat32("false") }, nil, strconv.ErrSyntax},
+	{"StringToFloat32(\"127\")", func() (any, error) { return StringToFloat32("127") }, float32(127), nil},
+	{"StringToFloat32(\"128\")", func() (any, error) { return StringToFloat32("128") }, float32(128), nil},
+	{"StringToFloat32(\"-128\")", func() (any, error) { return StringToFloat32("-128") }, float32(-128), nil},
+	{"StringToFloat32(\"-129\")", func() (any, error) { return StringToFloat32("-129") }, float32(-129), nil},
+	{"StringToFloat32(\"255\")", func() (any, error) { return StringToFloat32("255") }, float32(255), nil},
+	{"StringToFloat32(\"256\")", func() (any, error) { return StringToFloat32("256") }, float32(256), nil},
+	{"StringToFloat32(\"32767\")", func() (any, error) { return StringToFloat32("32767") }, float32(32767), nil},
+	{"StringToFloat32(\"32768\")", func() (any, error) { return StringToFloat32("32768") }, float32(32768), nil},
+	{"StringToFloat32(\"65535\")", func() (any, error) { return StringToFloat32("65535") }, float32(65535), nil},
+	{"StringToFloat32(\"65536\")", func() (any, error) { return StringToFloat32("65536") }, float32(65536), nil},
+	{"StringToFloat32(\"2147483647\")", func() (any, error) { return StringToFloat32("2147483647") }, float32(2.1474836e+09), nil},
+	{"StringToFloat32(\"2147483648\")", func() (any, error) { return StringToFloat32("2147483648") }, float32(2.1474836e+09), nil},
+	{"StringToFloat32(\"-2147483649\")", func() (any, error) { return StringToFloat32("-2147483649") }, float32(-2.1474836e+09), nil},
+	{"StringToFloat32(\"4294967295\")", func() (any, error) { return StringToFloat32("4294967295") }, float32(4.2949673e+09), nil},
+	{"StringToFloat32(\"4294967296\")", func() (any, error) { return StringToFloat32("4294967296") }, float32(4.2949673e+09), nil},
+	{"StringToFloat32(\"9223372036854775807\")", func() (any, error) { return StringToFloat32("9223372036854775807") }, float32(9.223372e+18), nil},
+	{"StringToFloat32(\"9223372036854775808\")", func() (any, error) { return StringToFloat32("9223372036854775808") }, float32(9.223372e+18), nil},
+	{"StringToFloat32(\"-9223372036854775808\")", func() (any, error) { return StringToFloat32("-9223372036854775808") }, float32(-9.223372e+18), nil},
+	{"StringToFloat32(\"-9223372036854775809\")", func() (any, error) { return StringToFloat32("-9223372036854775809") }, float32(-9.223372e+18), nil},
+	{"StringToFloat32(\"18446744073709551615\")", func() (any, error) { return StringToFloat32("18446744073709551615") }, float32(1.8446744e+19), nil},
+	{"StringToFloat32(\"18446744073709551616\")", func() (any, error) { return StringToFloat32("18446744073709551616") }, float32(1.8446744e+19), nil},
+	{"StringToFloat32(\"3.4028235e38\")", func() (any, error) { return StringToFloat32("3.4028235e38") }, float32(3.4028235e+38), nil},
+	{"StringToFloat32(\"3.5e38\")", func() (any, error) { return StringToFloat32("3.5e38") }, nil, ErrOverflow},
+	{"StringToFloat32(\"1.7976931348623157e308\")", func() (any, error) { return StringToFloat32("1.7976931348623157e308") }, nil, ErrOverflow},
+	{"StringToFloat32(\"1e309\")", func() (any, error) { return StringToFloat32("1e309") }, nil, ErrOverflow},
+	{"StringToFloat32(\"NaN\")", func() (any, error) { return StringToFloat32("NaN") }, float32(math.NaN()), nil},
+	{"StringToFloat32(\"Inf\")", func() (any, error) { return StringToFloat32("Inf") }, float32(math.Inf(1)), nil},
+	{"StringToFloat32(\"-Inf\")", func() (any, error) { return StringToFloat32("-Inf") }, float32(math.Inf(-1)), nil},
+	{"StringToFloat64(\"\")", func() (any, error) { return StringToFloat64("") }, nil, strconv.ErrSyntax},
+	{"StringToFloat64(\"0\")", func() (any, error) { return StringToFloat64("0") }, float64(0), nil},
+	{"StringToFloat64(\"1\")", func() (any, error) { return StringToFloat64("1") }, float64(1), nil},
+	{"StringToFloat64(\"-1\")", func() (any, error) { return StringToFloat64("-1") }, float64(-1), nil},
+	{"StringToFloat64(\"42\")", func() (any, error) { return StringToFloat64("42") }, float64(42), nil},
+	{"StringToFloat64(\"+42\")", func() (any, error) { return StringToFloat64("+42") }, float64(42), nil},
+	{"StringToFloat64(\"42.5\")", func() (any, error) { return StringToFloat64("42.5") }, float64(42.5), nil},
+	{"StringToFloat64(\"-42.5\")", func() (any, error) { return StringToFloat64("-42.5") }, float64(-42.5), nil},
+	{"StringToFloat64(\"1e3\")", func() (any, error) { return StringToFloat64("1e3") }, float64(1000), nil},
+	{"StringToFloat64(\"abc\")", func() (any, error) { return StringToFloat64("abc") }, nil, strconv.ErrSyntax},
+	{"StringToFloat64(\"true\")", func() (any, error) { return StringToFloat64("true") }, nil, strconv.ErrSyntax},
+	{"StringToFloat64(\"false\")", func() (any, error) { return StringToFloat64("false") }, nil, strconv.ErrSyntax},
+	{"StringToFloat64(\"127\")", func() (any, error) { return StringToFloat64("127") }, float64(127), nil},
+	{"StringToFloat64(\"128\")", func() (any, error) { return StringToFloat64("128") }, float64(128), nil},
+	{"StringToFloat64(\"-128\")", func() (any, error) { return StringToFloat64("-128") }, float64(-128), nil},
+	{"StringToFloat64(\"-129\")", func() (any, error) { return StringToFloat64("-129") }, float64(-129), nil},
+	{"StringToFloat64(\"255\")", func() (any, error) { return StringToFloat64("255") }, float64(255), nil},
+	{"StringToFloat64(\"256\")", func() (any, error) { return StringToFloat64("256") }, float64(256), nil},
+	{"StringToFloat64(\"32767\")", func() (any, error) { return StringToFloat64("32767") }, float64(32767), nil},
+	{"StringToFloat64(\"32768\")", func() (any, error) { return StringToFloat64("32768") }, float64(32768), nil},
+	{"StringToFloat64(\"65535\")", func() (any, error) { return StringToFloat64("65535") }, float64(65535), nil},
+	{"StringToFloat64(\"65536\")", func() (any, error) { return StringToFloat64("65536") }, float64(65536), nil},
+	{"StringToFloat64(\"2147483647\")", func() (any, error) { return StringToFloat64("2147483647") }, float64(2.147483647e+09), nil},
+	{"StringToFloat64(\"2147483648\")", func() (any, error) { return StringToFloat64("2147483648") }, float64(2.147483648e+09), nil},
+	{"StringToFloat64(\"-2147483649\")", func() (any, error) { return StringToFloat64("-2147483649") }, float64(-2.147483649e+09), nil},
+	{"StringToFloat64(\"4294967295\")", func() (any, error) { return StringToFloat64("4294967295") }, float64(4.294967295e+09), nil},
+	{"StringToFloat64(\"4294967296\")", func() (any, error) { return StringToFloat64("4294967296") }, float64(4.294967296e+09), nil},
+	{"StringToFloat64(\"9223372036854775807\")", func() (any, error) { return StringToFloat64("9223372036854775807") }, float64(9.223372036854776e+18), nil},
+	{"StringToFloat64(\"9223372036854775808\")", func() (any, error) { return StringToFloat64("9223372036854775808") }, float64(9.223372036854776e+18), nil},
+	{"StringToFloat64(\"-9223372036854775808\")", func() (any, error) { return StringToFloat64("-9223372036854775808") }, float64(-9.223372036854776e+18), nil},
+	{"StringToFloat64(\"-9223372036854775809\")", func() (any, error) { return StringToFloat64("-9223372036854775809") }, float64(-9.223372036854776e+18), nil},
+	{"StringToFloat64(\"18446744073709551615\")", func() (any, error) { return StringToFloat64("18446744073709551615") }, float64(1.8446744073709552e+19), nil},
+	{"StringToFloat64(\"18446744073709551616\")", func() (any, error) { return StringToFloat64("18446744073709551616") }, float64(1.8446744073709552e+19), nil},
+	{"StringToFloat64(\"3.4028235e38\")", func() (any, error) { return StringToFloat64("3.4028235e38") }, float64(3.4028235e+38), nil},
+	{"StringToFloat64(\"3.5e38\")", func() (any, error) { return StringToFloat64("3.5e38") }, float64(3.5e+38), nil},
+	{"StringToFloat64(\"1.7976931348623157e308\")", func() (any, error) { return StringToFloat64("1.7976931348623157e308") }, float64(1.7976931348623157e+308), nil},
+	{"StringToFloat64(\"1e309\")", func() (any, error) { return StringToFloat64("1e309") }, nil, ErrOverflow},
+	{"StringToFloat64(\"NaN\")", func() (any, error) { return StringToFloat64("NaN") }, math.NaN(), nil},
+	{"StringToFloat64(\"Inf\")", func() (any, error) { return StringToFloat64("Inf") }, math.Inf(1), nil},
+	{"StringToFloat64(\"-Inf\")", func() (any, error) { return StringToFloat64("-Inf") }, math.Inf(-1), nil},
+}
+
+func TestConversionMatrix(t *testing.T) {
+	for _, tt := range conversionMatrix {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.want && !(isNaN(got) && isNaN(tt.want)) {
+				t.Errorf("got %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+			}
+		})
+	}
+}
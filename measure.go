@@ -0,0 +1,165 @@
+package into
+
+import "math"
+
+// Angle is implemented by the angle types, Degrees and Radians, so that
+// each of them can be converted from the others with TryInto.
+type Angle interface {
+	// Radians returns the angle in radians.
+	Radians() Radians
+}
+
+// Degrees is an angle in degrees.
+type Degrees float64
+
+// Radians returns the angle in radians.
+func (d Degrees) Radians() Radians {
+	return Radians(float64(d) * math.Pi / 180)
+}
+
+// TryFrom sets d to the angle a, so TryInto converts any Angle to Degrees.
+func (d *Degrees) TryFrom(a Angle) error {
+	if deg, ok := a.(Degrees); ok {
+		*d = deg
+		return nil
+	}
+	*d = Degrees(float64(a.Radians()) * 180 / math.Pi)
+	return nil
+}
+
+// Radians is an angle in radians.
+type Radians float64
+
+// Radians returns r.
+func (r Radians) Radians() Radians {
+	return r
+}
+
+// TryFrom sets r to the angle a, so TryInto converts any Angle to Radians.
+func (r *Radians) TryFrom(a Angle) error {
+	*r = a.Radians()
+	return nil
+}
+
+// absoluteZero is the lowest temperature, in degrees Celsius.
+const absoluteZero = -273.15
+
+// Temperature is implemented by the temperature types, Celsius, Fahrenheit
+// and Kelvin, so that each of them can be converted from the others with
+// TryInto. The conversions fail with ErrUnderflow for temperatures below
+// absolute zero and with ErrNaN for NaN.
+type Temperature interface {
+	// Celsius returns the temperature in degrees Celsius.
+	Celsius() Celsius
+}
+
+// Celsius is a temperature in degrees Celsius.
+type Celsius float64
+
+// Celsius returns c.
+func (c Celsius) Celsius() Celsius {
+	return c
+}
+
+// TryFrom sets c to the temperature t, so TryInto converts any Temperature
+// to Celsius.
+func (c *Celsius) TryFrom(t Temperature) error {
+	v, err := checkTemperature(t, "into.Celsius")
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// Fahrenheit is a temperature in degrees Fahrenheit.
+type Fahrenheit float64
+
+// Celsius returns the temperature in degrees Celsius.
+func (f Fahrenheit) Celsius() Celsius {
+	return Celsius((float64(f) - 32) * 5 / 9)
+}
+
+// TryFrom sets f to the temperature t, so TryInto converts any Temperature
+// to Fahrenheit.
+func (f *Fahrenheit) TryFrom(t Temperature) error {
+	if v, ok := t.(Fahrenheit); ok {
+		if _, err := checkTemperature(t, "into.Fahrenheit"); err != nil {
+			return err
+		}
+		*f = v
+		return nil
+	}
+	c, err := checkTemperature(t, "into.Fahrenheit")
+	if err != nil {
+		return err
+	}
+	*f = Fahrenheit(float64(c)*9/5 + 32)
+	return nil
+}
+
+// Kelvin is a temperature in kelvins.
+type Kelvin float64
+
+// Celsius returns the temperature in degrees Celsius.
+func (k Kelvin) Celsius() Celsius {
+	return Celsius(float64(k) + absoluteZero)
+}
+
+// TryFrom sets k to the temperature t, so TryInto converts any Temperature
+// to Kelvin.
+func (k *Kelvin) TryFrom(t Temperature) error {
+	if v, ok := t.(Kelvin); ok {
+		if _, err := checkTemperature(t, "into.Kelvin"); err != nil {
+			return err
+		}
+		*k = v
+		return nil
+	}
+	c, err := checkTemperature(t, "into.Kelvin")
+	if err != nil {
+		return err
+	}
+	*k = Kelvin(float64(c) - absoluteZero)
+	return nil
+}
+
+// checkTemperature returns t in degrees Celsius, or an error naming to if
+// t is NaN or below absolute zero.
+func checkTemperature(t Temperature, to string) (Celsius, error) {
+	c := t.Celsius()
+	if math.IsNaN(float64(c)) {
+		return 0, nanError(t, to)
+	}
+	if c < absoluteZero {
+		return 0, underflowError(t, to)
+	}
+	return c, nil
+}
+
+// Bits is an amount of data in bits.
+type Bits uint64
+
+// TryFrom sets b to the number of bits in n bytes, so TryInto converts
+// Bytes to Bits. It fails with ErrOverflow if the result exceeds the
+// maximum of uint64.
+func (b *Bits) TryFrom(n Bytes) error {
+	if n > math.MaxUint64/8 {
+		return overflowError(n, "into.Bits")
+	}
+	*b = Bits(n) * 8
+	return nil
+}
+
+// Bytes is an amount of data in bytes.
+type Bytes uint64
+
+// TryFrom sets b to the number of bytes in n bits, so TryInto converts Bits
+// to Bytes. It fails with ErrLossOfPrecision if n is not a multiple of 8.
+func (b *Bytes) TryFrom(n Bits) error {
+	if n%8 != 0 {
+		return &ConversionError{From: "into.Bits", To: "into.Bytes", Value: n, Err: ErrLossOfPrecision}
+	}
+	*b = Bytes(n / 8)
+	return nil
+}
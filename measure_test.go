@@ -0,0 +1,78 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestAngle(t *testing.T) {
+	if got, err := TryInto[Radians](Degrees(180)); err != nil || got != math.Pi {
+		t.Errorf("TryInto[Radians](Degrees(180)) = %v, %v, want Pi", got, err)
+	}
+	if got, err := TryInto[Degrees](Radians(math.Pi / 2)); err != nil || got != 90 {
+		t.Errorf("TryInto[Degrees](Radians(Pi/2)) = %v, %v, want 90", got, err)
+	}
+	if got, err := TryInto[Degrees](Degrees(45)); err != nil || got != 45 {
+		t.Errorf("TryInto[Degrees](Degrees(45)) = %v, %v, want 45", got, err)
+	}
+	if got, err := TryInto[Degrees](30.0); err != nil || got != 30 {
+		t.Errorf("TryInto[Degrees](30.0) = %v, %v, want 30", got, err)
+	}
+	want, _ := TryInto[Radians](Degrees(180))
+	if got, err := TryIntoWith[Radians](Degrees(180), Options{}); err != nil || got != want {
+		t.Errorf("TryIntoWith[Radians](Degrees(180)) = %v, %v, want %v like TryInto", got, err, want)
+	}
+}
+
+func TestTemperature(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (float64, error)
+		want    float64
+		wantErr error
+	}{
+		{"CelsiusToFahrenheit", func() (float64, error) { f, err := TryInto[Fahrenheit](Celsius(100)); return float64(f), err }, 212, nil},
+		{"FahrenheitToCelsius", func() (float64, error) { c, err := TryInto[Celsius](Fahrenheit(-40)); return float64(c), err }, -40, nil},
+		{"CelsiusToKelvin", func() (float64, error) { k, err := TryInto[Kelvin](Celsius(-273.15)); return float64(k), err }, 0, nil},
+		{"KelvinToCelsius", func() (float64, error) { c, err := TryIntoAny[Celsius](Kelvin(0)); return float64(c), err }, -273.15, nil},
+		{"FahrenheitToKelvin", func() (float64, error) { k, err := TryInto[Kelvin](Fahrenheit(32)); return float64(k), err }, 273.15, nil},
+		{"BelowAbsoluteZero", func() (float64, error) { k, err := TryInto[Kelvin](Celsius(-300)); return float64(k), err }, 0, ErrUnderflow},
+		{"NegativeKelvin", func() (float64, error) { f, err := TryInto[Fahrenheit](Kelvin(-1)); return float64(f), err }, 0, ErrUnderflow},
+		{"SameTypeChecked", func() (float64, error) { k, err := TryInto[Kelvin](Kelvin(-1)); return float64(k), err }, 0, ErrUnderflow},
+		{"NaN", func() (float64, error) { c, err := TryInto[Celsius](Kelvin(math.NaN())); return float64(c), err }, 0, ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDataSize(t *testing.T) {
+	if got, err := TryInto[Bits](Bytes(3)); err != nil || got != 24 {
+		t.Errorf("TryInto[Bits](Bytes(3)) = %v, %v, want 24", got, err)
+	}
+	if got, err := TryInto[Bytes](Bits(24)); err != nil || got != 3 {
+		t.Errorf("TryInto[Bytes](Bits(24)) = %v, %v, want 3", got, err)
+	}
+	if _, err := TryInto[Bytes](Bits(12)); !errors.Is(err, ErrLossOfPrecision) {
+		t.Errorf("TryInto[Bytes](Bits(12)) error = %v, want ErrLossOfPrecision", err)
+	}
+	if _, err := TryInto[Bits](Bytes(math.MaxUint64 / 4)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TryInto[Bits](huge) error = %v, want ErrOverflow", err)
+	}
+	var bits Bits
+	if err := Fields([]any{&bits}, []any{Bytes(2)}); err != nil || bits != 16 {
+		t.Errorf("Fields() = %v, %v, want 16", bits, err)
+	}
+}
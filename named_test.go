@@ -0,0 +1,77 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+type (
+	namedBool   bool
+	namedInt    int
+	namedUint8  uint8
+	namedString string
+)
+
+func TestTryIntoNamedTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (any, error)
+		want    any
+		wantErr bool
+	}{
+		{"Bool", func() (any, error) { return TryIntoBool(namedInt(1)) }, true, false},
+		{"Float32", func() (any, error) { return TryIntoFloat32(namedInt(42)) }, float32(42), false},
+		{"Float64", func() (any, error) { return TryIntoFloat64(celsius(36.6)) }, 36.6, false},
+		{"Int", func() (any, error) { return TryIntoInt(namedString("42")) }, 42, false},
+		{"Int8", func() (any, error) { return TryIntoInt8(namedInt(-42)) }, int8(-42), false},
+		{"Int16", func() (any, error) { return TryIntoInt16(namedUint8(255)) }, int16(255), false},
+		{"Int32", func() (any, error) { return TryIntoInt32(namedBool(true)) }, int32(1), false},
+		{"Int64", func() (any, error) { return TryIntoInt64(time.Duration(5)) }, int64(5), false},
+		{"Uint", func() (any, error) { return TryIntoUint(namedInt(42)) }, uint(42), false},
+		{"Uint8", func() (any, error) { return TryIntoUint8(namedString("255")) }, uint8(255), false},
+		{"Uint16", func() (any, error) { return TryIntoUint16(celsius(42)) }, uint16(42), false},
+		{"Uint32", func() (any, error) { return TryIntoUint32(namedInt(42)) }, uint32(42), false},
+		{"Uint64", func() (any, error) { return TryIntoUint64(namedUint8(42)) }, uint64(42), false},
+		{"String", func() (any, error) { return TryIntoString(namedBool(true)) }, "true", false},
+		{"Time", func() (any, error) { return TryIntoTime(namedInt(5)) }, time.Unix(5, 0), false},
+		{"Int8Overflow", func() (any, error) { return TryIntoInt8(namedInt(300)) }, int8(0), true},
+		{"Uint32Underflow", func() (any, error) { return TryIntoUint32(namedInt(-1)) }, uint32(0), true},
+		{"BoolInvalid", func() (any, error) { return TryIntoBool(namedString("maybe")) }, false, true},
+		{"TimeInvalid", func() (any, error) { return TryIntoTime(namedString("soon")) }, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryIntoNamedTarget(t *testing.T) {
+	got, err := TryInto[celsius](namedInt(21))
+	if err != nil || got != 21 {
+		t.Errorf("TryInto[celsius](21) = %v, %v, want 21", got, err)
+	}
+
+	got, err = TryIntoWith[celsius](namedString(" 21.5 "), Options{TrimSpace: true})
+	if err != nil || got != 21.5 {
+		t.Errorf("TryIntoWith[celsius](\" 21.5 \") = %v, %v, want 21.5", got, err)
+	}
+
+	if _, err := TryInto[namedUint8](namedInt(256)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TryInto[namedUint8](256) error = %v, want ErrOverflow", err)
+	}
+
+	if s, err := TryIntoString(90 * time.Second); err != nil || s != "1m30s" {
+		t.Errorf("TryIntoString(90s) = %q, %v, want 1m30s", s, err)
+	}
+}
@@ -0,0 +1,44 @@
+package into
+
+import "strings"
+
+// Normalizers combines string normalization functions into one.
+//
+// Normalizers returns a function that applies fns in order, for use as
+// Options.Normalize when strings need several kinds of normalization, such
+// as Unicode normalization followed by case folding.
+//
+// Parameters:
+//   - fns: the normalization functions, applied from first to last.
+//
+// Returns:
+//   - func(string) string: the combined function.
+//
+// Example:
+//
+//	opts := Options{Normalize: Normalizers(strings.TrimSpace, strings.ToLower)}
+//	result, err := TryIntoWith[bool](" TRUE ", opts)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: true
+func Normalizers(fns ...func(string) string) func(string) string {
+	return func(s string) string {
+		for _, fn := range fns {
+			s = fn(s)
+		}
+		return s
+	}
+}
+
+// normalizeString prepares a string source for parsing with the TrimSpace
+// and Normalize options.
+func normalizeString(s string, opts Options) string {
+	if opts.TrimSpace {
+		s = strings.TrimSpace(s)
+	}
+	if opts.Normalize != nil {
+		s = opts.Normalize(s)
+	}
+	return s
+}
@@ -0,0 +1,84 @@
+package into_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestNormalizers(t *testing.T) {
+	fn := Normalizers(strings.TrimSpace, strings.ToLower)
+	if got := fn("  MiXeD "); got != "mixed" {
+		t.Errorf("Normalizers()() = %q, want %q", got, "mixed")
+	}
+	if got := Normalizers()("As Is"); got != "As Is" {
+		t.Errorf("Normalizers()() with no functions = %q, want %q", got, "As Is")
+	}
+}
+
+func TestOptionsNormalize(t *testing.T) {
+	calls := 0
+	lower := func(s string) string {
+		calls++
+		return strings.ToLower(s)
+	}
+	opts := Options{TrimSpace: true, Normalize: lower}
+
+	if got, err := TryIntoWith[bool](" TRUE ", opts); err != nil || !got {
+		t.Errorf("TryIntoWith[bool]() = %v, %v, want true", got, err)
+	}
+	if calls != 1 {
+		t.Errorf("Normalize called %d times, want 1", calls)
+	}
+	if got, err := TryIntoWith[float64]("1E3", opts); err != nil || got != 1000 {
+		t.Errorf("TryIntoWith[float64]() = %v, %v, want 1000", got, err)
+	}
+	if got, err := TryIntoWith[time.Duration](" 1H ", opts); err != nil || got != time.Hour {
+		t.Errorf("TryIntoWith[time.Duration]() = %v, %v, want %v", got, err, time.Hour)
+	}
+	if got, err := TryIntoWith[string]("ABC", opts); err != nil || got != "abc" {
+		t.Errorf("TryIntoWith[string]() = %q, %v, want %q", got, err, "abc")
+	}
+	if got, err := TryIntoWith[string]("ABC", Options{}); err != nil || got != "ABC" {
+		t.Errorf("TryIntoWith[string]() without Normalize = %q, %v, want %q", got, err, "ABC")
+	}
+}
+
+type normalizeColor int
+
+func TestDefaultsNormalizeRegistered(t *testing.T) {
+	defer SetDefaults(Defaults())
+	Register(func(s string) (normalizeColor, error) {
+		if s != "red" {
+			t.Errorf("registered function got %q, want %q", s, "red")
+		}
+		return 1, nil
+	})
+
+	SetDefaults(Options{Normalize: strings.ToLower})
+	if got, err := TryInto[normalizeColor]("RED"); err != nil || got != 1 {
+		t.Errorf("TryInto() = %v, %v, want 1", got, err)
+	}
+	if got, err := TryIntoAny[bool]("TRUE"); err != nil || !got {
+		t.Errorf("TryIntoAny[bool]() = %v, %v, want true", got, err)
+	}
+}
+
+func TestOptionsNormalizeTime(t *testing.T) {
+	want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got, err := TryIntoWith[time.Time](" 2024-01-02 ", Options{TrimSpace: true}); err != nil || !got.Equal(want) {
+		t.Errorf("TryIntoWith[time.Time]() with TrimSpace = %v, %v, want %v", got, err, want)
+	}
+	slashes := func(s string) string { return strings.ReplaceAll(s, "/", "-") }
+	if got, err := TryIntoWith[time.Time]("2024/01/02", Options{Normalize: slashes}); err != nil || !got.Equal(want) {
+		t.Errorf("TryIntoWith[time.Time]() with Normalize = %v, %v, want %v", got, err, want)
+	}
+	if got, err := TryIntoWith[time.Time]("  ", Options{TrimSpace: true, Empty: EmptyZero}); err != nil || !got.IsZero() {
+		t.Errorf("TryIntoWith[time.Time]() with EmptyZero = %v, %v, want the zero time", got, err)
+	}
+	if _, err := TryIntoWith[time.Time]("", Options{}); err == nil {
+		t.Error("TryIntoWith[time.Time]() with EmptyReject: want an error")
+	}
+}
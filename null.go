@@ -0,0 +1,136 @@
+package into
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// TryIntoNullInt64 attempts to convert a value of any type to a sql.NullInt64.
+//
+// TryIntoNullInt64 converts value like TryIntoAny and returns a valid
+// sql.NullInt64 holding the result. A nil value or nil pointer is converted
+// to an invalid sql.NullInt64 (SQL NULL), and so is an empty string when the
+// Empty option is EmptyUnset. The options set by SetDefaults are applied.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - sql.NullInt64: the converted value.
+//   - error: an error if the conversion fails.
+//
+// Example:
+//
+//	a, _ := TryIntoNullInt64("42")
+//	b, _ := TryIntoNullInt64(nil)
+//	fmt.Println(a.Int64, a.Valid, b.Valid) // Output: 42 true false
+func TryIntoNullInt64(value any) (sql.NullInt64, error) {
+	if isNull(value, Defaults()) {
+		return sql.NullInt64{}, nil
+	}
+	result, err := TryIntoAny[int64](value)
+	if err != nil {
+		return sql.NullInt64{}, err
+	}
+	return sql.NullInt64{Int64: result, Valid: true}, nil
+}
+
+// TryIntoNullFloat64 attempts to convert a value of any type to a sql.NullFloat64.
+//
+// TryIntoNullFloat64 converts value like TryIntoNullInt64, producing a
+// sql.NullFloat64.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - sql.NullFloat64: the converted value.
+//   - error: an error if the conversion fails.
+func TryIntoNullFloat64(value any) (sql.NullFloat64, error) {
+	if isNull(value, Defaults()) {
+		return sql.NullFloat64{}, nil
+	}
+	result, err := TryIntoAny[float64](value)
+	if err != nil {
+		return sql.NullFloat64{}, err
+	}
+	return sql.NullFloat64{Float64: result, Valid: true}, nil
+}
+
+// TryIntoNullString attempts to convert a value of any type to a sql.NullString.
+//
+// TryIntoNullString converts value like TryIntoNullInt64, producing a
+// sql.NullString. An empty string is a valid empty sql.NullString unless the
+// Empty option is EmptyUnset.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - sql.NullString: the converted value.
+//   - error: an error if the conversion fails.
+func TryIntoNullString(value any) (sql.NullString, error) {
+	if isNull(value, Defaults()) {
+		return sql.NullString{}, nil
+	}
+	result, err := TryIntoAny[string](value)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: result, Valid: true}, nil
+}
+
+// TryIntoNullTime attempts to convert a value of any type to a sql.NullTime.
+//
+// TryIntoNullTime converts value like TryIntoTime and returns a valid
+// sql.NullTime holding the result, with the same handling of nil values and
+// empty strings as TryIntoNullInt64.
+//
+// Parameters:
+//   - value: the value to be converted. Its underlying type, after
+//     dereferencing pointers, must be time.Time or a type accepted by
+//     TryIntoTime.
+//
+// Returns:
+//   - sql.NullTime: the converted value.
+//   - error: an error if the conversion fails.
+func TryIntoNullTime(value any) (sql.NullTime, error) {
+	opts := Defaults()
+	if isNull(value, opts) {
+		return sql.NullTime{}, nil
+	}
+	v, err := basicValue(value, timeType)
+	if err != nil {
+		return sql.NullTime{}, err
+	}
+	result, err := toTimeWith(v, opts)
+	if err != nil {
+		return sql.NullTime{}, err
+	}
+	return sql.NullTime{Time: result.(time.Time), Valid: true}, nil
+}
+
+// isNull reports whether value stands for SQL NULL under opts: nil, a nil
+// pointer, or an empty string when opts.Empty is EmptyUnset.
+func isNull(value any, opts Options) bool {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return true
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return true
+	}
+	if rv.Kind() != reflect.String || opts.Empty != EmptyUnset {
+		return false
+	}
+	s := rv.String()
+	if opts.TrimSpace {
+		s = strings.TrimSpace(s)
+	}
+	return s == ""
+}
@@ -0,0 +1,73 @@
+package into_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestTryIntoNull(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	n := 42
+	var nilPtr *int
+	tests := []struct {
+		name    string
+		input   any
+		opts    Options
+		want    sql.NullInt64
+		wantErr bool
+	}{
+		{"int", 42, Options{}, sql.NullInt64{Int64: 42, Valid: true}, false},
+		{"string", "42", Options{}, sql.NullInt64{Int64: 42, Valid: true}, false},
+		{"pointer", &n, Options{}, sql.NullInt64{Int64: 42, Valid: true}, false},
+		{"nil", nil, Options{}, sql.NullInt64{}, false},
+		{"nilPointer", nilPtr, Options{}, sql.NullInt64{}, false},
+		{"emptyReject", "", Options{}, sql.NullInt64{}, true},
+		{"emptyZero", "", Options{Empty: EmptyZero}, sql.NullInt64{Valid: true}, false},
+		{"emptyUnset", "", Options{Empty: EmptyUnset}, sql.NullInt64{}, false},
+		{"blankUnset", " ", Options{Empty: EmptyUnset, TrimSpace: true}, sql.NullInt64{}, false},
+		{"invalid", "x", Options{Empty: EmptyUnset}, sql.NullInt64{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDefaults(tt.opts)
+			got, err := TryIntoNullInt64(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryIntoNullInt64(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoNullInt64(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	SetDefaults(Options{Empty: EmptyUnset})
+	if got, err := TryIntoNullFloat64("1.5"); err != nil || got != (sql.NullFloat64{Float64: 1.5, Valid: true}) {
+		t.Errorf("TryIntoNullFloat64() = %v, %v", got, err)
+	}
+	if got, err := TryIntoNullString(""); err != nil || got.Valid {
+		t.Errorf("TryIntoNullString(\"\") = %v, %v, want invalid", got, err)
+	}
+	if got, err := TryIntoNullString(7); err != nil || got != (sql.NullString{String: "7", Valid: true}) {
+		t.Errorf("TryIntoNullString(7) = %v, %v", got, err)
+	}
+	want := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	if got, err := TryIntoNullTime("2024-05-01"); err != nil || !got.Valid || !got.Time.Equal(want) {
+		t.Errorf("TryIntoNullTime() = %v, %v", got, err)
+	}
+	if got, err := TryIntoNullTime(&want); err != nil || !got.Valid || !got.Time.Equal(want) {
+		t.Errorf("TryIntoNullTime(&time) = %v, %v", got, err)
+	}
+	if got, err := TryIntoNullTime(""); err != nil || got.Valid {
+		t.Errorf("TryIntoNullTime(\"\") = %v, %v, want invalid", got, err)
+	}
+
+	SetDefaults(Options{})
+	if got, err := TryIntoNullString(""); err != nil || got != (sql.NullString{Valid: true}) {
+		t.Errorf("TryIntoNullString(\"\") = %v, %v, want valid", got, err)
+	}
+}
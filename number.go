@@ -0,0 +1,196 @@
+package into
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// numberKind is the form a Number holds its value in.
+type numberKind int
+
+const (
+	numberInt numberKind = iota
+	numberUint
+	numberFloat
+	numberBig
+)
+
+// Number holds a number of any supported type in a normalized form.
+//
+// Number holds an int64, a uint64, a float64 or, for integers that do not
+// fit in 64 bits, a *big.Int. Heterogeneous numeric data, such as values
+// decoded from JSON, read from a database or taken from the environment, can
+// be normalized once with NumberFromAny and converted many times with
+// NumberTo, without inspecting the source type again. Integers are kept as
+// integers, so no precision is lost through float64. The zero value is the
+// integer 0.
+type Number struct {
+	kind numberKind
+	i    int64
+	u    uint64
+	f    float64
+	b    *big.Int
+}
+
+// NumberFromAny converts the given value to a Number.
+//
+// NumberFromAny converts an integer, a float, a numeric string, a
+// *big.Int or a *big.Float to a Number. Named types such as json.Number are
+// converted through their underlying type and pointers are dereferenced.
+// Strings holding integers are parsed exactly, whatever their size; other
+// numeric strings are parsed as float64. A *big.Float that is an integer is
+// held exactly; otherwise it is rounded to the nearest float64.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - Number: the converted Number value.
+//   - error: ErrNil if value is nil or a nil pointer, an *UnsupportedTypeError
+//     if value is not a number, or an error if it is a string that is not
+//     a number or a *big.Float outside the float64 range.
+//
+// Example:
+//
+//	n, err := NumberFromAny(json.Number("18446744073709551615"))
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	u, _ := NumberTo[uint64](n)
+//	_, err = NumberTo[int64](n)
+//	fmt.Println(u, errors.Is(err, ErrOverflow)) // Output: 18446744073709551615 true
+func NumberFromAny(value any) (Number, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return Number{}, ErrNil
+		}
+		return numberFromBig(new(big.Int).Set(v)), nil
+	case *big.Float:
+		if v == nil {
+			return Number{}, ErrNil
+		}
+		if v.IsInt() {
+			b, _ := v.Int(nil)
+			return numberFromBig(b), nil
+		}
+		f, _ := v.Float64()
+		if math.IsInf(f, 0) {
+			if v.Sign() < 0 {
+				return Number{}, underflowError(value, "float64")
+			}
+			return Number{}, overflowError(value, "float64")
+		}
+		return Number{kind: numberFloat, f: f}, nil
+	}
+
+	v, err := basicValue(value, typeOf[Number]())
+	if err != nil {
+		return Number{}, err
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Number{kind: numberInt, i: rv.Int()}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return numberFromUint(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return Number{kind: numberFloat, f: rv.Float()}, nil
+	case reflect.String:
+		s := rv.String()
+		if b, ok := new(big.Int).SetString(s, 10); ok {
+			return numberFromBig(b), nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return Number{}, err
+		}
+		return Number{kind: numberFloat, f: f}, nil
+	default:
+		return Number{}, unsupportedError(value, typeOf[Number]().String())
+	}
+}
+
+// numberFromUint returns a Number holding u, as an int64 if it fits.
+func numberFromUint(u uint64) Number {
+	if u <= math.MaxInt64 {
+		return Number{kind: numberInt, i: int64(u)}
+	}
+	return Number{kind: numberUint, u: u}
+}
+
+// numberFromBig returns a Number holding b in the smallest form that fits.
+func numberFromBig(b *big.Int) Number {
+	switch {
+	case b.IsInt64():
+		return Number{kind: numberInt, i: b.Int64()}
+	case b.IsUint64():
+		return Number{kind: numberUint, u: b.Uint64()}
+	default:
+		return Number{kind: numberBig, b: b}
+	}
+}
+
+// IsInt reports whether n holds an integer.
+func (n Number) IsInt() bool {
+	return n.kind != numberFloat
+}
+
+// String returns n in decimal notation.
+func (n Number) String() string {
+	switch n.kind {
+	case numberUint:
+		return strconv.FormatUint(n.u, 10)
+	case numberFloat:
+		return strconv.FormatFloat(n.f, 'g', -1, 64)
+	case numberBig:
+		return n.b.String()
+	default:
+		return strconv.FormatInt(n.i, 10)
+	}
+}
+
+// NumberTo converts the given Number to a value of type T.
+//
+// NumberTo converts n to T with the boundary checks of TryInto, applying the
+// options set by SetDefaults.
+//
+// Parameters:
+//   - n: the Number to be converted.
+//
+// Returns:
+//   - T: the converted value of type T.
+//   - error: an error if the conversion fails, e.g. a *ConversionError
+//     wrapping ErrOverflow if n is out of the range of T.
+//
+// Example:
+//
+//	n, _ := NumberFromAny("300")
+//	a, _ := NumberTo[int16](n)
+//	_, err := NumberTo[int8](n)
+//	fmt.Println(a, errors.Is(err, ErrOverflow)) // Output: 300 true
+func NumberTo[T convertable](n Number) (result T, err error) {
+	switch n.kind {
+	case numberUint:
+		return TryInto[T](n.u)
+	case numberFloat:
+		return TryInto[T](n.f)
+	case numberBig:
+		switch kind := reflect.TypeOf(result).Kind(); {
+		case isIntegerKind(kind):
+			if n.b.Sign() < 0 {
+				return result, underflowError(n.b, kind.String())
+			}
+			return result, overflowError(n.b, kind.String())
+		case kind == reflect.String:
+			return TryInto[T](n.b.String())
+		default:
+			f, _ := new(big.Float).SetInt(n.b).Float64()
+			return TryInto[T](f)
+		}
+	default:
+		return TryInto[T](n.i)
+	}
+}
@@ -0,0 +1,87 @@
+package into_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestNumber(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	n8 := int8(-5)
+	tests := []struct {
+		name      string
+		input     any
+		wantStr   string
+		wantInt   bool
+		wantInt64 int64
+		int64Err  error
+	}{
+		{"int", 42, "42", true, 42, nil},
+		{"int8Pointer", &n8, "-5", true, -5, nil},
+		{"uint64Small", uint64(7), "7", true, 7, nil},
+		{"uint64Max", uint64(math.MaxUint64), "18446744073709551615", true, 0, ErrOverflow},
+		{"float", 2.5, "2.5", false, 2, nil},
+		{"jsonNumber", json.Number("9007199254740993"), "9007199254740993", true, 9007199254740993, nil},
+		{"jsonFloat", json.Number("1e3"), "1000", false, 1000, nil},
+		{"stringHuge", "-123456789012345678901234567890", "-123456789012345678901234567890", true, 0, ErrUnderflow},
+		{"bigInt", huge, "123456789012345678901234567890", true, 0, ErrOverflow},
+		{"bigFloatInt", new(big.Float).SetInt64(-3), "-3", true, -3, nil},
+		{"bigFloat", big.NewFloat(0.5), "0.5", false, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := NumberFromAny(tt.input)
+			if err != nil {
+				t.Fatalf("NumberFromAny(%v) error = %v", tt.input, err)
+			}
+			if got := n.String(); got != tt.wantStr {
+				t.Errorf("String() = %q, want %q", got, tt.wantStr)
+			}
+			if got := n.IsInt(); got != tt.wantInt {
+				t.Errorf("IsInt() = %v, want %v", got, tt.wantInt)
+			}
+			got, err := NumberTo[int64](n)
+			if !errors.Is(err, tt.int64Err) {
+				t.Errorf("NumberTo[int64]() error = %v, want %v", err, tt.int64Err)
+			}
+			if err == nil && got != tt.wantInt64 {
+				t.Errorf("NumberTo[int64]() = %v, want %v", got, tt.wantInt64)
+			}
+			if s, err := NumberTo[string](n); err != nil || s != tt.wantStr {
+				t.Errorf("NumberTo[string]() = %q, %v, want %q", s, err, tt.wantStr)
+			}
+		})
+	}
+
+	n, _ := NumberFromAny(huge)
+	if f, err := NumberTo[float64](n); err != nil || f != 1.2345678901234568e29 {
+		t.Errorf("NumberTo[float64]() = %v, %v", f, err)
+	}
+	if _, err := NumberTo[float32](Number{}); err != nil {
+		t.Errorf("NumberTo[float32](Number{}) error = %v", err)
+	}
+
+	for _, input := range []any{nil, (*int)(nil), "abc", true, []int{1}} {
+		if _, err := NumberFromAny(input); err == nil {
+			t.Errorf("NumberFromAny(%v) error = nil", input)
+		}
+	}
+	for _, input := range []any{true, []int{1}} {
+		var unsupported *UnsupportedTypeError
+		_, err := NumberFromAny(input)
+		if !errors.Is(err, ErrUnsupportedType) || !errors.As(err, &unsupported) || unsupported.To != "into.Number" {
+			t.Errorf("NumberFromAny(%v) error = %v, want an UnsupportedTypeError to into.Number", input, err)
+		}
+	}
+	hugeFraction := new(big.Float).SetPrec(4000).SetMantExp(big.NewFloat(1), 2000)
+	hugeFraction.SetPrec(4000).Add(hugeFraction, big.NewFloat(0.5))
+	if _, err := NumberFromAny(hugeFraction); !errors.Is(err, ErrOverflow) {
+		t.Errorf("NumberFromAny(huge big.Float) error = %v, want %v", err, ErrOverflow)
+	}
+}
@@ -0,0 +1,61 @@
+package into
+
+import (
+	"reflect"
+	"sync/atomic"
+)
+
+// Observer is called after a conversion with the source and target types
+// and the error of the conversion, which is nil on success.
+type Observer func(src, dst reflect.Type, err error)
+
+// observer holds an observerHolder, since atomic.Value cannot store nil.
+var observer atomic.Value
+
+type observerHolder struct{ fn Observer }
+
+// SetObserver sets the function called after each generic conversion.
+//
+// SetObserver sets fn to be called after every conversion by TryInto,
+// TryIntoWith, TryIntoAny, TryIntoValue and the TryIntoXxx functions such as
+// TryIntoInt8 and TryIntoTime, e.g. to count failures by type pair in a
+// metrics system without wrapping every call site. The direct converters
+// such as StringToInt are not observed. fn is called on the
+// goroutine of the conversion and must be safe for concurrent use. A nil fn
+// removes the observer. It is safe for concurrent use.
+//
+// Parameters:
+//   - fn: the observer, or nil.
+//
+// Example:
+//
+//	SetObserver(func(src, dst reflect.Type, err error) {
+//	  if err != nil {
+//	    conversionFailures.WithLabelValues(src.String(), dst.String()).Inc()
+//	  }
+//	})
+func SetObserver(fn Observer) {
+	observer.Store(observerHolder{fn})
+}
+
+// loadObserver returns the observer set by SetObserver, or nil.
+func loadObserver() Observer {
+	h, _ := observer.Load().(observerHolder)
+	return h.fn
+}
+
+// observe calls the observer set by SetObserver, if any, after a
+// conversion of value to T by a TryIntoXxx function.
+func observe[T any](value any, err error) {
+	if o := loadObserver(); o != nil {
+		o(reflect.TypeOf(value), typeOf[T](), err)
+	}
+}
+
+// valueType returns the type of v, or nil if v is the zero reflect.Value.
+func valueType(v reflect.Value) reflect.Type {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Type()
+}
@@ -0,0 +1,85 @@
+package into_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestSetObserver(t *testing.T) {
+	type call struct {
+		src, dst reflect.Type
+		failed   bool
+	}
+	var (
+		mu    sync.Mutex
+		calls []call
+	)
+	SetObserver(func(src, dst reflect.Type, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call{src, dst, err != nil})
+	})
+	defer SetObserver(nil)
+
+	_, _ = TryInto[int8]("1")
+	_, _ = TryInto[int8](300)
+	_, _ = TryIntoWith[uint](-1, Options{})
+	_, _ = TryIntoAny[string](2.5)
+	var f float32
+	_ = TryIntoValue(reflect.ValueOf(&f).Elem(), reflect.ValueOf("x"))
+	_ = TryIntoValue(reflect.Value{}, reflect.Value{})
+	_, _ = StringToInt8("1")
+
+	want := []call{
+		{reflect.TypeOf(""), reflect.TypeOf(int8(0)), false},
+		{reflect.TypeOf(0), reflect.TypeOf(int8(0)), true},
+		{reflect.TypeOf(0), reflect.TypeOf(uint(0)), true},
+		{reflect.TypeOf(0.0), reflect.TypeOf(""), false},
+		{reflect.TypeOf(""), reflect.TypeOf(float32(0)), true},
+		{nil, nil, true},
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("observed %v, want %v", calls, want)
+	}
+
+	SetObserver(nil)
+	_, _ = TryInto[int8]("1")
+	if len(calls) != len(want) {
+		t.Errorf("observer called after SetObserver(nil)")
+	}
+}
+
+func TestSetObserverTryIntoXxx(t *testing.T) {
+	type call struct {
+		src, dst reflect.Type
+		failed   bool
+	}
+	var calls []call
+	SetObserver(func(src, dst reflect.Type, err error) {
+		calls = append(calls, call{src, dst, err != nil})
+	})
+	defer SetObserver(nil)
+
+	_, _ = TryIntoInt8(300)
+	_, _ = TryIntoFloat64("1.5")
+	_, _ = TryIntoBool("maybe")
+	_, _ = TryIntoString(42)
+	_, _ = TryIntoRune(-1)
+	_, _ = TryIntoTime("not a time")
+
+	want := []call{
+		{reflect.TypeOf(0), reflect.TypeOf(int8(0)), true},
+		{reflect.TypeOf(""), reflect.TypeOf(0.0), false},
+		{reflect.TypeOf(""), reflect.TypeOf(false), true},
+		{reflect.TypeOf(0), reflect.TypeOf(""), false},
+		{reflect.TypeOf(0), reflect.TypeOf(rune(0)), true},
+		{reflect.TypeOf(""), reflect.TypeOf(time.Time{}), true},
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("observed %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,138 @@
+package into
+
+import "time"
+
+// Value wraps a value for conversion with methods.
+//
+// Value offers the conversions of TryIntoAny as methods, such as Int32 or
+// Float64, for call sites that read better without type parameters and for
+// discovery through code completion. Each method applies the same dispatch,
+// registered functions, boundary checks and default options as TryIntoAny
+// with the corresponding type parameter. Create a Value with Of.
+type Value struct {
+	value any
+}
+
+// Of wraps a value for conversion with the methods of Value.
+//
+// Of wraps a value of any type, such as a value decoded from JSON or read
+// from a database, so that it can be converted with a method call.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - Value: the wrapped value.
+//
+// Example:
+//
+//	port, err := Of("8080").Uint16()
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(port) // Output: 8080
+func Of(value any) Value {
+	return Value{value: value}
+}
+
+// Any returns the wrapped value.
+func (v Value) Any() any {
+	return v.value
+}
+
+// Bool converts the wrapped value to bool like TryIntoAny[bool].
+func (v Value) Bool() (bool, error) {
+	return TryIntoAny[bool](v.value)
+}
+
+// Int converts the wrapped value to int like TryIntoAny[int].
+func (v Value) Int() (int, error) {
+	return TryIntoAny[int](v.value)
+}
+
+// Int8 converts the wrapped value to int8 like TryIntoAny[int8].
+func (v Value) Int8() (int8, error) {
+	return TryIntoAny[int8](v.value)
+}
+
+// Int16 converts the wrapped value to int16 like TryIntoAny[int16].
+func (v Value) Int16() (int16, error) {
+	return TryIntoAny[int16](v.value)
+}
+
+// Int32 converts the wrapped value to int32 like TryIntoAny[int32].
+func (v Value) Int32() (int32, error) {
+	return TryIntoAny[int32](v.value)
+}
+
+// Int64 converts the wrapped value to int64 like TryIntoAny[int64].
+func (v Value) Int64() (int64, error) {
+	return TryIntoAny[int64](v.value)
+}
+
+// Uint converts the wrapped value to uint like TryIntoAny[uint].
+func (v Value) Uint() (uint, error) {
+	return TryIntoAny[uint](v.value)
+}
+
+// Uint8 converts the wrapped value to uint8 like TryIntoAny[uint8].
+func (v Value) Uint8() (uint8, error) {
+	return TryIntoAny[uint8](v.value)
+}
+
+// Uint16 converts the wrapped value to uint16 like TryIntoAny[uint16].
+func (v Value) Uint16() (uint16, error) {
+	return TryIntoAny[uint16](v.value)
+}
+
+// Uint32 converts the wrapped value to uint32 like TryIntoAny[uint32].
+func (v Value) Uint32() (uint32, error) {
+	return TryIntoAny[uint32](v.value)
+}
+
+// Uint64 converts the wrapped value to uint64 like TryIntoAny[uint64].
+func (v Value) Uint64() (uint64, error) {
+	return TryIntoAny[uint64](v.value)
+}
+
+// Float32 converts the wrapped value to float32 like TryIntoAny[float32].
+func (v Value) Float32() (float32, error) {
+	return TryIntoAny[float32](v.value)
+}
+
+// Float64 converts the wrapped value to float64 like TryIntoAny[float64].
+func (v Value) Float64() (float64, error) {
+	return TryIntoAny[float64](v.value)
+}
+
+// String converts the wrapped value to string like TryIntoAny[string].
+// Unlike the String method of fmt.Stringer, it returns an error if the
+// value cannot be converted.
+func (v Value) String() (string, error) {
+	return TryIntoAny[string](v.value)
+}
+
+// Duration converts the wrapped value to time.Duration like
+// TryIntoAny[time.Duration]: strings are parsed like StringToDuration and
+// numbers are taken as nanoseconds.
+func (v Value) Duration() (time.Duration, error) {
+	return TryIntoAny[time.Duration](v.value)
+}
+
+// Time converts the wrapped value to time.Time like TryIntoTime: strings
+// are parsed in the supported layouts and numbers are taken as seconds
+// since the Unix epoch. A time.Time value is returned as is.
+func (v Value) Time() (time.Time, error) {
+	value := v.value
+	if _, ok := value.(time.Time); !ok {
+		var err error
+		if value, err = basicValue(value, timeType); err != nil {
+			return time.Time{}, err
+		}
+	}
+	result, err := toTimeWith(value, Defaults())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return result.(time.Time), nil
+}
@@ -0,0 +1,57 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (any, error)
+		want    any
+		wantErr error
+	}{
+		{"Int32", func() (any, error) { return Of("42").Int32() }, int32(42), nil},
+		{"Int8 overflow", func() (any, error) { return Of(300).Int8() }, nil, ErrOverflow},
+		{"Uint underflow", func() (any, error) { return Of(-1).Uint() }, nil, ErrUnderflow},
+		{"Float64", func() (any, error) { return Of(uint8(7)).Float64() }, 7.0, nil},
+		{"String", func() (any, error) { return Of(1.5).String() }, "1.5", nil},
+		{"Bool", func() (any, error) { return Of("true").Bool() }, true, nil},
+		{"pointer", func() (any, error) { v := int64(5); return Of(&v).Uint16() }, uint16(5), nil},
+		{"nil", func() (any, error) { return Of(nil).Int() }, nil, ErrNil},
+		{"Duration", func() (any, error) { return Of("1m30s").Duration() }, 90 * time.Second, nil},
+		{"Time", func() (any, error) { return Of(int64(0)).Time() }, time.Unix(0, 0).UTC(), nil},
+		{"Time as is", func() (any, error) { return Of(time.Unix(5, 0).UTC()).Time() }, time.Unix(5, 0).UTC(), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if tm, ok := got.(time.Time); ok {
+				if !tm.Equal(tt.want.(time.Time)) {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+			}
+		})
+	}
+
+	if got := Of(42).Any(); got != 42 {
+		t.Errorf("Any() = %v, want 42", got)
+	}
+}
@@ -0,0 +1,97 @@
+package into
+
+import "database/sql/driver"
+
+// Optional holds a value of type T or nothing.
+//
+// Optional makes the absence of a value explicit where TryIntoAny fails
+// with ErrNil: TryIntoOptional converts nil, nil pointers and SQL NULL to
+// None, and any other value to Some of the converted value. The zero
+// Optional is None.
+type Optional[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Optional holding value.
+//
+// Example:
+//
+//	o := Some(42)
+//	fmt.Println(o.OrElse(0)) // Output: 42
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, ok: true}
+}
+
+// None returns an Optional holding nothing.
+//
+// Example:
+//
+//	o := None[int]()
+//	fmt.Println(o.IsSome(), o.OrElse(-1)) // Output: false -1
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Get returns the value held by o and whether there is one. If o is None,
+// it returns the zero value of T and false.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// IsSome reports whether o holds a value.
+func (o Optional[T]) IsSome() bool {
+	return o.ok
+}
+
+// OrElse returns the value held by o, or def if o is None.
+func (o Optional[T]) OrElse(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.value
+}
+
+// TryIntoOptional attempts to convert a value of any type to an Optional[T].
+//
+// TryIntoOptional returns None for nil and nil pointers, for an empty string
+// when the Empty option is EmptyUnset, and for SQL NULL, that is, a
+// driver.Valuer such as sql.NullInt64 whose Value method returns nil. The
+// value returned by the Value method of any other driver.Valuer is
+// converted, so valid sql.Null types convert to Some. Any other value is
+// converted like TryIntoAny and returned as Some. The options set by
+// SetDefaults are applied.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - Optional[T]: the converted value, or None.
+//   - error: an error if the conversion or the Value method fails.
+//
+// Example:
+//
+//	a, _ := TryIntoOptional[int]("42")
+//	b, _ := TryIntoOptional[int](sql.NullString{})
+//	fmt.Println(a.OrElse(-1), b.IsSome()) // Output: 42 false
+func TryIntoOptional[T convertable](value any) (Optional[T], error) {
+	opts := Defaults()
+	if isNull(value, opts) {
+		return None[T](), nil
+	}
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return None[T](), err
+		}
+		if isNull(v, opts) {
+			return None[T](), nil
+		}
+		value = v
+	}
+	result, err := TryIntoAny[T](value)
+	if err != nil {
+		return None[T](), err
+	}
+	return Some(result), nil
+}
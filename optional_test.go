@@ -0,0 +1,81 @@
+package into_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestOptional(t *testing.T) {
+	if v, ok := Some(7).Get(); !ok || v != 7 {
+		t.Errorf("Some(7).Get() = %v, %v", v, ok)
+	}
+	if v, ok := None[int]().Get(); ok || v != 0 {
+		t.Errorf("None().Get() = %v, %v", v, ok)
+	}
+	var zero Optional[string]
+	if zero.IsSome() || zero.OrElse("def") != "def" {
+		t.Errorf("zero Optional = %+v, want None", zero)
+	}
+	if Some("").OrElse("def") != "" {
+		t.Errorf("Some(\"\").OrElse() did not return the held value")
+	}
+}
+
+func TestTryIntoOptional(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	n := 42
+	var nilPtr *int
+	tests := []struct {
+		name    string
+		input   any
+		opts    Options
+		want    Optional[int64]
+		wantErr bool
+	}{
+		{"int", 42, Options{}, Some[int64](42), false},
+		{"string", "42", Options{}, Some[int64](42), false},
+		{"pointer", &n, Options{}, Some[int64](42), false},
+		{"nil", nil, Options{}, None[int64](), false},
+		{"nilPointer", nilPtr, Options{}, None[int64](), false},
+		{"emptyReject", "", Options{}, None[int64](), true},
+		{"emptyZero", "", Options{Empty: EmptyZero}, Some[int64](0), false},
+		{"emptyUnset", "", Options{Empty: EmptyUnset}, None[int64](), false},
+		{"sqlNull", sql.NullInt64{}, Options{}, None[int64](), false},
+		{"sqlNullPointer", &sql.NullString{}, Options{}, None[int64](), false},
+		{"sqlValid", sql.NullInt64{Int64: 5, Valid: true}, Options{}, Some[int64](5), false},
+		{"sqlValidString", sql.NullString{String: "6", Valid: true}, Options{}, Some[int64](6), false},
+		{"sqlEmptyUnset", sql.NullString{Valid: true}, Options{Empty: EmptyUnset}, None[int64](), false},
+		{"overflow", "1e30", Options{}, None[int64](), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDefaults(tt.opts)
+			got, err := TryIntoOptional[int64](tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryIntoOptional(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoOptional(%v) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryIntoOptionalValuerError(t *testing.T) {
+	if _, err := TryIntoOptional[int64](failingValuer{}); !errors.Is(err, errValue) {
+		t.Fatalf("TryIntoOptional() error = %v, want %v", err, errValue)
+	}
+}
+
+var errValue = errors.New("value failed")
+
+// failingValuer is a driver.Valuer whose Value method fails.
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) { return nil, errValue }
@@ -0,0 +1,553 @@
+package into
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// Rounding selects how a float with a fractional part is converted to an integer.
+type Rounding int
+
+const (
+	// Truncate drops the fractional part, rounding toward zero. It is the default.
+	Truncate Rounding = iota
+	// HalfUp rounds to the nearest integer, rounding halves away from zero.
+	HalfUp
+	// HalfEven rounds to the nearest integer, rounding halves to the even neighbor.
+	HalfEven
+	// Floor rounds toward negative infinity.
+	Floor
+	// Ceil rounds toward positive infinity.
+	Ceil
+	// Exact rejects values with a fractional part with ErrLossOfPrecision.
+	Exact
+)
+
+// Overflow selects how a value outside the range of the target type is handled.
+type Overflow int
+
+const (
+	// OverflowReject returns an error for out-of-range values. It is the default.
+	OverflowReject Overflow = iota
+	// Saturate clamps out-of-range values to the nearest bound of the target type.
+	Saturate
+)
+
+// NaN selects how a NaN float is converted to a type that cannot represent it.
+type NaN int
+
+const (
+	// NaNReject returns ErrNaN for NaN values. It is the default.
+	NaNReject NaN = iota
+	// Zero converts NaN values to the zero value of the target type.
+	Zero
+)
+
+// Empty selects how an empty string is converted to a non-string type.
+//
+// The policy is applied by the functions that take Options, such as
+// TryIntoWith and BindOptions, and through SetDefaults by TryInto,
+// TryIntoAny, TryIntoValue and the TryIntoXxx functions. The direct
+// StringToXxx functions do not depend on the defaults and always reject
+// empty strings, as EmptyReject does.
+type Empty int
+
+const (
+	// EmptyReject passes empty strings to the parsers, which reject them. It is the default.
+	EmptyReject Empty = iota
+	// EmptyZero converts empty strings to the zero value of the target type.
+	EmptyZero
+	// EmptyUnset treats empty strings as absent values. Conversions return
+	// the zero value, while binders such as BindOptions leave the destination
+	// unchanged, so pointer fields stay nil.
+	EmptyUnset
+)
+
+// Truthiness selects which strings are converted to true and false.
+type Truthiness int
+
+const (
+	// TruthStrict accepts only the strings of strconv.ParseBool, so "2" is
+	// rejected although the number 2 converts to true. It is the default.
+	TruthStrict Truthiness = iota
+	// TruthNumeric also accepts numeric strings, converting them like
+	// numbers: any nonzero number is true and zero is false.
+	TruthNumeric
+)
+
+// BytesEncoding selects how a byte slice is written as a string.
+type BytesEncoding int
+
+const (
+	// BytesRaw uses the bytes as they are. It is the default.
+	BytesRaw BytesEncoding = iota
+	// BytesBase64 uses padded standard base64, as in RFC 4648 section 4.
+	BytesBase64
+	// BytesURLBase64 uses unpadded URL-safe base64, as in RFC 4648 section 5.
+	BytesURLBase64
+	// BytesHex uses lower-case hexadecimal.
+	BytesHex
+)
+
+// InvalidUTF8 selects how invalid UTF-8 sequences in a string are handled.
+type InvalidUTF8 int
+
+const (
+	// UTF8Keep leaves invalid sequences in the string. It is the default.
+	UTF8Keep InvalidUTF8 = iota
+	// UTF8Replace replaces each run of invalid bytes with U+FFFD.
+	UTF8Replace
+	// UTF8Reject returns an error wrapping ErrInvalidUTF8.
+	UTF8Reject
+)
+
+// DateOrder selects how numeric dates such as "03/04/05" are interpreted.
+type DateOrder int
+
+const (
+	// DateOrderReject leaves numeric dates other than "2006-01-02" to
+	// StringToTime, which rejects them. It is the default.
+	DateOrderReject DateOrder = iota
+	// MDY reads numeric dates as month, day and year, as in the United States.
+	MDY
+	// DMY reads numeric dates as day, month and year, as in most of Europe.
+	DMY
+	// YMD reads numeric dates as year, month and day, as in ISO 8601.
+	YMD
+)
+
+// NonFinite selects whether strings such as "NaN" and "Inf" are converted to floats.
+type NonFinite int
+
+const (
+	// NonFiniteAccept parses "NaN", "Inf", "+Inf" and "-Inf", in any case,
+	// as strconv.ParseFloat does. It is the default.
+	NonFiniteAccept NonFinite = iota
+	// NonFiniteReject rejects strings that parse to NaN or an infinity, as
+	// StringToFloat64Finite does.
+	NonFiniteReject
+)
+
+// Options combines the policies applied by TryIntoWith.
+//
+// The zero value matches the direct converters: fractions are truncated,
+// out-of-range values, NaN and empty strings are rejected and strings are
+// parsed as is.
+type Options struct {
+	// Rounding is applied when a float is converted to an integer type.
+	Rounding Rounding
+	// Overflow is applied when a numeric value is outside the target range.
+	Overflow Overflow
+	// NaN is applied when a NaN float is converted to an integer type.
+	NaN NaN
+	// NonFinite is applied when a string is converted to a float type.
+	NonFinite NonFinite
+	// TrimSpace strips leading and trailing white space from string sources.
+	TrimSpace bool
+	// Normalize, if set, is applied to string sources before they are
+	// parsed, after TrimSpace, to fold case (strings.ToLower) or normalize
+	// Unicode (norm.NFC.String of golang.org/x/text) once for every call.
+	// Combine several functions with Normalizers. The options set by
+	// SetDefaults also apply it to the strings passed to functions added
+	// with Register, such as the StringToEnum method of an Enum.
+	Normalize func(string) string
+	// Empty is applied when a string source is empty after trimming. It
+	// does not change the direct StringToXxx functions, which always reject
+	// empty strings.
+	Empty Empty
+	// Units accepts SI and IEC unit suffixes (e.g. "10MiB", "1.5k") in
+	// string sources converted to numeric types.
+	Units bool
+	// DecimalIntegers parses string sources converted to integer types as
+	// decimal numbers, accepting a leading plus sign, a fraction and an
+	// exponent (e.g. "+42", "1e3"), as StringToInt64Decimal does. The value
+	// must be an integer, so "1.5e0" is rejected with ErrLossOfPrecision.
+	DecimalIntegers bool
+	// FloatBits converts float64 values to uint64 and float32 values to
+	// uint32 by reinterpreting their IEEE 754 bits, as Float64ToUint64Bits
+	// does, and uint64 and uint32 values back to floats of the same width.
+	// Other conversions are not affected.
+	FloatBits bool
+	// FloatFormat, if set, is used when a float is converted to a string.
+	FloatFormat *FloatFormat
+	// IntFormat, if set, is used when an integer is converted to a string.
+	IntFormat *IntFormat
+	// BoolFormat, if set, is used when a bool is converted to a string.
+	BoolFormat *BoolFormat
+	// Truthiness is applied when a string is converted to bool.
+	Truthiness Truthiness
+	// Weak coerces values like the WeaklyTypedInput option of mapstructure,
+	// for configuration that comes from dynamically typed sources: empty
+	// strings convert to the zero value of numbers and bools, "true" and
+	// "false" to 1 and 0, numeric strings to bools by nonzero as with
+	// TruthNumeric, integer strings with a 0x, 0o or 0b prefix in their base,
+	// and bools to the strings "1" and "0". Numbers convert to bools by
+	// nonzero in any mode.
+	Weak bool
+	// Strict rejects implicit conversions between bools and numbers with
+	// ErrImplicitCoercion, such as true converted to 1 or 2 converted to
+	// true, including those of the Weak option and of TruthNumeric strings.
+	// Strings still convert to bools from their names, and "1" and "0"
+	// convert as numbers, not as bools.
+	Strict bool
+	// Bytes is the encoding used when a byte slice is converted to a string.
+	Bytes BytesEncoding
+	// UTF8 is applied when a byte slice is converted to a string with
+	// BytesRaw, as with StringToValidUTF8.
+	UTF8 InvalidUTF8
+	// EpochStrings parses digit-only strings (e.g. "1678867200") as Unix
+	// timestamps in seconds when they are converted to time.Time.
+	EpochStrings bool
+	// TimeLayout, if set, is the layout used when a time.Time is converted
+	// to a string. By default, times are formatted with TimeToStringRFC3339.
+	TimeLayout string
+	// Locale, if set, is used to parse month and weekday names in strings
+	// converted to time.Time, as with StringToTimeLocale.
+	Locale *Locale
+	// DateOrder is applied when a numeric date such as "03/04/05" is
+	// converted to time.Time, as with StringToTimeOrder.
+	DateOrder DateOrder
+	// YearPivot is the first year of the 100-year window two-digit years
+	// are mapped into when DateOrder is set. Zero means 1969, as in
+	// time.Parse.
+	YearPivot int
+}
+
+// defaults holds the Options used by TryInto and the TryIntoXxx functions.
+var defaults atomic.Value
+
+// SetDefaults sets the options used by the generic conversion functions.
+//
+// SetDefaults sets the options applied by Into, TryInto and the TryIntoXxx
+// functions, so a program can opt into a policy once instead of passing
+// Options to every call site. It is safe for concurrent use. The direct
+// converters (e.g. Float64ToInt32) are not affected.
+//
+// Parameters:
+//   - opts: the options to be used by default.
+//
+// Example:
+//
+//	SetDefaults(Options{Overflow: Saturate})
+//	result, err := TryInto[int8](1000)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 127
+func SetDefaults(opts Options) {
+	defaults.Store(opts)
+}
+
+// Defaults returns the options used by the generic conversion functions.
+//
+// Defaults returns the options most recently set by SetDefaults, or the zero
+// Options if SetDefaults has not been called.
+//
+// Returns:
+//   - Options: the current default options.
+func Defaults() Options {
+	if opts, ok := defaults.Load().(Options); ok {
+		return opts
+	}
+	return Options{}
+}
+
+// TryIntoWith attempts to convert a value of type U to a value of type T
+// using the given options.
+//
+// TryIntoWith attempts to convert a value of type U to a value of type T like
+// TryInto, applying the rounding, overflow, NaN, white space and empty string
+// policies in opts. Functions added with Register and conversion methods are
+// used as by TryInto, and a string value is passed through opts.Normalize
+// before it reaches a registered function.
+//
+// Parameters:
+//   - value: the value to be converted. It must be a convertable type or
+//     time.Time.
+//   - opts: the conversion policies.
+//
+// Returns:
+//   - T: the converted value of type T.
+//   - error: an error if the conversion fails under the given policies.
+//
+// Example:
+//
+//	opts := Options{Rounding: HalfUp, Overflow: Saturate, NaN: Zero, TrimSpace: true}
+//	a, _ := TryIntoWith[int8](2.5, opts)
+//	b, _ := TryIntoWith[int8](" 300 ", opts)
+//	fmt.Println(a, b) // Output: 3 127
+func TryIntoWith[T convertable | time.Time, U convertable | time.Time](value U, opts Options) (result T, err error) {
+	if o := loadObserver(); o != nil {
+		defer func() { o(reflect.TypeOf(value), reflect.TypeOf(result), err) }()
+	}
+	if r, ok, err := convertRegistered(value, reflect.TypeOf(result), opts); ok {
+		if err != nil {
+			return result, err
+		}
+		return r.(T), nil
+	}
+
+	resultType := reflect.TypeOf(result)
+	r, err := toTypeWith(resultType, value, opts)
+	if err != nil {
+		return
+	}
+
+	result = reflect.ValueOf(r).Convert(resultType).Interface().(T)
+	return
+}
+
+// toTypeWith converts a value to the given target type using opts.
+//
+// toTypeWith handles time.Duration, which has the kind of int64 but is
+// converted to and from strings in the Go duration syntax (e.g. "1h30m")
+// and to and from numbers as a count of nanoseconds. time.Time targets are
+// converted with toTimeWith. Other targets are dispatched by kind with
+// toKindWith.
+//
+// Parameters:
+//   - dst: the target type.
+//   - value: the value to be converted.
+//   - opts: the conversion policies.
+//
+// Returns:
+//   - any: the converted value, typed as time.Duration, time.Time or as the
+//     basic type of the kind of dst.
+//   - error: an error if the conversion fails under the given policies.
+func toTypeWith(dst reflect.Type, value any, opts Options) (any, error) {
+	if dst == timeType {
+		return toTimeWith(value, opts)
+	}
+	if d, ok := value.(time.Duration); ok {
+		if dst == durationType {
+			return d, nil
+		}
+		if dst.Kind() == reflect.String {
+			return d.String(), nil
+		}
+		value = int64(d)
+	}
+
+	if dst != durationType {
+		r, err := toKindWith(dst.Kind(), value, opts)
+		// Name the target type itself rather than the basic type of its kind.
+		var unsupported *UnsupportedTypeError
+		if errors.As(err, &unsupported) {
+			unsupported.To = dst.String()
+		}
+		if a := loadAuditor(); a != nil && err == nil {
+			auditLoss(a, dst, value, r, opts)
+		}
+		return r, err
+	}
+	if s, ok := value.(string); ok {
+		s = normalizeString(s, opts)
+		if s == "" && opts.Empty != EmptyReject {
+			return time.Duration(0), nil
+		}
+		return StringToDuration(s)
+	}
+	r, err := toKindWith(reflect.Int64, value, opts)
+	if err != nil {
+		return nil, err
+	}
+	if a := loadAuditor(); a != nil {
+		auditLoss(a, dst, value, r, opts)
+	}
+	return time.Duration(r.(int64)), nil
+}
+
+// toKindWith converts a value to the basic type of the given kind using opts.
+//
+// toKindWith prepares the source value according to opts, dispatches it with
+// toKind and applies the overflow policy to the result.
+//
+// Parameters:
+//   - kind: the kind of the target type.
+//   - value: the value to be converted.
+//   - opts: the conversion policies.
+//
+// Returns:
+//   - any: the converted value, typed as the basic type of kind.
+//   - error: an error if the conversion fails under the given policies.
+func toKindWith(kind reflect.Kind, value any, opts Options) (any, error) {
+	var err error
+	if d, ok := value.(time.Duration); ok && kind == reflect.String {
+		return d.String(), nil
+	}
+	value = kindValue(value)
+	if v, ok := value.(string); ok {
+		value = normalizeString(v, opts)
+	}
+	if opts.Strict {
+		if err := strictValue(kind, value, opts); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Weak {
+		if r, ok, err := weakValue(kind, value); ok {
+			return r, err
+		}
+	}
+	if v, ok := value.(string); ok {
+		if v == "" && kind != reflect.String && opts.Empty != EmptyReject {
+			if t, ok := basicTypes[kind]; ok {
+				return reflect.Zero(t).Interface(), nil
+			}
+		}
+		if kind == reflect.Bool && opts.Truthiness == TruthNumeric && !opts.Strict {
+			return StringToBoolNumeric(v)
+		}
+		if opts.Units && isNumericKind(kind) {
+			if value, err = unitsValue(v); err != nil {
+				return nil, err
+			}
+		} else if opts.DecimalIntegers && isIntegerKind(kind) {
+			if value, err = decimalValue(v, kind.String()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if v, ok := value.(string); ok && opts.NonFinite == NonFiniteReject {
+		switch kind {
+		case reflect.Float64:
+			return StringToFloat64Finite(v)
+		case reflect.Float32:
+			return StringToFloat32Finite(v)
+		}
+	}
+
+	if kind == reflect.String && opts.IntFormat != nil {
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return Int64ToStringFormat(rv.Int(), *opts.IntFormat)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return Uint64ToStringFormat(rv.Uint(), *opts.IntFormat)
+		}
+	}
+
+	if rv := reflect.ValueOf(value); kind == reflect.String && rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+		if opts.Bytes == BytesRaw && opts.UTF8 != UTF8Keep {
+			return StringToValidUTF8(string(rv.Bytes()), opts.UTF8)
+		}
+		return BytesToStringEncoding(rv.Bytes(), opts.Bytes)
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		if kind == reflect.String {
+			if opts.TimeLayout != "" {
+				return TimeToStringLayout(v, opts.TimeLayout)
+			}
+			return TimeToStringRFC3339(v)
+		}
+	case bool:
+		if kind == reflect.String && opts.BoolFormat != nil {
+			return BoolIntoStringAs(v, opts.BoolFormat.True, opts.BoolFormat.False), nil
+		}
+	case float64:
+		if kind == reflect.Uint64 && opts.FloatBits {
+			return Float64ToUint64Bits(v), nil
+		}
+		if kind == reflect.String && opts.FloatFormat != nil {
+			return Float64ToStringFormat(v, *opts.FloatFormat)
+		}
+		if isIntegerKind(kind) {
+			if value, err = roundFloat(v, opts); err != nil {
+				return nil, err
+			}
+		}
+	case float32:
+		if kind == reflect.Uint32 && opts.FloatBits {
+			return Float32ToUint32Bits(v), nil
+		}
+		if kind == reflect.String && opts.FloatFormat != nil {
+			return Float32ToStringFormat(v, *opts.FloatFormat)
+		}
+		if isIntegerKind(kind) {
+			var f float64
+			if f, err = roundFloat(float64(v), opts); err != nil {
+				return nil, err
+			}
+			value = float32(f)
+		}
+	case uint64:
+		if kind == reflect.Float64 && opts.FloatBits {
+			return Uint64BitsToFloat64(v), nil
+		}
+	case uint32:
+		if kind == reflect.Float32 && opts.FloatBits {
+			return Uint32BitsToFloat32(v), nil
+		}
+	}
+
+	result, err := toKind(kind, value)
+	if err != nil && opts.Overflow == Saturate {
+		if bounds, ok := kindBounds[kind]; ok {
+			switch {
+			case errors.Is(err, ErrUnderflow):
+				return bounds[0], nil
+			case errors.Is(err, ErrOverflow):
+				return bounds[1], nil
+			}
+		}
+	}
+	return result, err
+}
+
+// roundFloat applies the rounding and NaN policies of opts to f.
+func roundFloat(f float64, opts Options) (float64, error) {
+	if math.IsNaN(f) {
+		if opts.NaN == Zero {
+			return 0, nil
+		}
+		return 0, ErrNaN
+	}
+
+	switch opts.Rounding {
+	case HalfUp:
+		return math.Round(f), nil
+	case HalfEven:
+		return math.RoundToEven(f), nil
+	case Floor:
+		return math.Floor(f), nil
+	case Ceil:
+		return math.Ceil(f), nil
+	case Exact:
+		if f != math.Trunc(f) {
+			return 0, ErrLossOfPrecision
+		}
+	}
+	return f, nil
+}
+
+// isIntegerKind reports whether kind is a signed or unsigned integer kind.
+func isIntegerKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Uint64
+}
+
+// isNumericKind reports whether kind is an integer or float kind.
+func isNumericKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Float64
+}
+
+// kindBounds holds the minimum and maximum value of each numeric kind,
+// typed as the basic type of the kind.
+var kindBounds = map[reflect.Kind][2]any{
+	reflect.Int:     {int(math.MinInt), int(math.MaxInt)},
+	reflect.Int8:    {int8(math.MinInt8), int8(math.MaxInt8)},
+	reflect.Int16:   {int16(math.MinInt16), int16(math.MaxInt16)},
+	reflect.Int32:   {int32(math.MinInt32), int32(math.MaxInt32)},
+	reflect.Int64:   {int64(math.MinInt64), int64(math.MaxInt64)},
+	reflect.Uint:    {uint(0), uint(math.MaxUint)},
+	reflect.Uint8:   {uint8(0), uint8(math.MaxUint8)},
+	reflect.Uint16:  {uint16(0), uint16(math.MaxUint16)},
+	reflect.Uint32:  {uint32(0), uint32(math.MaxUint32)},
+	reflect.Uint64:  {uint64(0), uint64(math.MaxUint64)},
+	reflect.Float32: {float32(-math.MaxFloat32), float32(math.MaxFloat32)},
+	reflect.Float64: {float64(-math.MaxFloat64), float64(math.MaxFloat64)},
+}
@@ -0,0 +1,317 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestTryIntoWith(t *testing.T) {
+	saturate := Options{Overflow: Saturate}
+	{
+		namePrefix := "Float64"
+		tests := []struct {
+			name    string
+			input   float64
+			opts    Options
+			want    int8
+			wantErr error
+		}{
+			{"truncate", 2.7, Options{}, 2, nil},
+			{"halfUp", 2.5, Options{Rounding: HalfUp}, 3, nil},
+			{"halfUpNegative", -2.5, Options{Rounding: HalfUp}, -3, nil},
+			{"halfEven", 2.5, Options{Rounding: HalfEven}, 2, nil},
+			{"floor", -2.1, Options{Rounding: Floor}, -3, nil},
+			{"ceil", 2.1, Options{Rounding: Ceil}, 3, nil},
+			{"exact", 2, Options{Rounding: Exact}, 2, nil},
+			{"exactFraction", 2.1, Options{Rounding: Exact}, 0, ErrLossOfPrecision},
+			{"nan", math.NaN(), Options{}, 0, ErrNaN},
+			{"nanZero", math.NaN(), Options{NaN: Zero}, 0, nil},
+			{"saturateMax", 1000, saturate, math.MaxInt8, nil},
+			{"saturateMin", -1000, saturate, math.MinInt8, nil},
+			{"saturateInf", math.Inf(1), saturate, math.MaxInt8, nil},
+			{"roundIntoRange", 127.4, Options{Rounding: HalfUp}, 127, nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(namePrefix+tt.name, func(t *testing.T) {
+				got, err := TryIntoWith[int8](tt.input, tt.opts)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("TryIntoWith() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("TryIntoWith() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+
+	{
+		namePrefix := "String"
+		tests := []struct {
+			name    string
+			input   string
+			opts    Options
+			want    uint8
+			wantErr bool
+		}{
+			{"plain", "42", Options{}, 42, false},
+			{"untrimmed", " 42\n", Options{}, 0, true},
+			{"trimmed", " 42\n", Options{TrimSpace: true}, 42, false},
+			{"overflow", "300", Options{}, 0, true},
+			{"saturate", "300", saturate, math.MaxUint8, false},
+			{"negative", "-5", Options{}, 0, true},
+			{"saturateNegative", "-5", saturate, 0, false},
+			{"saturateSyntax", "abc", saturate, 0, true},
+		}
+
+		for _, tt := range tests {
+			t.Run(namePrefix+tt.name, func(t *testing.T) {
+				got, err := TryIntoWith[uint8](tt.input, tt.opts)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("TryIntoWith() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("TryIntoWith() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+
+	{
+		namePrefix := "Int64"
+		tests := []struct {
+			name    string
+			input   int64
+			opts    Options
+			want    uint16
+			wantErr bool
+		}{
+			{"inRange", 123, saturate, 123, false},
+			{"negative", -1, Options{}, 0, true},
+			{"saturateNegative", -1, saturate, 0, false},
+			{"saturateMax", math.MaxInt64, saturate, math.MaxUint16, false},
+		}
+
+		for _, tt := range tests {
+			t.Run(namePrefix+tt.name, func(t *testing.T) {
+				got, err := TryIntoWith[uint16](tt.input, tt.opts)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("TryIntoWith() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("TryIntoWith() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	if _, err := TryInto[int8](1000); err == nil {
+		t.Fatalf("TryInto() with zero defaults should reject overflow")
+	}
+
+	SetDefaults(Options{Overflow: Saturate, TrimSpace: true})
+	if got, err := TryInto[int8](1000); err != nil || got != math.MaxInt8 {
+		t.Errorf("TryInto() = %v, %v, want %v", got, err, math.MaxInt8)
+	}
+	if got, err := TryIntoUint16(" 70000 "); err != nil || got != math.MaxUint16 {
+		t.Errorf("TryIntoUint16() = %v, %v, want %v", got, err, math.MaxUint16)
+	}
+	if _, err := Float64ToInt8(1000); err == nil {
+		t.Errorf("Float64ToInt8() should not be affected by defaults")
+	}
+
+	SetDefaults(Options{Empty: EmptyZero})
+	if got, err := TryInto[int](""); err != nil || got != 0 {
+		t.Errorf("TryInto[int](\"\") = %v, %v, want 0", got, err)
+	}
+	if _, err := StringToInt(""); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("StringToInt(\"\") error = %v, want ErrSyntax whatever the defaults", err)
+	}
+	if _, err := StringToFloat64Trimmed(" "); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("StringToFloat64Trimmed(\" \") error = %v, want ErrSyntax whatever the defaults", err)
+	}
+}
+
+func TestEmptyPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    Options
+		want    int32
+		wantErr bool
+	}{
+		{"reject", "", Options{}, 0, true},
+		{"zero", "", Options{Empty: EmptyZero}, 0, false},
+		{"unset", "", Options{Empty: EmptyUnset}, 0, false},
+		{"blankUntrimmed", "  ", Options{Empty: EmptyZero}, 0, true},
+		{"blankTrimmed", "  ", Options{Empty: EmptyZero, TrimSpace: true}, 0, false},
+		{"value", "7", Options{Empty: EmptyZero}, 7, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryIntoWith[int32](tt.input, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TryIntoWith() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := StringToInt32Trimmed(" 42\n"); err != nil || got != 42 {
+		t.Errorf("StringToInt32Trimmed() = %v, %v, want 42", got, err)
+	}
+}
+
+func TestBindOptionsEmptyPolicy(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	type config struct {
+		Limit *int   `into:"limit"`
+		Name  string `into:"name"`
+	}
+	kv := map[string]string{"limit": " ", "name": ""}
+
+	SetDefaults(Options{TrimSpace: true, Empty: EmptyUnset})
+	var unset config
+	if err := BindOptions(&unset, kv); err != nil || unset.Limit != nil {
+		t.Errorf("BindOptions() with EmptyUnset = %+v, %v", unset, err)
+	}
+
+	SetDefaults(Options{TrimSpace: true, Empty: EmptyZero})
+	var zero config
+	if err := BindOptions(&zero, kv); err != nil || zero.Limit == nil || *zero.Limit != 0 {
+		t.Errorf("BindOptions() with EmptyZero = %+v, %v", zero, err)
+	}
+
+	SetDefaults(Options{TrimSpace: true})
+	var reject config
+	if err := BindOptions(&reject, kv); err == nil {
+		t.Errorf("BindOptions() with EmptyReject should fail")
+	}
+}
+
+func TestTruthiness(t *testing.T) {
+	numeric := Options{Truthiness: TruthNumeric}
+	tests := []struct {
+		name    string
+		input   string
+		opts    Options
+		want    bool
+		wantErr bool
+	}{
+		{"strictOne", "1", Options{}, true, false},
+		{"strictTwo", "2", Options{}, false, true},
+		{"numericTwo", "2", numeric, true, false},
+		{"numericNegative", "-0.5", numeric, true, false},
+		{"numericZero", "0.0", numeric, false, false},
+		{"numericWord", "true", numeric, true, false},
+		{"numericHuge", "1e400", numeric, true, false},
+		{"numericNaN", "NaN", numeric, false, true},
+		{"numericInvalid", "yes", numeric, false, true},
+		{"numericEmpty", "", Options{Truthiness: TruthNumeric, Empty: EmptyZero}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryIntoWith[bool](tt.input, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TryIntoWith() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoWith() = %v, want %v", got, tt.want)
+			}
+			// Numeric strings must agree with the numeric sources.
+			if f, err := StringToFloat64(tt.input); err == nil && !tt.wantErr && tt.opts.Truthiness == TruthNumeric {
+				if b, _ := Float64ToBool(f); b != got {
+					t.Errorf("TryIntoWith(%q) = %v, but Float64ToBool(%v) = %v", tt.input, got, f, b)
+				}
+			}
+		})
+	}
+
+	if _, err := StringToBoolNumeric("NaN"); !errors.Is(err, ErrNaN) {
+		t.Errorf("StringToBoolNumeric(\"NaN\") error = %v, want %v", err, ErrNaN)
+	}
+}
+
+func TestNonFinite(t *testing.T) {
+	reject := Options{NonFinite: NonFiniteReject}
+	tests := []struct {
+		name    string
+		input   string
+		opts    Options
+		want    float64
+		wantErr error
+	}{
+		{"acceptInf", "Inf", Options{}, math.Inf(1), nil},
+		{"acceptNegativeInf", "-inf", Options{}, math.Inf(-1), nil},
+		{"rejectNaN", "NaN", reject, 0, ErrNaN},
+		{"rejectInf", "+Inf", reject, 0, ErrOverflow},
+		{"rejectNegativeInf", "-Infinity", reject, 0, ErrUnderflow},
+		{"rejectFinite", "1.5", reject, 1.5, nil},
+		{"rejectOutOfRange", "1e400", reject, 0, ErrOverflow},
+		{"rejectTrimmed", " nan ", Options{NonFinite: NonFiniteReject, TrimSpace: true}, 0, ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryIntoWith[float64](tt.input, tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TryIntoWith[float64](%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoWith[float64](%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := TryIntoWith[float32]("Inf", reject); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TryIntoWith[float32](\"Inf\") error = %v, want ErrOverflow", err)
+	}
+	if _, err := StringToFloat32Finite("NaN"); !errors.Is(err, ErrNaN) {
+		t.Errorf("StringToFloat32Finite(\"NaN\") error = %v, want ErrNaN", err)
+	}
+	if got, err := StringToFloat32Finite("2.5"); err != nil || got != 2.5 {
+		t.Errorf("StringToFloat32Finite(\"2.5\") = %v, %v, want 2.5", got, err)
+	}
+}
+
+func TestNaNToInteger(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		name    string
+		convert func() error
+	}{
+		{"Float64ToInt", func() error { _, err := Float64ToInt(nan); return err }},
+		{"Float64ToInt64", func() error { _, err := Float64ToInt64(nan); return err }},
+		{"Float64ToUint8", func() error { _, err := Float64ToUint8(nan); return err }},
+		{"Float32ToInt32", func() error { _, err := Float32ToInt32(float32(nan)); return err }},
+		{"Float32ToUint64", func() error { _, err := Float32ToUint64(float32(nan)); return err }},
+		{"TryIntoInt16", func() error { _, err := TryIntoInt16(nan); return err }},
+		{"TryIntoWithSaturate", func() error { _, err := TryIntoWith[uint32](nan, Options{Overflow: Saturate}); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.convert(); !errors.Is(err, ErrNaN) {
+				t.Errorf("error = %v, want ErrNaN", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package into
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Pack appends values to a byte slice as fixed-width fields.
+//
+// Pack converts each value to the kind at the same position in layout with
+// the checked converters, so that a value that does not fit its field is
+// rejected instead of being truncated as with encoding/binary, and appends
+// it to dst in the given byte order. Bool fields take one byte, 1 for true
+// and 0 for false, and float fields hold the IEEE 754 bits. The kinds Int
+// and Uint are rejected, as their width depends on the platform. Values may
+// be of any type accepted by TryIntoAny. The options set by SetDefaults are
+// applied.
+//
+// Parameters:
+//   - dst: the slice to append to, or nil.
+//   - order: the byte order, e.g. binary.BigEndian.
+//   - layout: the kind of each field: Bool, Int8 to Int64, Uint8 to Uint64,
+//     Float32 or Float64.
+//   - values: the value of each field.
+//
+// Returns:
+//   - []byte: dst with the fields appended, or dst if an error is returned.
+//   - error: an *IndexError naming the first field that cannot be packed,
+//     or an error if layout and values differ in length.
+//
+// Example:
+//
+//	layout := []reflect.Kind{reflect.Uint16, reflect.Int8, reflect.Float32}
+//	b, err := Pack(nil, binary.BigEndian, layout, 258, "-1", 1.5)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Printf("% x\n", b) // Output: 01 02 ff 3f c0 00 00
+func Pack(dst []byte, order binary.ByteOrder, layout []reflect.Kind, values ...any) ([]byte, error) {
+	if len(layout) != len(values) {
+		return dst, fmt.Errorf("layout has %d fields, got %d values", len(layout), len(values))
+	}
+	opts := Defaults()
+	out := dst
+	var buf [8]byte
+	for i, kind := range layout {
+		size, ok := packSize(kind)
+		if !ok {
+			return dst, &IndexError{Index: i, Err: fmt.Errorf("%w %s in packed layout", ErrUnsupportedType, kind)}
+		}
+		v, err := basicValue(values[i], basicTypes[kind])
+		if err == nil {
+			v, err = toKindWith(kind, v, opts)
+		}
+		if err != nil {
+			return dst, &IndexError{Index: i, Err: err}
+		}
+		putPacked(buf[:size], order, v)
+		out = append(out, buf[:size]...)
+	}
+	return out, nil
+}
+
+// Unpack reads fixed-width fields from a byte slice into destinations.
+//
+// Unpack is the inverse of Pack: it reads each field of layout from src in
+// the given byte order and converts it to the type pointed to by the
+// destination at the same position with the checked converters, so that a
+// uint16 field can be read into an *int or a *uint8 that it fits. The
+// options set by SetDefaults are applied.
+//
+// Parameters:
+//   - src: the packed fields. Bytes after the last field are ignored.
+//   - order: the byte order, e.g. binary.BigEndian.
+//   - layout: the kind of each field, as for Pack.
+//   - dsts: a non-nil pointer for each field, to a bool, integer, float,
+//     string or time.Time type.
+//
+// Returns:
+//   - int: the number of bytes read.
+//   - error: an *IndexError naming the first field that cannot be unpacked,
+//     wrapping ErrLength if src is too short, or an error if layout and dsts
+//     differ in length. The fields before it are stored.
+//
+// Example:
+//
+//	var (
+//	  port uint16
+//	  temp float64
+//	)
+//	layout := []reflect.Kind{reflect.Uint16, reflect.Float32}
+//	_, err := Unpack([]byte{0x1f, 0x90, 0x41, 0xac, 0, 0}, binary.BigEndian, layout, &port, &temp)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(port, temp) // Output: 8080 21.5
+func Unpack(src []byte, order binary.ByteOrder, layout []reflect.Kind, dsts ...any) (int, error) {
+	if len(layout) != len(dsts) {
+		return 0, fmt.Errorf("layout has %d fields, got %d destinations", len(layout), len(dsts))
+	}
+	opts := Defaults()
+	n := 0
+	for i, kind := range layout {
+		size, ok := packSize(kind)
+		if !ok {
+			return n, &IndexError{Index: i, Err: fmt.Errorf("%w %s in packed layout", ErrUnsupportedType, kind)}
+		}
+		rv := reflect.ValueOf(dsts[i])
+		if rv.Kind() != reflect.Ptr || rv.IsNil() {
+			return n, &IndexError{Index: i, Err: fmt.Errorf("destination must be a non-nil pointer, got %T", dsts[i])}
+		}
+		if len(src)-n < size {
+			return n, &IndexError{Index: i, Err: lengthError(src[n:], size, kind.String())}
+		}
+		v := getPacked(src[n:n+size], order, kind)
+		if err := setValue(rv.Elem(), v, opts); err != nil {
+			return n, &IndexError{Index: i, Err: err}
+		}
+		n += size
+	}
+	return n, nil
+}
+
+// packSize returns the width in bytes of a packed field of the given kind,
+// and whether the kind can be packed.
+func packSize(kind reflect.Kind) (int, bool) {
+	switch kind {
+	case reflect.Bool, reflect.Int8, reflect.Uint8:
+		return 1, true
+	case reflect.Int16, reflect.Uint16:
+		return 2, true
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 4, true
+	case reflect.Int64, reflect.Uint64, reflect.Float64:
+		return 8, true
+	}
+	return 0, false
+}
+
+// putPacked writes v, a basic value of a kind accepted by packSize, to b,
+// which has the width of the kind.
+func putPacked(b []byte, order binary.ByteOrder, v any) {
+	switch v := v.(type) {
+	case bool:
+		b[0] = 0
+		if v {
+			b[0] = 1
+		}
+	case int8:
+		b[0] = byte(v)
+	case uint8:
+		b[0] = v
+	case int16:
+		order.PutUint16(b, uint16(v))
+	case uint16:
+		order.PutUint16(b, v)
+	case int32:
+		order.PutUint32(b, uint32(v))
+	case uint32:
+		order.PutUint32(b, v)
+	case float32:
+		order.PutUint32(b, math.Float32bits(v))
+	case int64:
+		order.PutUint64(b, uint64(v))
+	case uint64:
+		order.PutUint64(b, v)
+	case float64:
+		order.PutUint64(b, math.Float64bits(v))
+	}
+}
+
+// getPacked reads a basic value of a kind accepted by packSize from b,
+// which has the width of the kind.
+func getPacked(b []byte, order binary.ByteOrder, kind reflect.Kind) any {
+	switch kind {
+	case reflect.Bool:
+		return b[0] != 0
+	case reflect.Int8:
+		return int8(b[0])
+	case reflect.Uint8:
+		return b[0]
+	case reflect.Int16:
+		return int16(order.Uint16(b))
+	case reflect.Uint16:
+		return order.Uint16(b)
+	case reflect.Int32:
+		return int32(order.Uint32(b))
+	case reflect.Uint32:
+		return order.Uint32(b)
+	case reflect.Float32:
+		return math.Float32frombits(order.Uint32(b))
+	case reflect.Int64:
+		return int64(order.Uint64(b))
+	case reflect.Uint64:
+		return order.Uint64(b)
+	case reflect.Float64:
+		return math.Float64frombits(order.Uint64(b))
+	}
+	return nil
+}
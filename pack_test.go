@@ -0,0 +1,161 @@
+package into_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestPack(t *testing.T) {
+	type celsius float32
+
+	tests := []struct {
+		name    string
+		order   binary.ByteOrder
+		layout  []reflect.Kind
+		values  []any
+		want    []byte
+		wantErr error
+		index   int
+	}{
+		{
+			"big endian", binary.BigEndian,
+			[]reflect.Kind{reflect.Uint16, reflect.Int8, reflect.Float32},
+			[]any{258, "-1", 1.5},
+			[]byte{0x01, 0x02, 0xff, 0x3f, 0xc0, 0x00, 0x00}, nil, 0,
+		},
+		{
+			"little endian", binary.LittleEndian,
+			[]reflect.Kind{reflect.Int32, reflect.Bool, reflect.Uint64},
+			[]any{int64(-2), true, uint8(1)},
+			[]byte{0xfe, 0xff, 0xff, 0xff, 0x01, 0x01, 0, 0, 0, 0, 0, 0, 0}, nil, 0,
+		},
+		{
+			"named and float64", binary.BigEndian,
+			[]reflect.Kind{reflect.Float64, reflect.Int16},
+			[]any{celsius(-2), celsius(300)},
+			[]byte{0xc0, 0, 0, 0, 0, 0, 0, 0, 0x01, 0x2c}, nil, 0,
+		},
+		{
+			"overflow", binary.BigEndian,
+			[]reflect.Kind{reflect.Uint8, reflect.Uint8},
+			[]any{255, 256},
+			nil, ErrOverflow, 1,
+		},
+		{
+			"negative to unsigned", binary.BigEndian,
+			[]reflect.Kind{reflect.Uint32},
+			[]any{-1},
+			nil, ErrUnderflow, 0,
+		},
+		{
+			"platform width", binary.BigEndian,
+			[]reflect.Kind{reflect.Int8, reflect.Int},
+			[]any{1, 1},
+			nil, ErrUnsupportedType, 1,
+		},
+		{
+			"nil value", binary.BigEndian,
+			[]reflect.Kind{reflect.Int8},
+			[]any{nil},
+			nil, ErrNil, 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix := []byte{0xaa}
+			got, err := Pack(prefix, tt.order, tt.layout, tt.values...)
+			if tt.wantErr != nil {
+				var indexErr *IndexError
+				if !errors.Is(err, tt.wantErr) || !errors.As(err, &indexErr) || indexErr.Index != tt.index {
+					t.Fatalf("error = %v, want %v at index %d", err, tt.wantErr, tt.index)
+				}
+				if !bytes.Equal(got, prefix) {
+					t.Fatalf("Pack() = % x on error, want dst", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Pack() error = %v", err)
+			}
+			if want := append([]byte{0xaa}, tt.want...); !bytes.Equal(got, want) {
+				t.Errorf("Pack() = % x, want % x", got, want)
+			}
+		})
+	}
+
+	if _, err := Pack(nil, binary.BigEndian, []reflect.Kind{reflect.Int8}); err == nil {
+		t.Errorf("Pack() with missing values: error = nil")
+	}
+}
+
+func TestUnpack(t *testing.T) {
+	src := []byte{0x1f, 0x90, 0xff, 0x41, 0xac, 0x00, 0x00, 0x01, 0xee}
+	layout := []reflect.Kind{reflect.Uint16, reflect.Int8, reflect.Float32, reflect.Bool}
+	var (
+		port uint16
+		off  int
+		temp float64
+		ok   *bool
+	)
+	n, err := Unpack(src, binary.BigEndian, layout, &port, &off, &temp, &ok)
+	if err != nil {
+		t.Fatalf("Unpack() error = %v", err)
+	}
+	if n != 8 || port != 8080 || off != -1 || temp != 21.5 || ok == nil || !*ok {
+		t.Errorf("Unpack() = %d, %v, %v, %v, %v", n, port, off, temp, ok)
+	}
+
+	var small uint8
+	_, err = Unpack([]byte{0x01, 0x2c}, binary.BigEndian, []reflect.Kind{reflect.Uint16}, &small)
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("Unpack() into uint8 error = %v, want ErrOverflow", err)
+	}
+
+	var a, b uint16
+	n, err = Unpack([]byte{0, 1, 2}, binary.BigEndian, []reflect.Kind{reflect.Uint16, reflect.Uint16}, &a, &b)
+	var indexErr *IndexError
+	if !errors.Is(err, ErrLength) || !errors.As(err, &indexErr) || indexErr.Index != 1 || n != 2 || a != 1 {
+		t.Errorf("Unpack() short input = %d, %v, want ErrLength at index 1", n, err)
+	}
+
+	if _, err := Unpack([]byte{0}, binary.BigEndian, []reflect.Kind{reflect.Uint8}, small); err == nil {
+		t.Errorf("Unpack() into non-pointer: error = nil")
+	}
+}
+
+func TestPackRoundTrip(t *testing.T) {
+	layout := []reflect.Kind{
+		reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Bool,
+	}
+	values := []any{
+		int8(math.MinInt8), int16(math.MinInt16), int32(math.MinInt32), int64(math.MinInt64),
+		uint8(math.MaxUint8), uint16(math.MaxUint16), uint32(math.MaxUint32), uint64(math.MaxUint64),
+		float32(0.1), math.Inf(-1), false,
+	}
+	for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+		b, err := Pack(nil, order, layout, values...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dsts := make([]any, len(values))
+		for i, v := range values {
+			dsts[i] = reflect.New(reflect.TypeOf(v)).Interface()
+		}
+		if n, err := Unpack(b, order, layout, dsts...); err != nil || n != len(b) {
+			t.Fatalf("Unpack() = %d, %v", n, err)
+		}
+		for i, v := range values {
+			if got := reflect.ValueOf(dsts[i]).Elem().Interface(); got != v {
+				t.Errorf("%s field %d = %v, want %v", order, i, got, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package into
+
+// ZeroPort is the handling of port 0 by StringToPortWith.
+type ZeroPort int
+
+const (
+	// ZeroPortReject rejects port 0, which cannot be dialed.
+	ZeroPortReject ZeroPort = iota
+	// ZeroPortAllow accepts port 0, which asks the system to choose a
+	// free port when listening.
+	ZeroPortAllow
+)
+
+// StringToPort converts a string value to a TCP or UDP port number.
+//
+// StringToPort parses the string as a base 10 integer and checks that it is
+// a port number between 1 and 65535. Use StringToPortWith and ZeroPortAllow
+// for listen addresses, where port 0 is valid.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - uint16: the port number.
+//   - error: a *ConversionError wrapping strconv.ErrSyntax if the value is
+//     not a valid integer, or ErrUnderflow or ErrOverflow if it is
+//     outside the range 1 to 65535.
+//
+// Example:
+//
+//	port, err := StringToPort("8080")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(port) // Output: 8080
+func StringToPort(value string) (uint16, error) {
+	return StringToPortWith(value, ZeroPortReject)
+}
+
+// StringToPortWith converts a string value to a port number with a policy
+// for port 0.
+//
+// StringToPortWith converts like StringToPort, but accepts port 0 if zero
+// is ZeroPortAllow.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//   - zero: the handling of port 0.
+//
+// Returns:
+//   - uint16: the port number.
+//   - error: an error if the value is not a valid port number.
+//
+// Example:
+//
+//	port, err := StringToPortWith("0", ZeroPortAllow)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(port) // Output: 0
+func StringToPortWith(value string, zero ZeroPort) (uint16, error) {
+	port := ConstrainedUint16{Name: "port", Min: 1}
+	if zero == ZeroPortAllow {
+		port.Min = 0
+	}
+	return port.Parse(value)
+}
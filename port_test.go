@@ -0,0 +1,45 @@
+package into_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToPort(t *testing.T) {
+	tests := []struct {
+		value   string
+		zero    ZeroPort
+		want    uint16
+		wantErr error
+	}{
+		{"8080", ZeroPortReject, 8080, nil},
+		{"1", ZeroPortReject, 1, nil},
+		{"65535", ZeroPortReject, 65535, nil},
+		{"0", ZeroPortReject, 0, ErrUnderflow},
+		{"0", ZeroPortAllow, 0, nil},
+		{"65536", ZeroPortAllow, 0, ErrOverflow},
+		{"-1", ZeroPortAllow, 0, ErrUnderflow},
+		{"-", ZeroPortAllow, 0, strconv.ErrSyntax},
+		{"http", ZeroPortReject, 0, strconv.ErrSyntax},
+		{"", ZeroPortReject, 0, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := StringToPortWith(tt.value, tt.zero)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("StringToPortWith(%q, %v) error = %v, want %v", tt.value, tt.zero, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("StringToPortWith(%q, %v) = %d, want %d", tt.value, tt.zero, got, tt.want)
+			}
+			if tt.zero == ZeroPortReject {
+				if port, err := StringToPort(tt.value); port != got || (err == nil) != (tt.wantErr == nil) {
+					t.Errorf("StringToPort(%q) = %d, %v, want %d", tt.value, port, err, got)
+				}
+			}
+		})
+	}
+}
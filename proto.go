@@ -0,0 +1,173 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// The limits of the protobuf well-known types, as checked by the CheckValid
+// methods of timestamppb.Timestamp and durationpb.Duration.
+const (
+	// minTimestampSeconds is 0001-01-01T00:00:00Z in Unix seconds.
+	minTimestampSeconds = -62135596800
+	// maxTimestampSeconds is 9999-12-31T23:59:59Z in Unix seconds.
+	maxTimestampSeconds = 253402300799
+	// maxDurationSeconds is approximately 10,000 years in seconds.
+	maxDurationSeconds = 315576000000
+)
+
+// TimeToProtoTimestamp converts a time.Time value to the fields of a protobuf Timestamp.
+//
+// TimeToProtoTimestamp converts a time.Time value to the seconds and nanos
+// fields of a google.protobuf.Timestamp, as timestamppb.New does, without
+// importing protobuf. The nanos are always in the range [0, 999999999], so
+// times before the Unix epoch have a negative number of seconds and positive
+// nanos.
+//
+// Parameters:
+//   - value: the time.Time value to be converted.
+//
+// Returns:
+//   - int64: the number of seconds since the Unix epoch.
+//   - int32: the non-negative fraction of a second in nanoseconds.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if the
+//     time is outside the Timestamp range, which is from
+//     0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z.
+//
+// Example:
+//
+//	seconds, nanos, err := TimeToProtoTimestamp(time.Unix(1700000000, 500))
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(seconds, nanos) // Output: 1700000000 500
+func TimeToProtoTimestamp(value time.Time) (int64, int32, error) {
+	seconds := value.Unix()
+	if seconds > maxTimestampSeconds {
+		return 0, 0, overflowError(value, "timestamp")
+	}
+	if seconds < minTimestampSeconds {
+		return 0, 0, underflowError(value, "timestamp")
+	}
+	return seconds, int32(value.Nanosecond()), nil
+}
+
+// ProtoTimestampToTime converts the fields of a protobuf Timestamp to a time.Time value.
+//
+// ProtoTimestampToTime converts the seconds and nanos fields of a
+// google.protobuf.Timestamp to a time.Time value in UTC, as the AsTime
+// method of timestamppb.Timestamp does, after checking that the fields are
+// valid.
+//
+// Parameters:
+//   - seconds: the number of seconds since the Unix epoch.
+//   - nanos: the non-negative fraction of a second in nanoseconds.
+//
+// Returns:
+//   - time.Time: the converted time.Time value.
+//   - error: a *ConversionError wrapping ErrOverflow or ErrUnderflow if
+//     seconds is outside the Timestamp range or nanos is outside
+//     [0, 999999999].
+//
+// Example:
+//
+//	result, err := ProtoTimestampToTime(1700000000, 0)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 2023-11-14 22:13:20 +0000 UTC
+func ProtoTimestampToTime(seconds int64, nanos int32) (time.Time, error) {
+	switch {
+	case seconds > maxTimestampSeconds || nanos >= int32(time.Second):
+		return time.Time{}, protoError("timestamp", seconds, nanos, "time.Time", ErrOverflow)
+	case seconds < minTimestampSeconds || nanos < 0:
+		return time.Time{}, protoError("timestamp", seconds, nanos, "time.Time", ErrUnderflow)
+	}
+	return time.Unix(seconds, int64(nanos)).UTC(), nil
+}
+
+// DurationToProtoDuration converts a time.Duration value to the fields of a protobuf Duration.
+//
+// DurationToProtoDuration converts a time.Duration value to the seconds and
+// nanos fields of a google.protobuf.Duration, as durationpb.New does,
+// without importing protobuf. Both fields have the sign of the duration.
+//
+// Parameters:
+//   - value: the time.Duration value to be converted.
+//
+// Returns:
+//   - int64: the number of whole seconds.
+//   - int32: the fraction of a second in nanoseconds, with the sign of value.
+//   - error: nil, as every time.Duration is within the Duration range.
+//
+// Example:
+//
+//	seconds, nanos, err := DurationToProtoDuration(-1500 * time.Millisecond)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(seconds, nanos) // Output: -1 -500000000
+func DurationToProtoDuration(value time.Duration) (int64, int32, error) {
+	nanos := value.Nanoseconds()
+	return nanos / int64(time.Second), int32(nanos % int64(time.Second)), nil
+}
+
+// ProtoDurationToDuration converts the fields of a protobuf Duration to a time.Duration value.
+//
+// ProtoDurationToDuration converts the seconds and nanos fields of a
+// google.protobuf.Duration to a time.Duration value, after checking that
+// the fields are valid: seconds must be within approximately ±10,000
+// years, nanos within ±999999999 and both must have the same sign.
+//
+// Parameters:
+//   - seconds: the number of whole seconds.
+//   - nanos: the fraction of a second in nanoseconds.
+//
+// Returns:
+//   - time.Duration: the converted time.Duration value.
+//   - error: a *ConversionError if the signs of the fields differ, or
+//     wrapping ErrOverflow or ErrUnderflow if a field is out of its range
+//     or the duration is out of the time.Duration range, which is
+//     approximately ±292 years.
+//
+// Example:
+//
+//	result, err := ProtoDurationToDuration(90, 500000000)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1m30.5s
+func ProtoDurationToDuration(seconds int64, nanos int32) (time.Duration, error) {
+	if seconds > 0 && nanos < 0 || seconds < 0 && nanos > 0 {
+		return 0, protoError("duration", seconds, nanos, "time.Duration", errMixedSigns)
+	}
+	switch {
+	case seconds > maxDurationSeconds || nanos >= int32(time.Second):
+		return 0, protoError("duration", seconds, nanos, "time.Duration", ErrOverflow)
+	case seconds < -maxDurationSeconds || nanos <= -int32(time.Second):
+		return 0, protoError("duration", seconds, nanos, "time.Duration", ErrUnderflow)
+	}
+
+	// The range of time.Duration is narrower than the range of Duration.
+	const maxSeconds = math.MaxInt64 / int64(time.Second)
+	if seconds > maxSeconds || seconds == maxSeconds && int64(nanos) > math.MaxInt64%int64(time.Second) {
+		return 0, protoError("duration", seconds, nanos, "time.Duration", ErrOverflow)
+	}
+	if seconds < -maxSeconds || seconds == -maxSeconds && int64(nanos) < math.MinInt64%int64(time.Second) {
+		return 0, protoError("duration", seconds, nanos, "time.Duration", ErrUnderflow)
+	}
+	return time.Duration(seconds)*time.Second + time.Duration(nanos), nil
+}
+
+// errMixedSigns is wrapped by the error of ProtoDurationToDuration when the
+// seconds and nanos of a Duration have different signs.
+var errMixedSigns = errors.New("seconds and nanos have different signs")
+
+// protoError returns a ConversionError for the fields of a protobuf
+// Timestamp or Duration, named from.
+func protoError(from string, seconds int64, nanos int32, to string, err error) error {
+	value := fmt.Sprintf("{seconds:%d nanos:%d}", seconds, nanos)
+	return &ConversionError{From: from, To: to, Value: value, Err: err}
+}
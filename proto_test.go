@@ -0,0 +1,121 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestProtoTimestamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   time.Time
+		seconds int64
+		nanos   int32
+		wantErr error
+	}{
+		{"epoch", time.Unix(0, 0), 0, 0, nil},
+		{"fraction", time.Unix(1700000000, 500), 1700000000, 500, nil},
+		{"beforeEpoch", time.Unix(-1, 250000000), -1, 250000000, nil},
+		{"min", time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), -62135596800, 0, nil},
+		{"max", time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC), 253402300799, 999999999, nil},
+		{"tooLate", time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC), 0, 0, ErrOverflow},
+		{"tooEarly", time.Date(0, 12, 31, 23, 59, 59, 0, time.UTC), 0, 0, ErrUnderflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seconds, nanos, err := TimeToProtoTimestamp(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TimeToProtoTimestamp() error = %v, want %v", err, tt.wantErr)
+			}
+			if seconds != tt.seconds || nanos != tt.nanos {
+				t.Errorf("TimeToProtoTimestamp() = %v, %v, want %v, %v", seconds, nanos, tt.seconds, tt.nanos)
+			}
+			if err != nil {
+				return
+			}
+			got, err := ProtoTimestampToTime(seconds, nanos)
+			if err != nil || !got.Equal(tt.input) || got.Location() != time.UTC {
+				t.Errorf("ProtoTimestampToTime() = %v, %v, want %v in UTC", got, err, tt.input)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name    string
+		seconds int64
+		nanos   int32
+		wantErr error
+	}{
+		{"secondsTooLarge", 253402300800, 0, ErrOverflow},
+		{"secondsTooSmall", -62135596801, 0, ErrUnderflow},
+		{"nanosTooLarge", 0, 1e9, ErrOverflow},
+		{"negativeNanos", 1, -1, ErrUnderflow},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ProtoTimestampToTime(tt.seconds, tt.nanos)
+			var convErr *ConversionError
+			if !errors.Is(err, tt.wantErr) || !errors.As(err, &convErr) || convErr.From != "timestamp" {
+				t.Errorf("ProtoTimestampToTime() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProtoDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   time.Duration
+		seconds int64
+		nanos   int32
+	}{
+		{"zero", 0, 0, 0},
+		{"fraction", 90*time.Second + 500*time.Millisecond, 90, 500000000},
+		{"negative", -1500 * time.Millisecond, -1, -500000000},
+		{"negativeFraction", -time.Nanosecond, 0, -1},
+		{"max", math.MaxInt64, 9223372036, 854775807},
+		{"min", math.MinInt64, -9223372036, -854775808},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seconds, nanos, err := DurationToProtoDuration(tt.input)
+			if err != nil || seconds != tt.seconds || nanos != tt.nanos {
+				t.Fatalf("DurationToProtoDuration() = %v, %v, %v, want %v, %v", seconds, nanos, err, tt.seconds, tt.nanos)
+			}
+			got, err := ProtoDurationToDuration(seconds, nanos)
+			if err != nil || got != tt.input {
+				t.Errorf("ProtoDurationToDuration() = %v, %v, want %v", got, err, tt.input)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name    string
+		seconds int64
+		nanos   int32
+		wantErr error
+	}{
+		{"mixedSigns", 1, -1, nil},
+		{"nanosTooLarge", 0, 1e9, ErrOverflow},
+		{"nanosTooSmall", 0, -1e9, ErrUnderflow},
+		{"beyondDuration", 9223372036, 854775808, ErrOverflow},
+		{"belowDuration", -9223372037, 0, ErrUnderflow},
+		{"beyondProto", 315576000001, 0, ErrOverflow},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ProtoDurationToDuration(tt.seconds, tt.nanos)
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("ProtoDurationToDuration() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
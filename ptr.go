@@ -0,0 +1,52 @@
+package into
+
+// TryIntoPtr attempts to convert a pointer to a value of type U to a pointer
+// to a value of type T.
+//
+// TryIntoPtr converts the value value points to like TryInto and returns a
+// pointer to the result. A nil pointer is converted to a nil pointer without
+// an error, so optional fields of API payloads keep their absence.
+//
+// Parameters:
+//   - value: the pointer to the value to be converted, or nil.
+//
+// Returns:
+//   - *T: a pointer to the converted value, or nil if value is nil.
+//   - error: an error if the conversion fails.
+//
+// Example:
+//
+//	s := "42"
+//	a, _ := TryIntoPtr[int](&s)
+//	b, _ := TryIntoPtr[int]((*string)(nil))
+//	fmt.Println(*a, b) // Output: 42 <nil>
+func TryIntoPtr[T convertable, U convertable](value *U) (*T, error) {
+	if value == nil {
+		return nil, nil
+	}
+	result, err := TryInto[T](*value)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Deref returns the value a pointer points to, or a default for nil.
+//
+// Parameters:
+//   - value: the pointer, or nil.
+//   - def: the value returned if value is nil.
+//
+// Returns:
+//   - T: *value, or def if value is nil.
+//
+// Example:
+//
+//	var timeout *int
+//	fmt.Println(Deref(timeout, 30)) // Output: 30
+func Deref[T any](value *T, def T) T {
+	if value == nil {
+		return def
+	}
+	return *value
+}
@@ -0,0 +1,37 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestTryIntoPtr(t *testing.T) {
+	s := "42"
+	got, err := TryIntoPtr[int8](&s)
+	if err != nil || got == nil || *got != 42 {
+		t.Errorf("TryIntoPtr(&%q) = %v, %v, want 42", s, got, err)
+	}
+
+	got, err = TryIntoPtr[int8]((*string)(nil))
+	if err != nil || got != nil {
+		t.Errorf("TryIntoPtr(nil) = %v, %v, want nil", got, err)
+	}
+
+	big := 300
+	got, err = TryIntoPtr[int8](&big)
+	if !errors.Is(err, ErrOverflow) || got != nil {
+		t.Errorf("TryIntoPtr(&300) = %v, %v, want nil, %v", got, err, ErrOverflow)
+	}
+}
+
+func TestDeref(t *testing.T) {
+	n := 7
+	if got := Deref(&n, 30); got != 7 {
+		t.Errorf("Deref(&7, 30) = %v, want 7", got)
+	}
+	if got := Deref((*int)(nil), 30); got != 30 {
+		t.Errorf("Deref(nil, 30) = %v, want 30", got)
+	}
+}
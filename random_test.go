@@ -0,0 +1,119 @@
+package into_test
+
+import (
+	"errors"
+	"io"
+	"math"
+	mathrand "math/rand"
+	"strings"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestRandomIn(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if v := RandomIn[float32](); math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			t.Fatalf("RandomIn[float32]() = %v", v)
+		}
+		if v := RandomIn[float64](); math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Fatalf("RandomIn[float64]() = %v", v)
+		}
+	}
+
+	// 1000 draws of a uint8 are all the same value with a negligible
+	// probability, and both signs of an int64 are seen.
+	seen := map[uint8]bool{}
+	var neg, pos bool
+	for i := 0; i < 1000; i++ {
+		seen[RandomIn[uint8]()] = true
+		v := RandomIn[int64]()
+		neg = neg || v < 0
+		pos = pos || v >= 0
+	}
+	if len(seen) < 2 || !neg || !pos {
+		t.Errorf("RandomIn() is not spread over the range: %d uint8 values, negative %v, positive %v", len(seen), neg, pos)
+	}
+}
+
+func TestRandomInRange(t *testing.T) {
+	counts := map[int]int{}
+	for i := 0; i < 6000; i++ {
+		v, err := RandomInRange(1, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v < 1 || v > 6 {
+			t.Fatalf("RandomInRange(1, 6) = %d", v)
+		}
+		counts[v]++
+	}
+	if len(counts) != 6 {
+		t.Errorf("RandomInRange(1, 6) returned %v", counts)
+	}
+
+	checkRange(t, int8(math.MinInt8), int8(math.MaxInt8))
+	checkRange(t, int64(math.MinInt64), int64(math.MaxInt64))
+	checkRange(t, int64(-3), int64(-1))
+	checkRange(t, uint64(math.MaxUint64-2), uint64(math.MaxUint64))
+	checkRange(t, uint(0), uint(math.MaxUint))
+	checkRange(t, float32(1), float32(1.0000002))
+	checkRange(t, -math.MaxFloat64, math.MaxFloat64)
+	checkRange(t, 0.5, 0.5)
+
+	type percent uint8
+	checkRange(t, percent(0), percent(100))
+}
+
+// checkRange checks that RandomInRange returns values within [min, max].
+func checkRange[T int8 | int64 | uint | uint64 | float32 | float64 | ~uint8](t *testing.T, min, max T) {
+	t.Helper()
+	for i := 0; i < 200; i++ {
+		v, err := RandomInRange(min, max)
+		if err != nil {
+			t.Fatalf("RandomInRange(%v, %v) error = %v", min, max, err)
+		}
+		if v < min || v > max {
+			t.Fatalf("RandomInRange(%v, %v) = %v", min, max, v)
+		}
+	}
+}
+
+func TestRandomInRangeErrors(t *testing.T) {
+	if _, err := RandomInRange(2, 1); err == nil {
+		t.Errorf("RandomInRange(2, 1) error = nil")
+	}
+	if _, err := RandomInRange(uint8(2), uint8(1)); err == nil {
+		t.Errorf("RandomInRange(uint8(2), uint8(1)) error = nil")
+	}
+	if _, err := RandomInRange(0, math.NaN()); err == nil {
+		t.Errorf("RandomInRange(0, NaN) error = nil")
+	}
+	if _, err := RandomInRange(math.Inf(-1), 0); err == nil {
+		t.Errorf("RandomInRange(-Inf, 0) error = nil")
+	}
+	if _, err := RandomInRangeFrom(strings.NewReader("short"), 0, 10); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("RandomInRangeFrom(short reader) error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestRandomInRangeFromSeeded(t *testing.T) {
+	draw := func() []int {
+		rng := mathrand.New(mathrand.NewSource(1))
+		var values []int
+		for i := 0; i < 10; i++ {
+			v, err := RandomInRangeFrom(rng, 0, 1000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			values = append(values, v)
+		}
+		return values
+	}
+	a, b := draw(), draw()
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("seeded draws differ: %v and %v", a, b)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package into
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Record converts the columns of a CSV record to the fields of a struct.
+//
+// Record converts record[i] to the i-th field of T with the checked string
+// converters, as encoding/csv returns them. The fields are taken in
+// declaration order, with nested structs flattened in place and fields
+// tagged `into:"-"` skipped, so the record must have exactly one column per
+// field. The values are converted like BindOptions does, applying the
+// options set by SetDefaults.
+//
+// Parameters:
+//   - record: the columns of the record.
+//
+// Returns:
+//   - T: the struct holding the converted columns.
+//   - error: an error if T is not a struct or the record has the wrong
+//     number of columns, or a FieldErrors naming the key of every field
+//     whose column cannot be converted.
+//
+// Example:
+//
+//	type Row struct {
+//	  Name  string
+//	  Age   uint8
+//	  Score float64
+//	}
+//	row, err := Record[Row]([]string{"gopher", "13", "9.5"})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(row.Name, row.Age, row.Score) // Output: gopher 13 9.5
+func Record[T any](record []string) (T, error) {
+	var result T
+	rv := reflect.ValueOf(&result).Elem()
+	if rv.Kind() != reflect.Struct {
+		return result, fmt.Errorf("record destination must be a struct, got %s", rv.Type())
+	}
+
+	fields := recordFields(rv, "", nil)
+	if len(record) != len(fields) {
+		return result, fmt.Errorf("record has %d columns, want %d", len(record), len(fields))
+	}
+
+	opts := Defaults()
+	errs := FieldErrors{}
+	for i, f := range fields {
+		if err := setString(f.value, record[i], opts); err != nil {
+			errs[f.key] = fmt.Errorf("cannot bind column %d to field %s: %w", i, f.name, err)
+		}
+	}
+	if len(errs) > 0 {
+		return result, errs
+	}
+	return result, nil
+}
+
+// RecordWithHeader converts the columns of a CSV record to the fields of a
+// struct, matching the columns by name.
+//
+// RecordWithHeader converts record[i] to the field of T whose key is
+// header[i], as BindOptions does for a map of keys to values: the key is
+// taken from the `into` struct tag and defaults to the field name, nested
+// struct fields use their dotted path (e.g. "db.port"), and fields tagged
+// `into:"name,required"` must have a column. Columns without a matching
+// field are ignored.
+//
+// Parameters:
+//   - header: the names of the columns, usually the first record of the file.
+//   - record: the columns of the record.
+//
+// Returns:
+//   - T: the struct holding the converted columns.
+//   - error: an error if T is not a struct or the lengths of header and
+//     record differ, or a FieldErrors naming the key of every column that
+//     cannot be converted and every required column that is missing.
+//
+// Example:
+//
+//	type Row struct {
+//	  Name string `into:"name"`
+//	  Age  uint8  `into:"age"`
+//	}
+//	row, err := RecordWithHeader[Row]([]string{"age", "name"}, []string{"13", "gopher"})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(row.Name, row.Age) // Output: gopher 13
+func RecordWithHeader[T any](header []string, record []string) (T, error) {
+	var result T
+	if t := reflect.TypeOf(result); t == nil || t.Kind() != reflect.Struct {
+		return result, fmt.Errorf("record destination must be a struct, got %T", result)
+	}
+	if len(header) != len(record) {
+		return result, fmt.Errorf("record has %d columns, want %d", len(record), len(header))
+	}
+
+	kv := make(map[string]string, len(header))
+	for i, name := range header {
+		if _, ok := kv[name]; ok {
+			return result, fmt.Errorf("duplicate column %q", name)
+		}
+		kv[name] = record[i]
+	}
+
+	err := BindOptions(&result, kv)
+	return result, err
+}
+
+// recordField is a field of a struct bound by Record.
+type recordField struct {
+	// key is the path of the field's key, as used by BindOptions.
+	key string
+	// name is the name of the field.
+	name string
+	// value is the settable value of the field.
+	value reflect.Value
+}
+
+// recordFields appends the fields of the struct value v to fields in
+// declaration order, flattening nested structs as bindStruct does.
+func recordFields(v reflect.Value, prefix string, fields []recordField) []recordField {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !(field.Anonymous && isNestedStruct(field.Type)) {
+			continue
+		}
+
+		name, _ := parseBindTag(field)
+		if name == "-" {
+			continue
+		}
+
+		if isNestedStruct(field.Type) {
+			nested := prefix + name + "."
+			if field.Anonymous && field.Tag.Get("into") == "" {
+				nested = prefix
+			}
+			fields = recordFields(v.Field(i), nested, fields)
+			continue
+		}
+		fields = append(fields, recordField{key: prefix + name, name: field.Name, value: v.Field(i)})
+	}
+	return fields
+}
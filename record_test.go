@@ -0,0 +1,84 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+type recordRow struct {
+	Name    string
+	Age     uint8
+	Skipped string `into:"-"`
+	Timeout time.Duration
+	Limits  struct {
+		Max *int
+	}
+}
+
+func TestRecord(t *testing.T) {
+	row, err := Record[recordRow]([]string{"gopher", "13", "5s", "10"})
+	if err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if row.Name != "gopher" || row.Age != 13 || row.Timeout != 5*time.Second || row.Limits.Max == nil || *row.Limits.Max != 10 {
+		t.Errorf("Record() = %+v", row)
+	}
+
+	_, err = Record[recordRow]([]string{"gopher", "300", "5s", "x"})
+	var fieldErrs FieldErrors
+	if !errors.As(err, &fieldErrs) || len(fieldErrs) != 2 || !errors.Is(fieldErrs["Age"], ErrOverflow) || fieldErrs["Limits.Max"] == nil {
+		t.Errorf("Record() error = %v, want errors for Age and Limits.Max", err)
+	}
+
+	if _, err := Record[recordRow]([]string{"gopher", "13"}); err == nil {
+		t.Errorf("Record() with missing columns should fail")
+	}
+	if _, err := Record[int]([]string{"1"}); err == nil {
+		t.Errorf("Record[int]() should fail")
+	}
+}
+
+func TestRecordWithHeader(t *testing.T) {
+	type row struct {
+		Name string `into:"name,required"`
+		Age  uint8  `into:"age"`
+		DB   struct {
+			Port uint16 `into:"port"`
+		} `into:"db"`
+	}
+
+	got, err := RecordWithHeader[row]([]string{"age", "extra", "db.port", "name"}, []string{"13", "ignored", "5432", "gopher"})
+	if err != nil {
+		t.Fatalf("RecordWithHeader() error = %v", err)
+	}
+	if got.Name != "gopher" || got.Age != 13 || got.DB.Port != 5432 {
+		t.Errorf("RecordWithHeader() = %+v", got)
+	}
+
+	tests := []struct {
+		name    string
+		header  []string
+		record  []string
+		wantErr error
+	}{
+		{"missingRequired", []string{"age"}, []string{"13"}, nil},
+		{"overflow", []string{"name", "age"}, []string{"gopher", "300"}, ErrOverflow},
+		{"lengthMismatch", []string{"name", "age"}, []string{"gopher"}, nil},
+		{"duplicate", []string{"name", "name"}, []string{"a", "b"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RecordWithHeader[row](tt.header, tt.record)
+			if err == nil {
+				t.Fatal("RecordWithHeader() error = nil, want an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("RecordWithHeader() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package into
+
+import (
+	"reflect"
+	"sync"
+)
+
+// converters holds the conversion functions added by Register, keyed by
+// their source and target types.
+var converters = struct {
+	sync.RWMutex
+	m map[[2]reflect.Type]func(any) (any, error)
+}{m: make(map[[2]reflect.Type]func(any) (any, error))}
+
+// Register registers a conversion function from U to T.
+//
+// Register registers fn as the conversion from U to T. The generic and
+// runtime conversion functions (TryInto, TryIntoWith, TryIntoAny and
+// TryIntoValue) use a registered function instead of the built-in dispatch when the source and
+// target types match exactly, which lets named types such as Celsius or
+// UserID define their own conversions. A later registration for the same
+// pair replaces the earlier one. It is safe for concurrent use.
+//
+// The intogen command generates direct converters and their registrations
+// for named types:
+//
+//	//go:generate go run github.com/zenless-lab/into/cmd/intogen -type Celsius
+//
+// Parameters:
+//   - fn: the conversion function.
+//
+// Example:
+//
+//	type Celsius float64
+//
+//	Register(func(c Celsius) (string, error) {
+//	  return Float64IntoString(float64(c)) + "°C", nil
+//	})
+//	result, _ := TryInto[string](Celsius(21.5))
+//	fmt.Println(result) // Output: 21.5°C
+func Register[U any, T any](fn func(U) (T, error)) {
+	key := [2]reflect.Type{typeOf[U](), typeOf[T]()}
+	converters.Lock()
+	defer converters.Unlock()
+	converters.m[key] = func(value any) (any, error) {
+		return fn(value.(U))
+	}
+}
+
+// convertRegistered converts value to dst with a registered function.
+//
+// convertRegistered reports whether a function is registered for the type
+// of value and dst, or a conversion method is found by convertMethod. If
+// one is, it returns the result of the function or method. A string value is
+// passed through opts.Normalize first.
+func convertRegistered(value any, dst reflect.Type, opts Options) (any, bool, error) {
+	src := reflect.TypeOf(value)
+	if src == nil {
+		return nil, false, nil
+	}
+
+	converters.RLock()
+	fn, ok := converters.m[[2]reflect.Type{src, dst}]
+	converters.RUnlock()
+	if !ok {
+		return convertMethod(value, dst)
+	}
+	if s, ok := value.(string); ok {
+		if opts.Normalize != nil {
+			value = opts.Normalize(s)
+		}
+	}
+	result, err := fn(value)
+	return result, true, err
+}
+
+// typeOf returns the reflect.Type of T, including interface types.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
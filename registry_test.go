@@ -0,0 +1,102 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+type userID int64
+
+func TestRegister(t *testing.T) {
+	errReserved := errors.New("reserved user ID")
+	Register(func(id userID) (string, error) {
+		if id == 0 {
+			return "", errReserved
+		}
+		return "user-" + strconv.FormatInt(int64(id), 10), nil
+	})
+
+	tests := []struct {
+		name    string
+		convert func() (string, error)
+		want    string
+		wantErr error
+	}{
+		{"TryInto", func() (string, error) { return TryInto[string](userID(42)) }, "user-42", nil},
+		{"TryIntoWith", func() (string, error) { return TryIntoWith[string](userID(3), Options{}) }, "user-3", nil},
+		{"TryIntoAny", func() (string, error) { return TryIntoAny[string](userID(7)) }, "user-7", nil},
+		{"TryIntoValue", func() (string, error) {
+			var s string
+			err := TryIntoValue(reflect.ValueOf(&s).Elem(), reflect.ValueOf(userID(9)))
+			return s, err
+		}, "user-9", nil},
+		{"error", func() (string, error) { return TryInto[string](userID(0)) }, "", errReserved},
+		{"unregisteredPair", func() (string, error) {
+			i, err := TryIntoAny[int8](userID(12))
+			return strconv.FormatInt(int64(i), 10), err
+		}, "12", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// meters implements ITryInto[feet] and IInto[string].
+type meters float64
+
+type feet float64
+
+func (m meters) TryInto() (feet, error) {
+	if m < 0 {
+		return 0, ErrUnderflow
+	}
+	return feet(m / 0.3048), nil
+}
+
+func (m meters) Into() string {
+	return "meters"
+}
+
+// hexCode implements IFrom[int64].
+type hexCode string
+
+func (h *hexCode) From(value int64) {
+	*h = hexCode(strconv.FormatInt(value, 16))
+}
+
+func TestConversionMethods(t *testing.T) {
+	if got, err := TryInto[feet](meters(3.048)); err != nil || math.Abs(float64(got)-10) > 1e-9 {
+		t.Errorf("TryInto[feet](meters) = %v, %v, want 10", got, err)
+	}
+	if _, err := TryIntoAny[feet](meters(-1)); !errors.Is(err, ErrUnderflow) {
+		t.Errorf("TryIntoAny[feet](meters(-1)) error = %v, want ErrUnderflow", err)
+	}
+	if got, err := TryInto[string](meters(1)); err != nil || got != "meters" {
+		t.Errorf("TryInto[string](meters) = %q, %v, want \"meters\"", got, err)
+	}
+	if got, err := TryInto[float64](meters(2)); err != nil || got != 2 {
+		t.Errorf("TryInto[float64](meters) = %v, %v, want the built-in conversion", got, err)
+	}
+	var code hexCode
+	if err := TryIntoValue(reflect.ValueOf(&code).Elem(), reflect.ValueOf(int64(255))); err != nil || code != "ff" {
+		t.Errorf("TryIntoValue(hexCode, 255) = %q, %v, want \"ff\"", code, err)
+	}
+	if got, err := TryInto[hexCode](int32(255)); err != nil || got != "255" {
+		t.Errorf("TryInto[hexCode](int32) = %q, %v, want the built-in conversion", got, err)
+	}
+}
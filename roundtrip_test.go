@@ -0,0 +1,186 @@
+package into_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+// number is the set of numeric types covered by the round-trip matrix.
+type number interface {
+	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64
+}
+
+// roundTripSamples holds the candidate sample values of the matrix: the
+// bounds of every numeric type, their neighbors and the float precision
+// limits. Each row keeps the samples its source type represents exactly.
+var roundTripSamples = func() []*big.Float {
+	var samples []*big.Float
+	add := func(s string) {
+		f, _, err := big.ParseFloat(s, 10, 1024, big.ToNearestEven)
+		if err != nil {
+			panic(err)
+		}
+		samples = append(samples, f)
+		for _, d := range []int64{-1, 1} {
+			samples = append(samples, new(big.Float).SetPrec(1024).Add(f, big.NewFloat(float64(d))))
+		}
+	}
+	for _, s := range []string{
+		"0", "128", "-128", "256", "32768", "-32768", "65536",
+		"2147483648", "-2147483648", "4294967296",
+		"9223372036854775808", "-9223372036854775808", "18446744073709551616",
+		"16777216", "9007199254740992", "-16777216", "-9007199254740992",
+	} {
+		add(s)
+	}
+	for _, f := range []float64{math.MaxFloat32, -math.MaxFloat32, math.MaxFloat64, -math.MaxFloat64, 0.5, -0.5, 1e300} {
+		samples = append(samples, new(big.Float).SetPrec(1024).SetFloat64(f))
+	}
+	return samples
+}()
+
+// exactSample converts f to T, reporting whether T represents f exactly.
+func exactSample[T number](f *big.Float) (T, bool) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		x, acc := f.Float64()
+		if acc != big.Exact || rv.OverflowFloat(x) || rv.Kind() == reflect.Float32 && float64(float32(x)) != x {
+			return v, false
+		}
+		rv.SetFloat(x)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, acc := f.Int64()
+		if acc != big.Exact || !f.IsInt() || rv.OverflowInt(x) {
+			return v, false
+		}
+		rv.SetInt(x)
+	default:
+		x, acc := f.Uint64()
+		if acc != big.Exact || !f.IsInt() || rv.OverflowUint(x) {
+			return v, false
+		}
+		rv.SetUint(x)
+	}
+	return v, true
+}
+
+// inRange reports whether f lies within the range of T once truncated
+// toward zero, which is how the default conversions treat fractions.
+func inRange[T number](f *big.Float) bool {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	switch rv.Kind() {
+	case reflect.Float32:
+		return new(big.Float).Abs(f).Cmp(big.NewFloat(math.MaxFloat32)) <= 0
+	case reflect.Float64:
+		return new(big.Float).Abs(f).Cmp(big.NewFloat(math.MaxFloat64)) <= 0
+	}
+	i, _ := f.Int(nil)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return i.IsInt64() && !rv.OverflowInt(i.Int64())
+	default:
+		return i.IsUint64() && !rv.OverflowUint(i.Uint64())
+	}
+}
+
+// assertRoundTrip asserts for every sample of X that X→Y fails exactly when
+// the sample is outside the range of Y, and that X→Y→X is the identity when
+// Y represents the sample exactly.
+func assertRoundTrip[X number, Y number](t *testing.T) {
+	var x X
+	var y Y
+	name := fmt.Sprintf("%T→%T", x, y)
+	t.Run(name, func(t *testing.T) {
+		for _, f := range roundTripSamples {
+			x, ok := exactSample[X](f)
+			if !ok {
+				continue
+			}
+			got, err := TryInto[Y](x)
+			if want := inRange[Y](f); (err == nil) != want {
+				t.Errorf("TryInto[%T](%v) = %v, %v, want in range %v", y, x, got, err, want)
+				continue
+			}
+			if err != nil || !f.IsInt() {
+				continue
+			}
+			if _, exact := exactSample[Y](f); !exact {
+				continue
+			}
+			back, err := TryInto[X](got)
+			if err != nil || back != x {
+				t.Errorf("TryInto[%T](TryInto[%T](%v)) = %v, %v, want %v", x, y, x, back, err, x)
+			}
+		}
+	})
+}
+
+// assertRow runs the round trip from X to every numeric type, to string and
+// back, and from bool to X and back.
+func assertRow[X number](t *testing.T) {
+	assertRoundTrip[X, int](t)
+	assertRoundTrip[X, int8](t)
+	assertRoundTrip[X, int16](t)
+	assertRoundTrip[X, int32](t)
+	assertRoundTrip[X, int64](t)
+	assertRoundTrip[X, uint](t)
+	assertRoundTrip[X, uint8](t)
+	assertRoundTrip[X, uint16](t)
+	assertRoundTrip[X, uint32](t)
+	assertRoundTrip[X, uint64](t)
+	assertRoundTrip[X, float32](t)
+	assertRoundTrip[X, float64](t)
+
+	var zero X
+	t.Run(fmt.Sprintf("%T→string", zero), func(t *testing.T) {
+		for _, f := range roundTripSamples {
+			x, ok := exactSample[X](f)
+			if !ok {
+				continue
+			}
+			s, err := TryInto[string](x)
+			if err != nil {
+				t.Errorf("TryInto[string](%v) error = %v", x, err)
+				continue
+			}
+			if back, err := TryInto[X](s); err != nil || back != x {
+				t.Errorf("TryInto[%T](%q) = %v, %v, want %v", x, s, back, err, x)
+			}
+		}
+	})
+	t.Run(fmt.Sprintf("bool→%T", zero), func(t *testing.T) {
+		for _, b := range []bool{false, true} {
+			x, err := TryInto[X](b)
+			if err != nil {
+				t.Errorf("TryInto[%T](%v) error = %v", zero, b, err)
+				continue
+			}
+			if back, err := TryInto[bool](x); err != nil || back != b {
+				t.Errorf("TryInto[bool](%v) = %v, %v, want %v", x, back, err, b)
+			}
+		}
+	})
+}
+
+func TestRoundTripMatrix(t *testing.T) {
+	assertRow[int](t)
+	assertRow[int8](t)
+	assertRow[int16](t)
+	assertRow[int32](t)
+	assertRow[int64](t)
+	assertRow[uint](t)
+	assertRow[uint8](t)
+	assertRow[uint16](t)
+	assertRow[uint32](t)
+	assertRow[uint64](t)
+	assertRow[float32](t)
+	assertRow[float64](t)
+}
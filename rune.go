@@ -0,0 +1,41 @@
+package into
+
+import (
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// TryIntoRune attempts to convert the given value to a Unicode code point.
+//
+// TryIntoRune converts the given value to an int32 like TryIntoInt32 and
+// checks that the result is a legal Unicode code point: at most U+10FFFF
+// and not a surrogate half (U+D800 to U+DFFF). Strings are parsed as
+// decimal numbers, so "65" yields 'A'.
+//
+// Parameters:
+//   - value: the value to be converted.
+//
+// Returns:
+//   - rune: the converted rune value.
+//   - error: ErrInvalidRune if the value is not a legal code point, or an
+//     error if the conversion fails.
+//
+// Example:
+//
+//	result, err := TryIntoRune(0x1F600)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(string(result)) // Output: 😀
+func TryIntoRune[T convertable](value T) (rune, error) {
+	result, err := toKindWith(reflect.Int32, value, Defaults())
+	if err == nil && !utf8.ValidRune(result.(int32)) {
+		err = &ConversionError{From: fmt.Sprintf("%T", value), To: "rune", Value: value, Err: ErrInvalidRune}
+	}
+	observe[rune](value, err)
+	if err != nil {
+		return 0, err
+	}
+	return result.(int32), nil
+}
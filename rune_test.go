@@ -0,0 +1,55 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestTryIntoRune(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (rune, error)
+		want    rune
+		wantErr error
+	}{
+		{"ascii", func() (rune, error) { return TryIntoRune(65) }, 'A', nil},
+		{"string", func() (rune, error) { return TryIntoRune("65") }, 'A', nil},
+		{"emoji", func() (rune, error) { return TryIntoRune(uint32(0x1F600)) }, '😀', nil},
+		{"max", func() (rune, error) { return TryIntoRune(0x10FFFF) }, 0x10FFFF, nil},
+		{"beforeSurrogate", func() (rune, error) { return TryIntoRune(0xD7FF) }, 0xD7FF, nil},
+		{"afterSurrogate", func() (rune, error) { return TryIntoRune(0xE000) }, 0xE000, nil},
+		{"aboveMax", func() (rune, error) { return TryIntoRune(0x110000) }, 0, ErrInvalidRune},
+		{"highSurrogate", func() (rune, error) { return TryIntoRune(0xD800) }, 0, ErrInvalidRune},
+		{"lowSurrogate", func() (rune, error) { return TryIntoRune(0xDFFF) }, 0, ErrInvalidRune},
+		{"negative", func() (rune, error) { return TryIntoRune(-1) }, 0, ErrInvalidRune},
+		{"int32Overflow", func() (rune, error) { return TryIntoRune(int64(1 << 40)) }, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %U, want %U", got, tt.want)
+			}
+		})
+	}
+
+	var convErr *ConversionError
+	if _, err := TryIntoRune(0xD800); !errors.As(err, &convErr) || convErr.From != "int" || convErr.To != "rune" {
+		t.Errorf("TryIntoRune(0xD800) error = %v, want a ConversionError from int to rune", err)
+	}
+}
+
+func TestTryIntoByte(t *testing.T) {
+	if got, err := TryIntoByte("255"); err != nil || got != 255 {
+		t.Errorf("TryIntoByte(\"255\") = %v, %v, want 255", got, err)
+	}
+	if _, err := TryIntoByte(256); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TryIntoByte(256) error = %v, want ErrOverflow", err)
+	}
+}
@@ -0,0 +1,66 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StringToInt64Scaled converts a decimal string to an int64 number of 10^-scale units.
+//
+// StringToInt64Scaled converts a decimal string such as "12.34" to an int64
+// scaled by 10^scale, e.g. 1234 for a scale of 2, as used for amounts of
+// money in cents. The scaling is done on the digits, so no float rounding is
+// involved. Missing fraction digits count as zeros; extra fraction digits
+// are accepted only if they are zeros.
+//
+// Parameters:
+//   - value: the string value to be converted, with an optional sign.
+//   - scale: the number of fraction digits kept, from 0 to 18.
+//
+// Returns:
+//   - int64: the scaled int64 value.
+//   - error: ErrLossOfPrecision if the value has more nonzero fraction digits
+//     than scale, a *ConversionError wrapping ErrOverflow or ErrUnderflow if
+//     the scaled value is out of the int64 range, or an error if the string
+//     is not a decimal number.
+//
+// Example:
+//
+//	result, err := StringToInt64Scaled("12.34", 2)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1234
+func StringToInt64Scaled(value string, scale int) (int64, error) {
+	if scale < 0 || scale > 18 {
+		return 0, fmt.Errorf("scale %d is outside the range [0, 18]", scale)
+	}
+
+	s, sign := value, ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		s, sign = s[1:], s[:1]
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || whole != "" && !isDigits(whole) || frac != "" && !isDigits(frac) {
+		return 0, fmt.Errorf("invalid decimal %q", value)
+	}
+
+	if len(frac) > scale {
+		if strings.Trim(frac[scale:], "0") != "" {
+			return 0, ErrLossOfPrecision
+		}
+		frac = frac[:scale]
+	}
+	digits := whole + frac + strings.Repeat("0", scale-len(frac))
+
+	result, err := strconv.ParseInt(sign+digits, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		if sign == "-" {
+			return 0, underflowError(value, "int64")
+		}
+		return 0, overflowError(value, "int64")
+	}
+	return result, err
+}
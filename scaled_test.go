@@ -0,0 +1,95 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToInt64Scaled(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		scale   int
+		want    int64
+		wantErr error
+	}{
+		{"cents", "12.34", 2, 1234, nil},
+		{"shortFraction", "12.3", 2, 1230, nil},
+		{"noFraction", "12", 2, 1200, nil},
+		{"trailingZeros", "12.3400", 2, 1234, nil},
+		{"leadingDot", ".5", 2, 50, nil},
+		{"trailingDot", "5.", 2, 500, nil},
+		{"negative", "-0.05", 2, -5, nil},
+		{"plus", "+1.5", 1, 15, nil},
+		{"scaleZero", "42", 0, 42, nil},
+		{"notFloatExact", "0.29", 2, 29, nil},
+		{"max", "92233720368547758.07", 2, math.MaxInt64, nil},
+		{"min", "-92233720368547758.08", 2, math.MinInt64, nil},
+		{"lossOfPrecision", "12.345", 2, 0, ErrLossOfPrecision},
+		{"overflow", "92233720368547758.08", 2, 0, ErrOverflow},
+		{"underflow", "-92233720368547758.09", 2, 0, ErrUnderflow},
+		{"empty", "", 2, 0, errInvalid},
+		{"dot", ".", 2, 0, errInvalid},
+		{"exponent", "1e2", 2, 0, errInvalid},
+		{"twoDots", "1.2.3", 2, 0, errInvalid},
+		{"badScale", "1", 19, 0, errInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToInt64Scaled(tt.input, tt.scale)
+			if tt.wantErr == errInvalid {
+				if err == nil {
+					t.Errorf("StringToInt64Scaled(%q) error = nil", tt.input)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StringToInt64Scaled(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToInt64Scaled(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt64ScaledToString(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int64
+		scale int
+		want  string
+	}{
+		{"cents", 1234, 2, "12.34"},
+		{"small", 5, 2, "0.05"},
+		{"negativeSmall", -5, 2, "-0.05"},
+		{"zero", 0, 2, "0.00"},
+		{"scaleZero", 42, 0, "42"},
+		{"min", math.MinInt64, 2, "-92233720368547758.08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Int64ScaledToString(tt.input, tt.scale)
+			if err != nil || got != tt.want {
+				t.Errorf("Int64ScaledToString(%v, %d) = %q, %v, want %q", tt.input, tt.scale, got, err, tt.want)
+			}
+			back, err := StringToInt64Scaled(got, tt.scale)
+			if err != nil || back != tt.input {
+				t.Errorf("StringToInt64Scaled(%q, %d) = %v, %v, want %v", got, tt.scale, back, err, tt.input)
+			}
+		})
+	}
+
+	if _, err := Int64ScaledToString(1, -1); err == nil {
+		t.Error("Int64ScaledToString(1, -1) error = nil")
+	}
+}
+
+// errInvalid marks test cases that expect an error without a sentinel.
+var errInvalid = errors.New("invalid")
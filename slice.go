@@ -0,0 +1,307 @@
+package into
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// Float64SliceToFloat32 converts a slice of float64 values to float32.
+//
+// Float64SliceToFloat32 converts every element like Float64ToFloat32. It is
+// meant for large slices: the range is checked once with a pre-scan for the
+// minimum and maximum, and the elements are then converted in a loop without
+// branches, which the compiler can keep tight. This is several times faster
+// than converting the elements one by one. NaN elements are passed through.
+//
+// Parameters:
+//   - value: the float64 values to be converted.
+//
+// Returns:
+//   - []float32: a new slice with the converted values, or nil on error.
+//   - error: an error naming the index of the first element out of the
+//     float32 range, wrapping its *ConversionError.
+//
+// Example:
+//
+//	result, err := Float64SliceToFloat32([]float64{1.5, -2})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [1.5 -2]
+func Float64SliceToFloat32(value []float64) ([]float32, error) {
+	lo, hi := 0.0, 0.0
+	for _, v := range value {
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	if lo < -math.MaxFloat32 || hi > math.MaxFloat32 {
+		return nil, firstSliceError(value, Float64ToFloat32)
+	}
+
+	result := make([]float32, len(value))
+	for i, v := range value {
+		result[i] = float32(v)
+	}
+	return result, nil
+}
+
+// Int64SliceToInt32 converts a slice of int64 values to int32.
+//
+// Int64SliceToInt32 converts every element like Int64ToInt32, checking the
+// range once with a pre-scan for the minimum and maximum like
+// Float64SliceToFloat32.
+//
+// Parameters:
+//   - value: the int64 values to be converted.
+//
+// Returns:
+//   - []int32: a new slice with the converted values, or nil on error.
+//   - error: an error naming the index of the first element out of the
+//     int32 range, wrapping its *ConversionError.
+//
+// Example:
+//
+//	_, err := Int64SliceToInt32([]int64{1, 1 << 40})
+//	fmt.Println(err) // Output: index 1: cannot convert int64 1099511627776 to int32: value exceeds the maximum of the target type [-2147483648, 2147483647]
+func Int64SliceToInt32(value []int64) ([]int32, error) {
+	var lo, hi int64
+	for _, v := range value {
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	if lo < math.MinInt32 || hi > math.MaxInt32 {
+		return nil, firstSliceError(value, Int64ToInt32)
+	}
+
+	result := make([]int32, len(value))
+	for i, v := range value {
+		result[i] = int32(v)
+	}
+	return result, nil
+}
+
+// firstSliceError returns the error of the first element of value that conv
+// rejects, prefixed with its index.
+func firstSliceError[U any, T any](value []U, conv func(U) (T, error)) error {
+	for i, v := range value {
+		if _, err := conv(v); err != nil {
+			return fmt.Errorf("index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ctxCheckInterval is the number of elements converted between checks of
+// the context by the Ctx converters.
+const ctxCheckInterval = 1024
+
+// TryIntoSlice attempts to convert a slice of U values to a slice of T values.
+//
+// TryIntoSlice converts every element like TryInto and stops at the first
+// failure.
+//
+// Parameters:
+//   - value: the values to be converted.
+//
+// Returns:
+//   - []T: a new slice with the converted values, or nil on error.
+//   - error: an error naming the index of the first element that failed,
+//     wrapping its error.
+//
+// Example:
+//
+//	result, err := TryIntoSlice[int]([]string{"1", "2"})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [1 2]
+func TryIntoSlice[T convertable, U convertable](value []U) ([]T, error) {
+	return TryIntoSliceCtx[T](context.Background(), value)
+}
+
+// TryIntoSliceCtx attempts to convert a slice of U values to a slice of T
+// values, stopping when ctx is done.
+//
+// TryIntoSliceCtx converts like TryIntoSlice and checks ctx every 1024
+// elements, so that the conversion of a huge slice can be cancelled.
+//
+// Parameters:
+//   - ctx: the context of the conversion.
+//   - value: the values to be converted.
+//
+// Returns:
+//   - []T: a new slice with the converted values, or nil on error.
+//   - error: ctx.Err() if ctx is done, or an error naming the index of the
+//     first element that failed, wrapping its error.
+func TryIntoSliceCtx[T convertable, U convertable](ctx context.Context, value []U) ([]T, error) {
+	result := make([]T, len(value))
+	for i, v := range value {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		r, err := TryInto[T](v)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		result[i] = r
+	}
+	return result, nil
+}
+
+// TryIntoSliceInto converts a slice of U values into a destination slice.
+//
+// TryIntoSliceInto converts every element of src like TryIntoSlice and
+// stores the results in dst[:0], growing it only if its capacity is less
+// than len(src). Passing the result back as dst for the next batch lets
+// bulk conversions such as []string to []float64 run without allocating.
+//
+// Parameters:
+//   - dst: the slice whose capacity is reused; its contents are overwritten.
+//   - src: the values to be converted.
+//
+// Returns:
+//   - []T: dst[:len(src)] with the converted values, or a reallocated slice
+//     if dst is too small, or dst[:0] on error.
+//   - error: an error naming the index of the first element that failed,
+//     wrapping its error.
+//
+// Example:
+//
+//	buf := make([]float64, 0, 1024)
+//	buf, err := TryIntoSliceInto(buf, []string{"1.5", "2"})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(buf) // Output: [1.5 2]
+func TryIntoSliceInto[T convertable, U convertable](dst []T, src []U) ([]T, error) {
+	if cap(dst) < len(src) {
+		dst = make([]T, len(src))
+	}
+	dst = dst[:len(src)]
+	for i, v := range src {
+		r, err := TryInto[T](v)
+		if err != nil {
+			return dst[:0], fmt.Errorf("index %d: %w", i, err)
+		}
+		dst[i] = r
+	}
+	return dst, nil
+}
+
+// TryIntoMap attempts to convert the values of a map from U to T.
+//
+// TryIntoMap converts every value like TryInto and keeps the keys. It stops
+// at the first failure; as map iteration is random, which failure is
+// reported is not specified when several values fail.
+//
+// Parameters:
+//   - value: the map to be converted.
+//
+// Returns:
+//   - map[K]T: a new map with the converted values, or nil on error.
+//   - error: an error naming the key of the value that failed, wrapping its
+//     error.
+//
+// Example:
+//
+//	result, err := TryIntoMap[int](map[string]string{"a": "1"})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: map[a:1]
+func TryIntoMap[T convertable, K comparable, U convertable](value map[K]U) (map[K]T, error) {
+	return TryIntoMapCtx[T](context.Background(), value)
+}
+
+// TryIntoMapCtx attempts to convert the values of a map from U to T,
+// stopping when ctx is done.
+//
+// TryIntoMapCtx converts like TryIntoMap and checks ctx every 1024 values.
+//
+// Parameters:
+//   - ctx: the context of the conversion.
+//   - value: the map to be converted.
+//
+// Returns:
+//   - map[K]T: a new map with the converted values, or nil on error.
+//   - error: ctx.Err() if ctx is done, or an error naming the key of the
+//     value that failed, wrapping its error.
+func TryIntoMapCtx[T convertable, K comparable, U convertable](ctx context.Context, value map[K]U) (map[K]T, error) {
+	result := make(map[K]T, len(value))
+	i := 0
+	for k, v := range value {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		i++
+		r, err := TryInto[T](v)
+		if err != nil {
+			return nil, fmt.Errorf("key %v: %w", k, err)
+		}
+		result[k] = r
+	}
+	return result, nil
+}
+
+// TryIntoChanCtx converts the values received from in and sends them to out.
+//
+// TryIntoChanCtx converts every value received from in like TryInto and
+// sends the result to out, until in is closed, a conversion fails or ctx is
+// done. It does not close out. It is meant for streaming conversions, such
+// as the rows of a large file, that should not be held in memory at once.
+//
+// Parameters:
+//   - ctx: the context of the conversion.
+//   - in: the channel of values to be converted.
+//   - out: the channel the converted values are sent to.
+//
+// Returns:
+//   - error: nil once in is closed, ctx.Err() if ctx is done, or an error
+//     naming the position of the value that failed, wrapping its error.
+//
+// Example:
+//
+//	out := make(chan float64)
+//	go func() {
+//	  defer close(out)
+//	  if err := TryIntoChanCtx(ctx, lines, out); err != nil {
+//	    log.Print(err)
+//	  }
+//	}()
+func TryIntoChanCtx[T convertable, U convertable](ctx context.Context, in <-chan U, out chan<- T) error {
+	for i := 0; ; i++ {
+		var v U
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case u, ok := <-in:
+			if !ok {
+				return nil
+			}
+			v = u
+		}
+
+		r, err := TryInto[T](v)
+		if err != nil {
+			return fmt.Errorf("index %d: %w", i, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case out <- r:
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package into_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFloat64SliceToFloat32(t *testing.T) {
+	got, err := Float64SliceToFloat32([]float64{1.5, -2, 0, math.MaxFloat32, -math.MaxFloat32, math.Inf(1) - math.Inf(1)})
+	if err != nil || len(got) != 6 || got[0] != 1.5 || got[1] != -2 || got[3] != math.MaxFloat32 || !math.IsNaN(float64(got[5])) {
+		t.Errorf("Float64SliceToFloat32() = %v, %v", got, err)
+	}
+	if got, err := Float64SliceToFloat32(nil); err != nil || len(got) != 0 {
+		t.Errorf("Float64SliceToFloat32(nil) = %v, %v", got, err)
+	}
+
+	_, err = Float64SliceToFloat32([]float64{1, 2, math.Inf(1)})
+	if !errors.Is(err, ErrOverflow) || err.Error()[:8] != "index 2:" {
+		t.Errorf("Float64SliceToFloat32() error = %v, want overflow at index 2", err)
+	}
+	if _, err := Float64SliceToFloat32([]float64{-1e39}); !errors.Is(err, ErrUnderflow) {
+		t.Errorf("Float64SliceToFloat32() error = %v, want %v", err, ErrUnderflow)
+	}
+}
+
+func TestInt64SliceToInt32(t *testing.T) {
+	got, err := Int64SliceToInt32([]int64{1, -1, math.MaxInt32, math.MinInt32})
+	if err != nil || len(got) != 4 || got[2] != math.MaxInt32 || got[3] != math.MinInt32 {
+		t.Errorf("Int64SliceToInt32() = %v, %v", got, err)
+	}
+
+	_, err = Int64SliceToInt32([]int64{1, math.MinInt32 - 1, math.MaxInt32 + 1})
+	if !errors.Is(err, ErrUnderflow) || err.Error()[:8] != "index 1:" {
+		t.Errorf("Int64SliceToInt32() error = %v, want underflow at index 1", err)
+	}
+}
+
+func BenchmarkInt64SliceToInt32(b *testing.B) {
+	values := make([]int64, 4096)
+	for i := range values {
+		values[i] = int64(i - 2048)
+	}
+	b.Run("slice", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = Int64SliceToInt32(values)
+		}
+	})
+	b.Run("elements", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := make([]int32, len(values))
+			for j, v := range values {
+				result[j], _ = TryInto[int32](v)
+			}
+		}
+	})
+}
+
+func TestTryIntoSlice(t *testing.T) {
+	got, err := TryIntoSlice[int8]([]string{"1", "-2"})
+	if err != nil || !reflect.DeepEqual(got, []int8{1, -2}) {
+		t.Errorf("TryIntoSlice() = %v, %v", got, err)
+	}
+
+	_, err = TryIntoSlice[int8]([]int{1, 2, 300})
+	if !errors.Is(err, ErrOverflow) || err.Error()[:8] != "index 2:" {
+		t.Errorf("TryIntoSlice() error = %v, want overflow at index 2", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := TryIntoSliceCtx[int](ctx, []string{"1"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("TryIntoSliceCtx() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestTryIntoSliceInto(t *testing.T) {
+	buf := make([]float64, 0, 4)
+	got, err := TryIntoSliceInto(buf, []string{"1.5", "2"})
+	if err != nil || !reflect.DeepEqual(got, []float64{1.5, 2}) {
+		t.Errorf("TryIntoSliceInto() = %v, %v", got, err)
+	}
+	if &got[0] != &buf[:1][0] {
+		t.Error("TryIntoSliceInto() did not reuse the capacity of dst")
+	}
+
+	got, err = TryIntoSliceInto(got, []string{"3"})
+	if err != nil || !reflect.DeepEqual(got, []float64{3}) {
+		t.Errorf("TryIntoSliceInto() second batch = %v, %v", got, err)
+	}
+
+	got, err = TryIntoSliceInto(got, []string{"1", "2", "3", "4", "5"})
+	if err != nil || len(got) != 5 || got[4] != 5 {
+		t.Errorf("TryIntoSliceInto() growing = %v, %v", got, err)
+	}
+
+	got, err = TryIntoSliceInto(got, []string{"1", "x"})
+	if !errors.Is(err, strconv.ErrSyntax) || err.Error()[:8] != "index 1:" {
+		t.Errorf("TryIntoSliceInto() error = %v, want a syntax error at index 1", err)
+	}
+	if len(got) != 0 || cap(got) < 5 {
+		t.Errorf("TryIntoSliceInto() on error = len %d, cap %d, want len 0 and the capacity kept", len(got), cap(got))
+	}
+}
+
+func TestTryIntoMap(t *testing.T) {
+	got, err := TryIntoMap[float64](map[string]string{"a": "1.5", "b": "2"})
+	if err != nil || !reflect.DeepEqual(got, map[string]float64{"a": 1.5, "b": 2}) {
+		t.Errorf("TryIntoMap() = %v, %v", got, err)
+	}
+
+	_, err = TryIntoMap[uint](map[string]int{"neg": -1})
+	if !errors.Is(err, ErrUnderflow) || err.Error()[:8] != "key neg:" {
+		t.Errorf("TryIntoMap() error = %v, want underflow at key neg", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := TryIntoMapCtx[int](ctx, map[int]string{1: "1"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("TryIntoMapCtx() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestTryIntoChanCtx(t *testing.T) {
+	in := make(chan string, 3)
+	out := make(chan int, 3)
+	for i := 1; i <= 3; i++ {
+		in <- strconv.Itoa(i)
+	}
+	close(in)
+	if err := TryIntoChanCtx(context.Background(), in, out); err != nil {
+		t.Fatalf("TryIntoChanCtx() error = %v", err)
+	}
+	close(out)
+	var got []int
+	for v := range out {
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("TryIntoChanCtx() sent %v", got)
+	}
+
+	in = make(chan string, 2)
+	in <- "1"
+	in <- "x"
+	close(in)
+	if err := TryIntoChanCtx(context.Background(), in, make(chan int, 2)); err == nil || err.Error()[:8] != "index 1:" {
+		t.Errorf("TryIntoChanCtx() error = %v, want an error at index 1", err)
+	}
+
+	// A blocked receiver does not keep a cancelled conversion running.
+	ctx, cancel := context.WithCancel(context.Background())
+	in = make(chan string, 1)
+	in <- "1"
+	done := make(chan error)
+	go func() { done <- TryIntoChanCtx(ctx, in, make(chan int)) }()
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("TryIntoChanCtx() error = %v, want %v", err, context.Canceled)
+	}
+}
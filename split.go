@@ -0,0 +1,149 @@
+package into
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// StringToSlice converts a delimited string to a slice of T values.
+//
+// StringToSlice splits value at every sep, trims the white space around
+// each element and converts it like TryInto, for lists read from
+// environment variables and command-line flags such as "1, 2, 3". An empty
+// string yields an empty slice.
+//
+// Parameters:
+//   - value: the delimited string to be converted.
+//   - sep: the separator between elements; it must not be empty.
+//
+// Returns:
+//   - []T: the converted elements, or nil on error.
+//   - error: an *IndexError naming the position of the first element that
+//     failed, wrapping its error, or an error if sep is empty.
+//
+// Example:
+//
+//	result, err := StringToSlice[uint16]("80, 443", ",")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [80 443]
+func StringToSlice[T convertable](value string, sep string) ([]T, error) {
+	if sep == "" {
+		return nil, errors.New("empty separator")
+	}
+	if value == "" {
+		return []T{}, nil
+	}
+
+	parts := strings.Split(value, sep)
+	result := make([]T, len(parts))
+	for i, part := range parts {
+		r, err := TryInto[T](strings.TrimSpace(part))
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		result[i] = r
+	}
+	return result, nil
+}
+
+// StringToInt64Slice converts a delimited string to a slice of int64 values.
+//
+// StringToInt64Slice converts like StringToSlice.
+//
+// Parameters:
+//   - value: the delimited string to be converted.
+//   - sep: the separator between elements; it must not be empty.
+//
+// Returns:
+//   - []int64: the converted elements, or nil on error.
+//   - error: an *IndexError naming the position of the first element that
+//     failed, wrapping its error, or an error if sep is empty.
+//
+// Example:
+//
+//	result, err := StringToInt64Slice("1,2,3", ",")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [1 2 3]
+func StringToInt64Slice(value string, sep string) ([]int64, error) {
+	return StringToSlice[int64](value, sep)
+}
+
+// StringToFloat64Slice converts a delimited string to a slice of float64
+// values.
+//
+// StringToFloat64Slice converts like StringToSlice.
+//
+// Parameters:
+//   - value: the delimited string to be converted.
+//   - sep: the separator between elements; it must not be empty.
+//
+// Returns:
+//   - []float64: the converted elements, or nil on error.
+//   - error: an *IndexError naming the position of the first element that
+//     failed, wrapping its error, or an error if sep is empty.
+//
+// Example:
+//
+//	result, err := StringToFloat64Slice("0.5;1e3", ";")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [0.5 1000]
+func StringToFloat64Slice(value string, sep string) ([]float64, error) {
+	return StringToSlice[float64](value, sep)
+}
+
+// StringToStringSlice converts a delimited string to a slice of strings.
+//
+// StringToStringSlice splits like StringToSlice, trimming the white space
+// around each element.
+//
+// Parameters:
+//   - value: the delimited string to be converted.
+//   - sep: the separator between elements; it must not be empty.
+//
+// Returns:
+//   - []string: the elements.
+//   - error: an error if sep is empty.
+//
+// Example:
+//
+//	result, err := StringToStringSlice("a, b ,c", ",")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Printf("%q\n", result) // Output: ["a" "b" "c"]
+func StringToStringSlice(value string, sep string) ([]string, error) {
+	return StringToSlice[string](value, sep)
+}
+
+// StringToDurationSlice converts a delimited string to a slice of
+// time.Duration values.
+//
+// StringToDurationSlice converts like StringToSlice, parsing each element
+// like StringToDuration.
+//
+// Parameters:
+//   - value: the delimited string to be converted.
+//   - sep: the separator between elements; it must not be empty.
+//
+// Returns:
+//   - []time.Duration: the converted elements, or nil on error.
+//   - error: an *IndexError naming the position of the first element that
+//     failed, wrapping its error, or an error if sep is empty.
+//
+// Example:
+//
+//	result, err := StringToDurationSlice("1s,250ms", ",")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [1s 250ms]
+func StringToDurationSlice(value string, sep string) ([]time.Duration, error) {
+	return StringToSlice[time.Duration](value, sep)
+}
@@ -0,0 +1,69 @@
+package into_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToInt64Slice(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		sep       string
+		want      []int64
+		wantIndex int
+		wantErr   error
+	}{
+		{"comma", "1,2,3", ",", []int64{1, 2, 3}, 0, nil},
+		{"spaces", " 1 ; -2 ;3 ", ";", []int64{1, -2, 3}, 0, nil},
+		{"multiByteSep", "1::2", "::", []int64{1, 2}, 0, nil},
+		{"single", "42", ",", []int64{42}, 0, nil},
+		{"empty", "", ",", []int64{}, 0, nil},
+		{"syntax", "1,x,3", ",", nil, 1, strconv.ErrSyntax},
+		{"emptyElement", "1,,3", ",", nil, 1, strconv.ErrSyntax},
+		{"overflow", "1,2,9223372036854775808", ",", nil, 2, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToInt64Slice(tt.input, tt.sep)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StringToInt64Slice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				var indexErr *IndexError
+				if !errors.As(err, &indexErr) || indexErr.Index != tt.wantIndex {
+					t.Errorf("StringToInt64Slice() error = %v, want index %d", err, tt.wantIndex)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StringToInt64Slice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringToSlices(t *testing.T) {
+	if got, err := StringToFloat64Slice("0.5; 1e3", ";"); err != nil || !reflect.DeepEqual(got, []float64{0.5, 1000}) {
+		t.Errorf("StringToFloat64Slice() = %v, %v", got, err)
+	}
+	if got, err := StringToStringSlice("a, b ,c", ","); err != nil || !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("StringToStringSlice() = %q, %v", got, err)
+	}
+	if got, err := StringToDurationSlice("1s, 250ms", ","); err != nil || !reflect.DeepEqual(got, []time.Duration{time.Second, 250 * time.Millisecond}) {
+		t.Errorf("StringToDurationSlice() = %v, %v", got, err)
+	}
+	if got, err := StringToSlice[uint8]("1,256", ","); !errors.Is(err, ErrOverflow) || got != nil {
+		t.Errorf("StringToSlice[uint8]() = %v, %v, want %v", got, err, ErrOverflow)
+	}
+	if _, err := StringToStringSlice("a,b", ""); err == nil {
+		t.Error("StringToStringSlice() with an empty separator error = nil, want an error")
+	}
+}
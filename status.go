@@ -0,0 +1,86 @@
+package into
+
+import "strconv"
+
+// IntToHTTPStatus converts an int value to an HTTP status code.
+//
+// IntToHTTPStatus checks that value is a valid HTTP status code, between
+// 100 and 599 as defined by RFC 9110, so a status read from a
+// configuration file or an upstream response can be rejected before it is
+// passed to http.ResponseWriter.WriteHeader, which panics on codes outside
+// this range.
+//
+// Parameters:
+//   - value: the int value to be converted.
+//
+// Returns:
+//   - int: the status code.
+//   - error: a *ConversionError wrapping ErrUnderflow or ErrOverflow if the
+//     value is outside the range 100 to 599.
+//
+// Example:
+//
+//	_, err := IntToHTTPStatus(600)
+//	fmt.Println(errors.Is(err, ErrOverflow)) // Output: true
+func IntToHTTPStatus(value int) (int, error) {
+	return bounded(value, 100, 599, "HTTP status code")
+}
+
+// StringToHTTPStatus converts a string value to an HTTP status code.
+//
+// StringToHTTPStatus parses the string as a base 10 integer, as
+// StringToInt does, and checks it like IntToHTTPStatus.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - int: the status code.
+//   - error: a *ConversionError wrapping strconv.ErrSyntax if the value is
+//     not a valid integer, or ErrUnderflow or ErrOverflow if it is outside
+//     the range 100 to 599.
+//
+// Example:
+//
+//	result, err := StringToHTTPStatus("404")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 404
+func StringToHTTPStatus(value string) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, parseError(err, value, "HTTP status code")
+	}
+	return IntToHTTPStatus(i)
+}
+
+// IntToGRPCCode converts an int value to a gRPC status code.
+//
+// IntToGRPCCode checks that value is one of the status codes defined by
+// gRPC, from 0 (OK) to 16 (Unauthenticated), and returns it as a uint32,
+// the underlying type of codes.Code, so it converts with codes.Code(result)
+// without importing gRPC here.
+//
+// Parameters:
+//   - value: the int value to be converted.
+//
+// Returns:
+//   - uint32: the status code.
+//   - error: a *ConversionError wrapping ErrUnderflow or ErrOverflow if the
+//     value is outside the range 0 to 16.
+//
+// Example:
+//
+//	result, err := IntToGRPCCode(5)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 5
+func IntToGRPCCode(value int) (uint32, error) {
+	code, err := bounded(value, 0, 16, "gRPC status code")
+	if err != nil {
+		return 0, err
+	}
+	return uint32(code), nil
+}
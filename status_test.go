@@ -0,0 +1,85 @@
+package into_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestIntToHTTPStatus(t *testing.T) {
+	tests := []struct {
+		value   int
+		wantErr error
+	}{
+		{200, nil},
+		{100, nil},
+		{599, nil},
+		{99, ErrUnderflow},
+		{600, ErrOverflow},
+		{-1, ErrUnderflow},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.value), func(t *testing.T) {
+			got, err := IntToHTTPStatus(tt.value)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("IntToHTTPStatus(%d) error = %v, want %v", tt.value, err, tt.wantErr)
+			}
+			if err == nil && got != tt.value {
+				t.Errorf("IntToHTTPStatus(%d) = %d", tt.value, got)
+			}
+		})
+	}
+}
+
+func TestStringToHTTPStatus(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr error
+	}{
+		{"404", 404, nil},
+		{"1000", 0, ErrOverflow},
+		{"abc", 0, strconv.ErrSyntax},
+		{"", 0, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := StringToHTTPStatus(tt.value)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("StringToHTTPStatus(%q) error = %v, want %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("StringToHTTPStatus(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntToGRPCCode(t *testing.T) {
+	tests := []struct {
+		value   int
+		wantErr error
+	}{
+		{0, nil},
+		{16, nil},
+		{17, ErrOverflow},
+		{-1, ErrUnderflow},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.value), func(t *testing.T) {
+			got, err := IntToGRPCCode(tt.value)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("IntToGRPCCode(%d) error = %v, want %v", tt.value, err, tt.wantErr)
+			}
+			if err == nil && got != uint32(tt.value) {
+				t.Errorf("IntToGRPCCode(%d) = %d", tt.value, got)
+			}
+		})
+	}
+	_, err := IntToGRPCCode(17)
+	if want := "cannot convert int 17 to gRPC status code: value exceeds the maximum of the target type"; err.Error() != want {
+		t.Errorf("IntToGRPCCode(17) error = %q, want %q", err, want)
+	}
+}
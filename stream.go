@@ -0,0 +1,275 @@
+package into
+
+import (
+	"errors"
+	"io"
+	"reflect"
+)
+
+// ReadToken reads the next token of white-space-separated text.
+//
+// ReadToken skips leading white space in r and reads bytes up to the next
+// white space character, which is consumed, or the end of the input. It
+// reads one byte at a time, so no input beyond the token is consumed; wrap
+// r in a bufio.Reader, which ReadToken uses through io.ByteReader, to avoid
+// a Read call per byte.
+//
+// Parameters:
+//   - r: the reader to read from.
+//
+// Returns:
+//   - string: the token.
+//   - error: io.EOF if there is no token before the end of the input, or the
+//     error of r.
+//
+// Example:
+//
+//	r := bufio.NewReader(strings.NewReader("  12 abc\n"))
+//	first, _ := ReadToken(r)
+//	second, _ := ReadToken(r)
+//	fmt.Println(first, second) // Output: 12 abc
+func ReadToken(r io.Reader) (string, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = &singleByteReader{r: r}
+	}
+
+	var token []byte
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(token) > 0 {
+				return string(token), nil
+			}
+			return "", err
+		}
+		if isSpaceByte(b) {
+			if len(token) > 0 {
+				return string(token), nil
+			}
+			continue
+		}
+		token = append(token, b)
+	}
+}
+
+// Read reads the next token of white-space-separated text as a value of type T.
+//
+// Read reads a token like ReadToken and converts it to T like TryIntoAny,
+// so that files of numbers can be processed one value at a time.
+//
+// Parameters:
+//   - r: the reader to read from.
+//
+// Returns:
+//   - T: the converted value.
+//   - error: io.EOF if there is no token before the end of the input, the
+//     error of r, or an error if the token cannot be converted.
+//
+// Example:
+//
+//	r := bufio.NewReader(strings.NewReader("1.5 2.5"))
+//	var sum float64
+//	for {
+//	  v, err := Read[float64](r)
+//	  if err == io.EOF {
+//	    break
+//	  }
+//	  if err != nil {
+//	    log.Fatal(err)
+//	  }
+//	  sum += v
+//	}
+//	fmt.Println(sum) // Output: 4
+func Read[T convertable](r io.Reader) (T, error) {
+	token, err := ReadToken(r)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return TryIntoAny[T](token)
+}
+
+// ReadInt64 reads the next token of white-space-separated text as an int64.
+//
+// ReadInt64 reads a token like ReadToken and converts it with
+// StringToInt64.
+//
+// Parameters:
+//   - r: the reader to read from.
+//
+// Returns:
+//   - int64: the converted int64 value.
+//   - error: io.EOF if there is no token before the end of the input, the
+//     error of r, or an error if the token is not a valid int64.
+func ReadInt64(r io.Reader) (int64, error) {
+	token, err := ReadToken(r)
+	if err != nil {
+		return 0, err
+	}
+	return StringToInt64(token)
+}
+
+// ReadUint64 reads the next token of white-space-separated text as a uint64.
+//
+// ReadUint64 reads a token like ReadToken and converts it with
+// StringToUint64.
+//
+// Parameters:
+//   - r: the reader to read from.
+//
+// Returns:
+//   - uint64: the converted uint64 value.
+//   - error: io.EOF if there is no token before the end of the input, the
+//     error of r, or an error if the token is not a valid uint64.
+func ReadUint64(r io.Reader) (uint64, error) {
+	token, err := ReadToken(r)
+	if err != nil {
+		return 0, err
+	}
+	return StringToUint64(token)
+}
+
+// ReadFloat64 reads the next token of white-space-separated text as a float64.
+//
+// ReadFloat64 reads a token like ReadToken and converts it with
+// StringToFloat64.
+//
+// Parameters:
+//   - r: the reader to read from.
+//
+// Returns:
+//   - float64: the converted float64 value.
+//   - error: io.EOF if there is no token before the end of the input, the
+//     error of r, or an error if the token is not a valid float64.
+func ReadFloat64(r io.Reader) (float64, error) {
+	token, err := ReadToken(r)
+	if err != nil {
+		return 0, err
+	}
+	return StringToFloat64(token)
+}
+
+// singleByteReader reads one byte at a time from a reader that does not
+// implement io.ByteReader.
+type singleByteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+// ReadByte reads a byte from the underlying reader.
+func (s *singleByteReader) ReadByte() (byte, error) {
+	for {
+		n, err := s.r.Read(s.buf[:])
+		if n == 1 {
+			return s.buf[0], nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// isSpaceByte reports whether b is an ASCII white space character.
+func isSpaceByte(b byte) bool {
+	switch b {
+	case ' ', '\t', '\n', '\v', '\f', '\r':
+		return true
+	}
+	return false
+}
+
+// ValueWriter writes values in their string form, separated by a separator.
+//
+// ValueWriter is the writing counterpart of Read: it converts each value
+// like Join and writes it to an io.Writer, with the separator between the
+// values of a line. Integers and floats are formatted into a reused buffer
+// with the Append functions, so writing numbers does not allocate.
+type ValueWriter struct {
+	w       io.Writer
+	sep     string
+	buf     []byte
+	pending bool
+}
+
+// NewValueWriter returns a ValueWriter that writes to w.
+//
+// Parameters:
+//   - w: the writer to write to.
+//   - sep: the separator written between the values of a line, such as " "
+//     or ",".
+//
+// Returns:
+//   - *ValueWriter: the new ValueWriter.
+//
+// Example:
+//
+//	vw := NewValueWriter(os.Stdout, " ")
+//	_ = vw.Write(1, 2.5, "x")
+//	_ = vw.Newline()
+//	// Output: 1 2.5 x
+func NewValueWriter(w io.Writer, sep string) *ValueWriter {
+	return &ValueWriter{w: w, sep: sep}
+}
+
+// Write writes values, each preceded by the separator unless it is the
+// first value of the line. It returns an *IndexError naming the position
+// in values of the first value that cannot be converted, or the error of
+// the underlying writer. The values before a failed one are written.
+func (vw *ValueWriter) Write(values ...any) error {
+	opts := Defaults()
+	for i, value := range values {
+		vw.buf = vw.buf[:0]
+		if vw.pending {
+			vw.buf = append(vw.buf, vw.sep...)
+		}
+		var err error
+		if vw.buf, err = appendValue(vw.buf, value, opts); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+		if _, err := vw.w.Write(vw.buf); err != nil {
+			return err
+		}
+		vw.pending = true
+	}
+	return nil
+}
+
+// Newline writes a line feed; the next value is written without a
+// separator.
+func (vw *ValueWriter) Newline() error {
+	vw.pending = false
+	_, err := vw.w.Write([]byte{'\n'})
+	return err
+}
+
+// appendValue appends the string form of value to dst like toKindWith,
+// formatting integers and floats without allocating when opts sets no
+// format for them.
+func appendValue(dst []byte, value any, opts Options) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return dst, ErrNil
+	case int:
+		if opts.IntFormat == nil {
+			return AppendIntToBytes(dst, v), nil
+		}
+	case int64:
+		if opts.IntFormat == nil {
+			return AppendInt64ToBytes(dst, v), nil
+		}
+	case uint64:
+		if opts.IntFormat == nil {
+			return AppendUint64ToBytes(dst, v), nil
+		}
+	case float64:
+		if opts.FloatFormat == nil {
+			return AppendFloat64ToBytes(dst, v), nil
+		}
+	}
+	s, err := toKindWith(reflect.String, value, opts)
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, s.(string)...), nil
+}
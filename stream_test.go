@@ -0,0 +1,158 @@
+package into_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestReadToken(t *testing.T) {
+	const input = "  12\t-3\n\n4.5 abc"
+	want := []string{"12", "-3", "4.5", "abc"}
+
+	readers := map[string]func() io.Reader{
+		"bufio":       func() io.Reader { return bufio.NewReader(strings.NewReader(input)) },
+		"one byte":    func() io.Reader { return iotest.OneByteReader(strings.NewReader(input)) },
+		"data on EOF": func() io.Reader { return iotest.DataErrReader(strings.NewReader(input)) },
+	}
+	for name, newReader := range readers {
+		t.Run(name, func(t *testing.T) {
+			r := newReader()
+			for _, w := range want {
+				got, err := ReadToken(r)
+				if err != nil || got != w {
+					t.Fatalf("ReadToken() = %q, %v, want %q", got, err, w)
+				}
+			}
+			if _, err := ReadToken(r); err != io.EOF {
+				t.Fatalf("ReadToken() at end error = %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestReadTokenDoesNotReadAhead(t *testing.T) {
+	r := strings.NewReader("1 2 rest")
+	if _, err := ReadInt64(r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Len() != len("2 rest") {
+		t.Fatalf("%d bytes left, want %d", r.Len(), len("2 rest"))
+	}
+}
+
+func TestReadNumbers(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("-42 18446744073709551615 2.5 300 x"))
+
+	if got, err := ReadInt64(r); err != nil || got != -42 {
+		t.Fatalf("ReadInt64() = %v, %v, want -42", got, err)
+	}
+	if got, err := ReadUint64(r); err != nil || got != 18446744073709551615 {
+		t.Fatalf("ReadUint64() = %v, %v, want max uint64", got, err)
+	}
+	if got, err := ReadFloat64(r); err != nil || got != 2.5 {
+		t.Fatalf("ReadFloat64() = %v, %v, want 2.5", got, err)
+	}
+	if _, err := Read[int8](r); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("Read[int8]() error = %v, want ErrOverflow", err)
+	}
+	if _, err := ReadInt64(r); err == nil || err == io.EOF {
+		t.Fatalf("ReadInt64() error = %v, want a parse error", err)
+	}
+	if _, err := Read[float64](r); err != io.EOF {
+		t.Fatalf("Read[float64]() error = %v, want io.EOF", err)
+	}
+}
+
+func TestReadReaderError(t *testing.T) {
+	errRead := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("12"), iotest.ErrReader(errRead))
+	if _, err := ReadInt64(r); !errors.Is(err, errRead) {
+		t.Fatalf("ReadInt64() error = %v, want %v", err, errRead)
+	}
+}
+
+func TestValueWriter(t *testing.T) {
+	var sb strings.Builder
+	vw := NewValueWriter(&sb, " ")
+	if err := vw.Write(1, int64(-2), uint64(3), 4.5, float32(0.1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := vw.Newline(); err != nil {
+		t.Fatal(err)
+	}
+	if err := vw.Write("x", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := vw.Write([]byte("y")); err != nil {
+		t.Fatal(err)
+	}
+	if err := vw.Newline(); err != nil {
+		t.Fatal(err)
+	}
+
+	const want = "1 -2 3 4.5 0.1\nx true y\n"
+	if got := sb.String(); got != want {
+		t.Fatalf("output = %q, want %q", got, want)
+	}
+}
+
+func TestValueWriterErrors(t *testing.T) {
+	var sb strings.Builder
+	vw := NewValueWriter(&sb, ",")
+	err := vw.Write(1, nil)
+	var indexErr *IndexError
+	if !errors.Is(err, ErrNil) || !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Fatalf("Write() error = %v, want ErrNil at index 1", err)
+	}
+	if got := sb.String(); got != "1" {
+		t.Fatalf("output = %q, want %q", got, "1")
+	}
+
+	errWrite := errors.New("write failed")
+	vw = NewValueWriter(failingWriter{errWrite}, ",")
+	if err := vw.Write(1); !errors.Is(err, errWrite) {
+		t.Fatalf("Write() error = %v, want %v", err, errWrite)
+	}
+}
+
+func TestValueWriterRoundTrip(t *testing.T) {
+	var sb strings.Builder
+	vw := NewValueWriter(&sb, " ")
+	values := []float64{0.1, -1e21, 3}
+	for _, v := range values {
+		if err := vw.Write(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := strings.NewReader(sb.String())
+	for _, want := range values {
+		got, err := ReadFloat64(r)
+		if err != nil || got != want {
+			t.Fatalf("ReadFloat64() = %v, %v, want %v", got, err, want)
+		}
+	}
+}
+
+func TestValueWriterAllocs(t *testing.T) {
+	vw := NewValueWriter(io.Discard, " ")
+	_ = vw.Write(1, 2.5)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = vw.Write(123456789, int64(-1), uint64(7), 0.125)
+	})
+	// The variadic slice of interfaces is the only allocation.
+	if allocs > 1 {
+		t.Fatalf("Write allocated %v times, want at most 1", allocs)
+	}
+}
+
+// failingWriter is an io.Writer that always fails with err.
+type failingWriter struct{ err error }
+
+func (w failingWriter) Write([]byte) (int, error) { return 0, w.err }
@@ -0,0 +1,43 @@
+package into
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// strictValue returns an error if converting value to the basic type of kind
+// is an implicit conversion between bools and numbers that the Strict
+// option rejects.
+func strictValue(kind reflect.Kind, value any, opts Options) error {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		return nil
+	}
+	src := rv.Kind()
+	switch {
+	case src == reflect.Bool && isNumericKind(kind):
+		return coercionError(value, kind.String())
+	case isNumericKind(src) && kind == reflect.Bool:
+		return coercionError(value, kind.String())
+	case src != reflect.String:
+		return nil
+	}
+
+	s := rv.String()
+	if s == "0" || s == "1" {
+		return nil
+	}
+	if kind == reflect.Bool && (opts.Weak || opts.Truthiness == TruthNumeric) {
+		if _, err := strconv.ParseBool(s); err != nil {
+			if _, err := strconv.ParseFloat(s, 64); err == nil {
+				return coercionError(value, kind.String())
+			}
+		}
+	}
+	if isNumericKind(kind) && opts.Weak {
+		if _, err := strconv.ParseBool(s); err == nil {
+			return coercionError(value, kind.String())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStrict(t *testing.T) {
+	strict := Options{Strict: true}
+	weak := Options{Strict: true, Weak: true}
+	numeric := Options{Strict: true, Truthiness: TruthNumeric}
+	tests := []struct {
+		name    string
+		convert func() (any, error)
+		want    any
+		wantErr error
+	}{
+		{"boolToInt", func() (any, error) { return TryIntoWith[int](true, strict) }, 0, ErrImplicitCoercion},
+		{"boolToFloat", func() (any, error) { return TryIntoWith[float64](false, strict) }, 0.0, ErrImplicitCoercion},
+		{"intToBool", func() (any, error) { return TryIntoWith[bool](1, strict) }, false, ErrImplicitCoercion},
+		{"floatToBool", func() (any, error) { return TryIntoWith[bool](0.5, strict) }, false, ErrImplicitCoercion},
+		{"boolToBool", func() (any, error) { return TryIntoWith[bool](true, strict) }, true, nil},
+		{"boolToString", func() (any, error) { return TryIntoWith[string](true, strict) }, "true", nil},
+		{"intToInt", func() (any, error) { return TryIntoWith[int8](42, strict) }, int8(42), nil},
+		{"stringToBool", func() (any, error) { return TryIntoWith[bool]("true", strict) }, true, nil},
+		{"digitToBool", func() (any, error) { return TryIntoWith[bool]("1", strict) }, true, nil},
+		{"numericString", func() (any, error) { return TryIntoWith[bool]("2", numeric) }, false, ErrImplicitCoercion},
+		{"numericName", func() (any, error) { return TryIntoWith[bool]("false", numeric) }, false, nil},
+		{"weakBoolString", func() (any, error) { return TryIntoWith[int]("true", weak) }, 0, ErrImplicitCoercion},
+		{"weakNumberString", func() (any, error) { return TryIntoWith[bool]("-3", weak) }, false, ErrImplicitCoercion},
+		{"weakDigit", func() (any, error) { return TryIntoWith[int]("1", weak) }, 1, nil},
+		{"weakEmpty", func() (any, error) { return TryIntoWith[int]("", weak) }, 0, nil},
+		{"weakHex", func() (any, error) { return TryIntoWith[int]("0x10", weak) }, 16, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("TryIntoWith() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrictNamed(t *testing.T) {
+	type flag bool
+	_, err := TryIntoWith[uint8](flag(true), Options{Strict: true})
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || !errors.Is(err, ErrImplicitCoercion) {
+		t.Fatalf("TryIntoWith() error = %v, want a *ConversionError wrapping ErrImplicitCoercion", err)
+	}
+	if convErr.To != "uint8" {
+		t.Errorf("ConversionError.To = %q, want %q", convErr.To, "uint8")
+	}
+}
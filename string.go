@@ -1,9 +1,14 @@
 package into
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // TryIntoString attempts to convert a value to a string.
@@ -14,8 +19,9 @@ import (
 //   - int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64
 //   - float32, float64
 //   - string
-//   - []byte
+//   - []byte, written with the Bytes option (as is by default)
 //   - []rune
+//   - time.Time, formatted with the TimeLayout option (RFC 3339 by default)
 //
 // Parameters:
 //   - value: The value to be converted. The range of the input value is determined by the type T.
@@ -23,47 +29,64 @@ import (
 // Returns:
 //   - string: The converted string value.
 //   - error: An error if the conversion fails.
-func TryIntoString[T convertable | ~[]byte | ~[]rune](value T) (string, error) {
-	result, err := toString(value)
-	return result.(string), err
+func TryIntoString[T convertable | ~[]byte | ~[]rune | time.Time](value T) (string, error) {
+	result, err := toKindWith(reflect.String, value, Defaults())
+	observe[string](value, err)
+	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
 }
 
 func toString(value any) (any, error) {
 	valueType := reflect.TypeOf(value)
 	switch valueType.Kind() {
 	case reflect.Float64:
-		return Float64ToString(value.(float64))
+		return Float64IntoString(value.(float64)), nil
 	case reflect.Float32:
-		return Float32ToString(value.(float32))
+		return Float32IntoString(value.(float32)), nil
 	case reflect.Int:
-		return IntToString(value.(int))
+		return IntIntoString(value.(int)), nil
 	case reflect.Int8:
-		return Int8ToString(value.(int8))
+		return Int8IntoString(value.(int8)), nil
 	case reflect.Int16:
-		return Int16ToString(value.(int16))
+		return Int16IntoString(value.(int16)), nil
 	case reflect.Int32:
-		return Int32ToString(value.(int32))
+		return Int32IntoString(value.(int32)), nil
 	case reflect.Int64:
-		return Int64ToString(value.(int64))
+		return Int64IntoString(value.(int64)), nil
 	case reflect.Uint:
-		return UintToString(value.(uint))
+		return UintIntoString(value.(uint)), nil
 	case reflect.Uint8:
-		return Uint8ToString(value.(uint8))
+		return Uint8IntoString(value.(uint8)), nil
 	case reflect.Uint16:
-		return Uint16ToString(value.(uint16))
+		return Uint16IntoString(value.(uint16)), nil
 	case reflect.Uint32:
-		return Uint32ToString(value.(uint32))
+		return Uint32IntoString(value.(uint32)), nil
 	case reflect.Uint64:
-		return Uint64ToString(value.(uint64))
+		return Uint64IntoString(value.(uint64)), nil
 	case reflect.String:
 		return value.(string), nil
 	case reflect.Bool:
-		return BoolToString(value.(bool))
+		return BoolIntoString(value.(bool)), nil
 	default:
-		return "", errors.New("unsupported type")
+		return "", unsupportedError(value, "string")
 	}
 }
 
+// BoolIntoString converts a boolean value to a string.
+//
+// BoolIntoString converts a boolean value to a string.
+//
+// Parameters:
+//   - value: The boolean value to be converted. The range of bool is true or false.
+//
+// Returns:
+//   - string: The converted string value.
+func BoolIntoString(value bool) string {
+	return strconv.FormatBool(value)
+}
+
 // BoolToString converts a boolean value to a string.
 //
 // BoolToString converts a boolean value to a string.
@@ -74,8 +97,60 @@ func toString(value any) (any, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use BoolIntoString, which has no error to handle.
 func BoolToString(value bool) (string, error) {
-	return strconv.FormatBool(value), nil
+	return BoolIntoString(value), nil
+}
+
+// BoolIntoStringAs converts a boolean value to one of two given strings.
+//
+// BoolIntoStringAs converts a boolean value to trueStr or falseStr, so
+// callers can emit vocabularies such as "yes"/"no", "1"/"0" or "on"/"off".
+//
+// Parameters:
+//   - value: The boolean value to be converted.
+//   - trueStr: The string returned for true.
+//   - falseStr: The string returned for false.
+//
+// Returns:
+//   - string: The converted string value.
+//
+// Example:
+//
+//	fmt.Println(BoolIntoStringAs(true, "yes", "no")) // Output: yes
+func BoolIntoStringAs(value bool, trueStr, falseStr string) string {
+	if value {
+		return trueStr
+	}
+	return falseStr
+}
+
+// BoolToStringAs converts a boolean value to one of two given strings.
+//
+// BoolToStringAs converts a boolean value to trueStr or falseStr like
+// BoolIntoStringAs.
+//
+// Parameters:
+//   - value: The boolean value to be converted.
+//   - trueStr: The string returned for true.
+//   - falseStr: The string returned for false.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: nil.
+//
+// Deprecated: Use BoolIntoStringAs, which has no error to handle.
+func BoolToStringAs(value bool, trueStr, falseStr string) (string, error) {
+	return BoolIntoStringAs(value, trueStr, falseStr), nil
+}
+
+// BoolFormat holds the strings a boolean value is converted to.
+type BoolFormat struct {
+	// True is the string used for true.
+	True string
+	// False is the string used for false.
+	False string
 }
 
 // BytesToString converts a byte slice to a string.
@@ -92,6 +167,91 @@ func BytesToString(value []byte) (string, error) {
 	return string(value), nil
 }
 
+// BytesToStringEncoding converts a byte slice to a string with the given encoding.
+//
+// BytesToStringEncoding converts a byte slice to a string using enc, so that
+// binary data can be stored in text formats such as JSON or YAML. It is the
+// inverse of StringToBytesEncoding.
+//
+// Parameters:
+//   - value: the byte slice to be converted.
+//   - enc: the encoding, e.g. BytesBase64 or BytesHex.
+//
+// Returns:
+//   - string: the encoded string.
+//   - error: an error if enc is not a known encoding.
+//
+// Example:
+//
+//	result, err := BytesToStringEncoding([]byte("hi"), BytesHex)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 6869
+func BytesToStringEncoding(value []byte, enc BytesEncoding) (string, error) {
+	switch enc {
+	case BytesRaw:
+		return BytesToString(value)
+	case BytesBase64:
+		return BytesToStringStdBase64(value)
+	case BytesURLBase64:
+		return BytesToStringURLBase64(value)
+	case BytesHex:
+		return BytesIntoStringHex(value), nil
+	default:
+		return "", fmt.Errorf("unknown bytes encoding %d", enc)
+	}
+}
+
+// BytesToStringStdBase64 converts a byte slice to a padded standard base64 string.
+//
+// Parameters:
+//   - value: the byte slice to be converted.
+//
+// Returns:
+//   - string: the base64 string, as in RFC 4648 section 4.
+//   - error: nil.
+func BytesToStringStdBase64(value []byte) (string, error) {
+	return base64.StdEncoding.EncodeToString(value), nil
+}
+
+// BytesToStringURLBase64 converts a byte slice to an unpadded URL-safe base64 string.
+//
+// Parameters:
+//   - value: the byte slice to be converted.
+//
+// Returns:
+//   - string: the base64 string, as in RFC 4648 section 5, without padding.
+//   - error: nil.
+func BytesToStringURLBase64(value []byte) (string, error) {
+	return base64.RawURLEncoding.EncodeToString(value), nil
+}
+
+// BytesIntoStringHex converts a byte slice to a lower-case hexadecimal string.
+//
+// Parameters:
+//   - value: the byte slice to be converted.
+//
+// Returns:
+//   - string: the hexadecimal string.
+func BytesIntoStringHex(value []byte) string {
+	return hex.EncodeToString(value)
+}
+
+// BytesToStringHex converts a byte slice to a lower-case hexadecimal string.
+//
+// Parameters:
+//   - value: the byte slice to be converted.
+//
+// Returns:
+//   - string: the hexadecimal string.
+//   - error: nil.
+//
+// Deprecated: Use BytesIntoStringHex, which has no error to handle.
+func BytesToStringHex(value []byte) (string, error) {
+	return BytesIntoStringHex(value), nil
+}
+
 // ErrorToString converts an error to a string.
 //
 // ErrorToString converts an error to a string.
@@ -106,6 +266,19 @@ func ErrorToString(err error) (string, error) {
 	return err.Error(), nil
 }
 
+// Float32IntoString converts a float32 value to a string.
+//
+// Float32IntoString converts a float32 value to a string.
+//
+// Parameters:
+//   - value: The float32 value to be converted. The range of float32 is approximately ±3.4E38.
+//
+// Returns:
+//   - string: The converted string value.
+func Float32IntoString(value float32) string {
+	return strconv.FormatFloat(float64(value), 'f', -1, 32)
+}
+
 // Float32ToString converts a float32 value to a string.
 //
 // Float32ToString converts a float32 value to a string.
@@ -116,8 +289,23 @@ func ErrorToString(err error) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Float32IntoString, which has no error to handle.
 func Float32ToString(value float32) (string, error) {
-	return strconv.FormatFloat(float64(value), 'f', -1, 32), nil
+	return Float32IntoString(value), nil
+}
+
+// Float64IntoString converts a float64 value to a string.
+//
+// Float64IntoString converts a float64 value to a string.
+//
+// Parameters:
+//   - value: The float64 value to be converted. The range of float64 is approximately ±1.7E308.
+//
+// Returns:
+//   - string: The converted string value.
+func Float64IntoString(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
 }
 
 // Float64ToString converts a float64 value to a string.
@@ -130,8 +318,122 @@ func Float32ToString(value float32) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Float64IntoString, which has no error to handle.
 func Float64ToString(value float64) (string, error) {
-	return strconv.FormatFloat(value, 'f', -1, 64), nil
+	return Float64IntoString(value), nil
+}
+
+// FloatFormat describes how a float is formatted as a string.
+//
+// The zero value formats like Float64ToString, with the smallest number of
+// digits that round-trips, so FloatFormat{Verb: 'f'} formats 1.5 as "1.5".
+// Set HasPrecision to fix the number of digits.
+type FloatFormat struct {
+	// Verb is the strconv format verb: 'f', 'e', 'E', 'g' or 'G'. Zero means 'f'.
+	Verb byte
+	// Precision is the number of digits after the decimal point for 'f', 'e'
+	// and 'E', or the number of significant digits for 'g' and 'G', if
+	// HasPrecision is set. Without HasPrecision, or if Precision is -1, the
+	// smallest number of digits that round-trips is used, so that a
+	// Precision of 0 is not mistaken for the absence of a precision.
+	Precision int
+	// HasPrecision reports whether Precision is set.
+	HasPrecision bool
+	// TrimZeros removes trailing zeros, and a trailing decimal point, from
+	// the fraction.
+	TrimZeros bool
+}
+
+// Float64ToStringFormat converts a float64 value to a string using the given format.
+//
+// Float64ToStringFormat converts a float64 value to a string using the verb
+// and precision of format, optionally trimming trailing zeros from the
+// fraction.
+//
+// Parameters:
+//   - value: The float64 value to be converted.
+//   - format: The format to be used.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: An error if the format verb is not supported.
+//
+// Example:
+//
+//	result, err := Float64ToStringFormat(1500, FloatFormat{Verb: 'e', Precision: 3, HasPrecision: true, TrimZeros: true})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1.5e+03
+func Float64ToStringFormat(value float64, format FloatFormat) (string, error) {
+	return formatFloat(value, format, 64)
+}
+
+// Float32ToStringFormat converts a float32 value to a string using the given format.
+//
+// Float32ToStringFormat converts a float32 value to a string like
+// Float64ToStringFormat, using the shortest representation of float32 when
+// the precision is -1.
+//
+// Parameters:
+//   - value: The float32 value to be converted.
+//   - format: The format to be used.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: An error if the format verb is not supported.
+func Float32ToStringFormat(value float32, format FloatFormat) (string, error) {
+	return formatFloat(float64(value), format, 32)
+}
+
+// formatFloat formats f with the given format and bit size.
+func formatFloat(f float64, format FloatFormat, bitSize int) (string, error) {
+	verb := format.Verb
+	switch verb {
+	case 0:
+		verb = 'f'
+	case 'f', 'e', 'E', 'g', 'G':
+	default:
+		return "", fmt.Errorf("unsupported float format verb %q", verb)
+	}
+
+	precision := -1
+	if format.HasPrecision {
+		precision = format.Precision
+	}
+	s := strconv.FormatFloat(f, verb, precision, bitSize)
+	if format.TrimZeros {
+		s = trimFractionZeros(s)
+	}
+	return s, nil
+}
+
+// trimFractionZeros removes trailing zeros and a trailing decimal point from
+// the fraction of a formatted float, keeping any exponent.
+func trimFractionZeros(s string) string {
+	mantissa, exponent := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa, exponent = s[:i], s[i:]
+	}
+	if strings.Contains(mantissa, ".") {
+		mantissa = strings.TrimRight(mantissa, "0")
+		mantissa = strings.TrimSuffix(mantissa, ".")
+	}
+	return mantissa + exponent
+}
+
+// IntIntoString converts an int value to a string.
+//
+// IntIntoString converts an int value to a string.
+//
+// Parameters:
+//   - value: The int value to be converted. The range of int is platform-dependent.
+//
+// Returns:
+//   - string: The converted string value.
+func IntIntoString(value int) string {
+	return strconv.Itoa(value)
 }
 
 // IntToString converts an int value to a string.
@@ -144,8 +446,23 @@ func Float64ToString(value float64) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use IntIntoString, which has no error to handle.
 func IntToString(value int) (string, error) {
-	return strconv.Itoa(value), nil
+	return IntIntoString(value), nil
+}
+
+// Int8IntoString converts an int8 value to a string.
+//
+// Int8IntoString converts an int8 value to a string.
+//
+// Parameters:
+//   - value: The int8 value to be converted. The range of int8 is -128 to 127.
+//
+// Returns:
+//   - string: The converted string value.
+func Int8IntoString(value int8) string {
+	return strconv.Itoa(int(value))
 }
 
 // Int8ToString converts an int8 value to a string.
@@ -158,8 +475,23 @@ func IntToString(value int) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Int8IntoString, which has no error to handle.
 func Int8ToString(value int8) (string, error) {
-	return strconv.Itoa(int(value)), nil
+	return Int8IntoString(value), nil
+}
+
+// Int16IntoString converts an int16 value to a string.
+//
+// Int16IntoString converts an int16 value to a string.
+//
+// Parameters:
+//   - value: The int16 value to be converted. The range of int16 is -32,768 to 32,767.
+//
+// Returns:
+//   - string: The converted string value.
+func Int16IntoString(value int16) string {
+	return strconv.Itoa(int(value))
 }
 
 // Int16ToString converts an int16 value to a string.
@@ -172,8 +504,23 @@ func Int8ToString(value int8) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Int16IntoString, which has no error to handle.
 func Int16ToString(value int16) (string, error) {
-	return strconv.Itoa(int(value)), nil
+	return Int16IntoString(value), nil
+}
+
+// Int32IntoString converts an int32 value to a string.
+//
+// Int32IntoString converts an int32 value to a string.
+//
+// Parameters:
+//   - value: The int32 value to be converted. The range of int32 is -2,147,483,648 to 2,147,483,647.
+//
+// Returns:
+//   - string: The converted string value.
+func Int32IntoString(value int32) string {
+	return strconv.Itoa(int(value))
 }
 
 // Int32ToString converts an int32 value to a string.
@@ -186,8 +533,23 @@ func Int16ToString(value int16) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Int32IntoString, which has no error to handle.
 func Int32ToString(value int32) (string, error) {
-	return strconv.Itoa(int(value)), nil
+	return Int32IntoString(value), nil
+}
+
+// Int64IntoString converts an int64 value to a string.
+//
+// Int64IntoString converts an int64 value to a string.
+//
+// Parameters:
+//   - value: The int64 value to be converted. The range of int64 is -9,223,372,036,854,775,808 to 9,223,372,036,854,775,807.
+//
+// Returns:
+//   - string: The converted string value.
+func Int64IntoString(value int64) string {
+	return strconv.FormatInt(value, 10)
 }
 
 // Int64ToString converts an int64 value to a string.
@@ -200,8 +562,123 @@ func Int32ToString(value int32) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Int64IntoString, which has no error to handle.
 func Int64ToString(value int64) (string, error) {
-	return strconv.FormatInt(value, 10), nil
+	return Int64IntoString(value), nil
+}
+
+// Int64ScaledToString converts an int64 number of 10^-scale units to a decimal string.
+//
+// Int64ScaledToString is the inverse of StringToInt64Scaled: it converts an
+// int64 scaled by 10^scale to a decimal string with exactly scale fraction
+// digits, e.g. "12.34" for 1234 and a scale of 2.
+//
+// Parameters:
+//   - value: the scaled int64 value to be converted.
+//   - scale: the number of fraction digits, from 0 to 18.
+//
+// Returns:
+//   - string: the decimal string.
+//   - error: an error if scale is out of range.
+//
+// Example:
+//
+//	result, err := Int64ScaledToString(-5, 2)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: -0.05
+func Int64ScaledToString(value int64, scale int) (string, error) {
+	if scale < 0 || scale > 18 {
+		return "", fmt.Errorf("scale %d is outside the range [0, 18]", scale)
+	}
+
+	// The magnitude is computed as uint64 so math.MinInt64 does not overflow.
+	sign, u := "", uint64(value)
+	if value < 0 {
+		sign, u = "-", -u
+	}
+	digits := strconv.FormatUint(u, 10)
+	if scale == 0 {
+		return sign + digits, nil
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:], nil
+}
+
+// Int64ToStringBase converts an int64 value to a string in the given base.
+//
+// Int64ToStringBase converts an int64 value to a string in the given base,
+// using lower-case letters for digit values of 10 and above.
+//
+// Parameters:
+//   - value: The int64 value to be converted.
+//   - base: The base, between 2 and 36.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: An error if the base is out of range.
+//
+// Example:
+//
+//	result, err := Int64ToStringBase(-255, 16)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: -ff
+func Int64ToStringBase(value int64, base int) (string, error) {
+	return Int64ToStringFormat(value, IntFormat{Base: base})
+}
+
+// IntFormat describes how an integer is formatted as a string.
+//
+// The zero value formats like Int64ToString.
+type IntFormat struct {
+	// Base is the base, between 2 and 36. Zero means 10.
+	Base int
+	// Prefix adds the "0b", "0o" or "0x" prefix for bases 2, 8 and 16.
+	Prefix bool
+	// Width is the minimum number of digits; shorter values are padded with zeros.
+	Width int
+	// Separator, if not zero, is inserted between groups of digits
+	// (e.g. '_' for 1_000_000 or ',' for 1,000,000).
+	Separator rune
+	// GroupSize is the number of digits per group. Zero means 3 for base 10
+	// and 4 for other bases.
+	GroupSize int
+}
+
+// Int64ToStringFormat converts an int64 value to a string using the given format.
+//
+// Int64ToStringFormat converts an int64 value to a string using the base,
+// prefix, zero padding and digit grouping of format. The sign precedes the
+// prefix, e.g. "-0x1f".
+//
+// Parameters:
+//   - value: The int64 value to be converted.
+//   - format: The format to be used.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: An error if the base is out of range or has no prefix.
+//
+// Example:
+//
+//	result, err := Int64ToStringFormat(1000000, IntFormat{Separator: ','})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1,000,000
+func Int64ToStringFormat(value int64, format IntFormat) (string, error) {
+	if value < 0 {
+		// The magnitude of math.MinInt64 does not fit in int64, but does in uint64.
+		s, err := formatInteger(uint64(-(value+1))+1, format)
+		return "-" + s, err
+	}
+	return formatInteger(uint64(value), format)
 }
 
 // RuneToString converts a rune value to a string.
@@ -232,18 +709,101 @@ func StringToString(value string) (string, error) {
 	return value, nil
 }
 
+// TimeToStringRFC3339 converts a time.Time value to an RFC 3339 string.
+//
+// TimeToStringRFC3339 converts a time.Time value to a string in the RFC 3339
+// format, e.g. "2023-03-15T08:00:00Z". Fractional seconds are included when
+// they are not zero, so the result parses back to the same instant.
+//
+// Parameters:
+//   - value: The time.Time value to be converted.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: An error if the year is outside the range [0, 9999], which
+//     RFC 3339 cannot represent.
+//
+// Example:
+//
+//	result, err := TimeToStringRFC3339(time.Unix(1678867200, 500000000).UTC())
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 2023-03-15T08:00:00.5Z
+func TimeToStringRFC3339(value time.Time) (string, error) {
+	if y := value.Year(); y < 0 || y > 9999 {
+		return "", fmt.Errorf("year %d is outside the range of RFC 3339", y)
+	}
+	return value.Format(time.RFC3339Nano), nil
+}
+
+// TimeToStringLayout converts a time.Time value to a string using a layout.
+//
+// TimeToStringLayout converts a time.Time value to a string with
+// time.Time.Format and the given layout, e.g. time.Kitchen or "2006-01-02".
+//
+// Parameters:
+//   - value: The time.Time value to be converted.
+//   - layout: The layout, as accepted by time.Time.Format.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: An error if the layout is empty.
+//
+// Example:
+//
+//	result, err := TimeToStringLayout(time.Unix(1678867200, 0).UTC(), "2006-01-02")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 2023-03-15
+func TimeToStringLayout(value time.Time, layout string) (string, error) {
+	if layout == "" {
+		return "", errors.New("empty time layout")
+	}
+	return value.Format(layout), nil
+}
+
+// UintIntoString converts a uint value to a string.
+//
+// UintIntoString converts a uint value to a string.
+//
+// Parameters:
+//   - value: The uint value to be converted. The range of uint is 0 to 4.3E9 on 32-bit platforms and 0 to 1.8E19 on 64-bit platforms.
+//
+// Returns:
+//   - string: The converted string value.
+func UintIntoString(value uint) string {
+	return strconv.FormatUint(uint64(value), 10)
+}
+
 // UintToString converts a uint value to a string.
 //
 // UintToString converts a uint value to a string.
 //
 // Parameters:
-//   - value: The uint value to be converted. The range of uint is 0 to 4,294,967,295.
+//   - value: The uint value to be converted. The range of uint is 0 to 4.3E9 on 32-bit platforms and 0 to 1.8E19 on 64-bit platforms.
 //
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use UintIntoString, which has no error to handle.
 func UintToString(value uint) (string, error) {
-	return strconv.FormatUint(uint64(value), 10), nil
+	return UintIntoString(value), nil
+}
+
+// Uint8IntoString converts a uint8 value to a string.
+//
+// Uint8IntoString converts a uint8 value to a string.
+//
+// Parameters:
+//   - value: The uint8 value to be converted. The range of uint8 is 0 to 255.
+//
+// Returns:
+//   - string: The converted string value.
+func Uint8IntoString(value uint8) string {
+	return strconv.FormatUint(uint64(value), 10)
 }
 
 // Uint8ToString converts a uint8 value to a string.
@@ -256,8 +816,23 @@ func UintToString(value uint) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Uint8IntoString, which has no error to handle.
 func Uint8ToString(value uint8) (string, error) {
-	return strconv.FormatUint(uint64(value), 10), nil
+	return Uint8IntoString(value), nil
+}
+
+// Uint16IntoString converts a uint16 value to a string.
+//
+// Uint16IntoString converts a uint16 value to a string.
+//
+// Parameters:
+//   - value: The uint16 value to be converted. The range of uint16 is 0 to 65,535.
+//
+// Returns:
+//   - string: The converted string value.
+func Uint16IntoString(value uint16) string {
+	return strconv.FormatUint(uint64(value), 10)
 }
 
 // Uint16ToString converts a uint16 value to a string.
@@ -270,8 +845,23 @@ func Uint8ToString(value uint8) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Uint16IntoString, which has no error to handle.
 func Uint16ToString(value uint16) (string, error) {
-	return strconv.FormatUint(uint64(value), 10), nil
+	return Uint16IntoString(value), nil
+}
+
+// Uint32IntoString converts a uint32 value to a string.
+//
+// Uint32IntoString converts a uint32 value to a string.
+//
+// Parameters:
+//   - value: The uint32 value to be converted. The range of uint32 is 0 to 4,294,967,295.
+//
+// Returns:
+//   - string: The converted string value.
+func Uint32IntoString(value uint32) string {
+	return strconv.FormatUint(uint64(value), 10)
 }
 
 // Uint32ToString converts a uint32 value to a string.
@@ -284,8 +874,23 @@ func Uint16ToString(value uint16) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Uint32IntoString, which has no error to handle.
 func Uint32ToString(value uint32) (string, error) {
-	return strconv.FormatUint(uint64(value), 10), nil
+	return Uint32IntoString(value), nil
+}
+
+// Uint64IntoString converts a uint64 value to a string.
+//
+// Uint64IntoString converts a uint64 value to a string.
+//
+// Parameters:
+//   - value: The uint64 value to be converted. The range of uint64 is 0 to 18,446,744,073,709,551,615.
+//
+// Returns:
+//   - string: The converted string value.
+func Uint64IntoString(value uint64) string {
+	return strconv.FormatUint(value, 10)
 }
 
 // Uint64ToString converts a uint64 value to a string.
@@ -298,6 +903,106 @@ func Uint32ToString(value uint32) (string, error) {
 // Returns:
 //   - string: The converted string value.
 //   - error: nil.
+//
+// Deprecated: Use Uint64IntoString, which has no error to handle.
 func Uint64ToString(value uint64) (string, error) {
-	return strconv.FormatUint(value, 10), nil
+	return Uint64IntoString(value), nil
+}
+
+// Uint64ToStringBase converts a uint64 value to a string in the given base.
+//
+// Uint64ToStringBase converts a uint64 value to a string in the given base,
+// using lower-case letters for digit values of 10 and above.
+//
+// Parameters:
+//   - value: The uint64 value to be converted.
+//   - base: The base, between 2 and 36.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: An error if the base is out of range.
+//
+// Example:
+//
+//	result, err := Uint64ToStringBase(255, 2)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 11111111
+func Uint64ToStringBase(value uint64, base int) (string, error) {
+	return Uint64ToStringFormat(value, IntFormat{Base: base})
+}
+
+// Uint64ToStringFormat converts a uint64 value to a string using the given format.
+//
+// Uint64ToStringFormat converts a uint64 value to a string using the base,
+// prefix, zero padding and digit grouping of format.
+//
+// Parameters:
+//   - value: The uint64 value to be converted.
+//   - format: The format to be used.
+//
+// Returns:
+//   - string: The converted string value.
+//   - error: An error if the base is out of range or has no prefix.
+//
+// Example:
+//
+//	result, err := Uint64ToStringFormat(0xdeadbeef, IntFormat{Base: 16, Prefix: true, Separator: '_'})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 0xdead_beef
+func Uint64ToStringFormat(value uint64, format IntFormat) (string, error) {
+	return formatInteger(value, format)
+}
+
+// formatInteger formats the magnitude of an integer with the given format.
+func formatInteger(value uint64, format IntFormat) (string, error) {
+	base := format.Base
+	if base == 0 {
+		base = 10
+	}
+	if base < 2 || base > 36 {
+		return "", fmt.Errorf("invalid base %d", base)
+	}
+
+	prefix := ""
+	if format.Prefix {
+		switch base {
+		case 2:
+			prefix = "0b"
+		case 8:
+			prefix = "0o"
+		case 16:
+			prefix = "0x"
+		default:
+			return "", fmt.Errorf("no prefix for base %d", base)
+		}
+	}
+
+	digits := strconv.FormatUint(value, base)
+	if n := format.Width - len(digits); n > 0 {
+		digits = strings.Repeat("0", n) + digits
+	}
+
+	if format.Separator != 0 {
+		size := format.GroupSize
+		if size <= 0 {
+			size = 4
+			if base == 10 {
+				size = 3
+			}
+		}
+		var b strings.Builder
+		for i, d := range digits {
+			if i > 0 && (len(digits)-i)%size == 0 {
+				b.WriteRune(format.Separator)
+			}
+			b.WriteRune(d)
+		}
+		digits = b.String()
+	}
+
+	return prefix + digits, nil
 }
@@ -0,0 +1,224 @@
+package into
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Conversion is a pair of source and target kinds.
+type Conversion struct {
+	// From is the kind of the source type.
+	From reflect.Kind
+	// To is the kind of the target type.
+	To reflect.Kind
+	// FromType and ToType are the source and target types if the pair is
+	// specific to time.Time or time.Duration, and nil for pairs of kinds.
+	FromType, ToType reflect.Type
+}
+
+// SupportedConversions returns the kind pairs handled by the built-in dispatch.
+//
+// SupportedConversions returns every pair of source and target kinds that
+// TryInto, TryIntoAny and TryIntoValue convert without a registered
+// function, followed by the pairs of time.Time and time.Duration, which
+// have their own rules: a time.Time is converted from the integer, float
+// and string kinds and to strings, and a time.Duration converts like an
+// int64 but parses and formats duration strings such as "1h30m". The pairs
+// are sorted by source and then target kind, pairs of kinds first. A supported pair may
+// still fail for particular values, e.g. when a value is out of range.
+// Conversions added with Register are matched by exact type and are not
+// listed; use CanConvert to take them into account.
+//
+// Returns:
+//   - []Conversion: the supported kind pairs.
+//
+// Example:
+//
+//	for _, c := range SupportedConversions() {
+//	  fmt.Println(c.From, "->", c.To)
+//	}
+func SupportedConversions() []Conversion {
+	types := []reflect.Type{timeType, durationType}
+	for _, t := range basicTypes {
+		types = append(types, t)
+	}
+	// special returns t if it is time.Time or time.Duration, and nil
+	// otherwise.
+	special := func(t reflect.Type) reflect.Type {
+		if t == timeType || t == durationType {
+			return t
+		}
+		return nil
+	}
+
+	var conversions []Conversion
+	for _, from := range types {
+		for _, to := range types {
+			if canConvertBuiltin(from, to) {
+				conversions = append(conversions, Conversion{From: from.Kind(), To: to.Kind(), FromType: special(from), ToType: special(to)})
+			}
+		}
+	}
+	sort.Slice(conversions, func(i, j int) bool {
+		a, b := conversions[i], conversions[j]
+		if (a.FromType == nil && a.ToType == nil) != (b.FromType == nil && b.ToType == nil) {
+			return a.FromType == nil && a.ToType == nil
+		}
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		if typeString(a.FromType) != typeString(b.FromType) {
+			return typeString(a.FromType) < typeString(b.FromType)
+		}
+		return typeString(a.ToType) < typeString(b.ToType)
+	})
+	return conversions
+}
+
+// typeString returns the name of t, or "" if t is nil.
+func typeString(t reflect.Type) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
+
+// CanConvert reports whether values of srcType can be converted to dstType.
+//
+// CanConvert reports whether TryIntoValue accepts a source of srcType and a
+// destination of dstType, either through a function added with Register or
+// through the built-in dispatch. Pointer types are dereferenced as
+// TryIntoValue does. A true result does not guarantee that every value
+// converts, e.g. when a value is out of range.
+//
+// Parameters:
+//   - srcType: the type of the source value.
+//   - dstType: the type of the destination value.
+//
+// Returns:
+//   - bool: true if the conversion is supported, false otherwise.
+//
+// Example:
+//
+//	fmt.Println(CanConvert(reflect.TypeOf(""), reflect.TypeOf(0)))          // Output: true
+//	fmt.Println(CanConvert(reflect.TypeOf([]byte{}), reflect.TypeOf(0)))    // Output: false
+func CanConvert(srcType, dstType reflect.Type) bool {
+	if srcType == nil || dstType == nil {
+		return false
+	}
+
+	converters.RLock()
+	_, ok := converters.m[[2]reflect.Type{srcType, dstType}]
+	converters.RUnlock()
+	if ok {
+		return true
+	}
+
+	for srcType.Kind() == reflect.Ptr {
+		srcType = srcType.Elem()
+	}
+	for dstType.Kind() == reflect.Ptr {
+		dstType = dstType.Elem()
+	}
+	return canConvertBuiltin(srcType, dstType)
+}
+
+// canConvertBuiltin reports whether the built-in dispatch converts values
+// of the non-pointer type src to dst.
+func canConvertBuiltin(src, dst reflect.Type) bool {
+	_, srcOK := basicTypes[src.Kind()]
+	_, dstOK := basicTypes[dst.Kind()]
+	switch {
+	case dst == timeType:
+		return src == timeType || srcOK && src.Kind() != reflect.Bool
+	case src == timeType:
+		return dst.Kind() == reflect.String
+	}
+	return srcOK && dstOK
+}
+
+// IsLossless reports whether every value of srcType converts to dstType
+// exactly.
+//
+// IsLossless reports whether converting any value of srcType to dstType with
+// TryInto, TryIntoAny or TryIntoValue under the default options succeeds and
+// keeps the value, as int8 to int64 or uint16 to float64 do, so that a call
+// site can skip the handling of an error that cannot happen. The pair is
+// classified by the kinds of the types, on every platform: int and uint are
+// taken as 64 bits when converted and as 32 bits when converted to. A
+// supported type converted to itself is lossless. Pairs with a function added with
+// Register are not classified, and return false, as are pointer types,
+// which may be nil. The WidensToXxx constraints express the same
+// classification at compile time.
+//
+// IsLossless is narrower than "cannot fail": int64 to float64 rounds and
+// bool to string changes the form of the value, so both are not lossless,
+// although they never return an error. Use IsInfallible for that question.
+//
+// Parameters:
+//   - srcType: the type of the source value.
+//   - dstType: the type of the destination value.
+//
+// Returns:
+//   - bool: true if the conversion cannot fail or lose information.
+//
+// Example:
+//
+//	fmt.Println(IsLossless(reflect.TypeOf(int8(0)), reflect.TypeOf(int64(0)))) // Output: true
+//	fmt.Println(IsLossless(reflect.TypeOf(int64(0)), reflect.TypeOf(0.0)))      // Output: false
+func IsLossless(srcType, dstType reflect.Type) bool {
+	return classifyKinds(srcType, dstType, losslessKinds)
+}
+
+// IsInfallible reports whether converting a value of srcType to dstType can
+// never fail.
+//
+// IsInfallible reports whether converting any value of srcType to dstType
+// with TryInto, TryIntoAny or TryIntoValue returns a nil error, as for the
+// pairs with an XxxIntoYyy converter: bool to any other basic type, a number
+// to bool or string, an integer to a float, and a number to a type it widens
+// to. Unlike IsLossless, it also holds for pairs that round or change the
+// form of the value, such as int64 to float64 or int8 to string. The pairs
+// are classified like IsLossless: by kind, on every platform, with a
+// supported type converted to itself infallible and registered pairs and
+// pointer types not classified. Options set with SetDefaults, such as
+// Strict, may still reject a pair that IsInfallible accepts.
+//
+// Parameters:
+//   - srcType: the type of the source value.
+//   - dstType: the type of the destination value.
+//
+// Returns:
+//   - bool: true if the conversion cannot return an error.
+//
+// Example:
+//
+//	fmt.Println(IsInfallible(reflect.TypeOf(int64(0)), reflect.TypeOf(0.0))) // Output: true
+//	fmt.Println(IsInfallible(reflect.TypeOf(0.0), reflect.TypeOf(int64(0)))) // Output: false
+func IsInfallible(srcType, dstType reflect.Type) bool {
+	return classifyKinds(srcType, dstType, infallibleKinds)
+}
+
+// classifyKinds reports whether the conversion of srcType to dstType is in
+// kinds, which holds pairs of different kinds, for IsLossless and
+// IsInfallible.
+func classifyKinds(srcType, dstType reflect.Type, kinds map[[2]reflect.Kind]bool) bool {
+	if srcType == nil || dstType == nil {
+		return false
+	}
+
+	converters.RLock()
+	_, ok := converters.m[[2]reflect.Type{srcType, dstType}]
+	converters.RUnlock()
+	if ok {
+		return false
+	}
+
+	if srcType == dstType {
+		return srcType.Kind() != reflect.Ptr && canConvertBuiltin(srcType, dstType)
+	}
+	return kinds[[2]reflect.Kind{srcType.Kind(), dstType.Kind()}]
+}
@@ -0,0 +1,254 @@
+package into_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+type point struct{ X, Y int }
+
+func TestSupportedConversions(t *testing.T) {
+	conversions := SupportedConversions()
+	// 14*14 pairs of kinds, 29 pairs of time.Duration and 16 of time.Time.
+	if want := 14*14 + 29 + 16; len(conversions) != want {
+		t.Errorf("len(SupportedConversions()) = %d, want %d", len(conversions), want)
+	}
+	if c := conversions[0]; c.FromType != nil || c.ToType != nil {
+		t.Errorf("SupportedConversions()[0] = %v, want a pair of kinds first", c)
+	}
+
+	// Every listed pair must be accepted by the runtime dispatch.
+	for _, c := range conversions {
+		srcType, dstType := c.FromType, c.ToType
+		if srcType == nil {
+			srcType = basicType(c.From)
+		}
+		if dstType == nil {
+			dstType = basicType(c.To)
+		}
+		src := reflect.New(srcType).Elem()
+		if c.From == reflect.String {
+			src.SetString("0")
+			if dstType == reflect.TypeOf(time.Time{}) {
+				src.SetString("2024-01-02")
+			}
+		}
+		dst := reflect.New(dstType).Elem()
+		if !CanConvert(srcType, dstType) {
+			t.Errorf("CanConvert(%v, %v) = false", srcType, dstType)
+		}
+		if err := TryIntoValue(dst, src); err != nil {
+			t.Errorf("TryIntoValue(%v, %v) error = %v", dstType, srcType, err)
+		}
+	}
+}
+
+func TestCanConvert(t *testing.T) {
+	Register(func(p point) (string, error) { return "point", nil })
+
+	tests := []struct {
+		name string
+		src  reflect.Type
+		dst  reflect.Type
+		want bool
+	}{
+		{"basic", reflect.TypeOf(""), reflect.TypeOf(0), true},
+		{"named", reflect.TypeOf(time.Duration(0)), reflect.TypeOf(celsius(0)), true},
+		{"pointers", reflect.TypeOf(new(int8)), reflect.TypeOf(new(*float32)), true},
+		{"registered", reflect.TypeOf(point{}), reflect.TypeOf(""), true},
+		{"unregisteredStruct", reflect.TypeOf(point{}), reflect.TypeOf(0), false},
+		{"slice", reflect.TypeOf([]byte{}), reflect.TypeOf(""), false},
+		{"complex", reflect.TypeOf(1i), reflect.TypeOf(0.0), false},
+		{"nil", nil, reflect.TypeOf(0), false},
+		{"stringToTime", reflect.TypeOf(""), reflect.TypeOf(time.Time{}), true},
+		{"timeToString", reflect.TypeOf(time.Time{}), reflect.TypeOf(""), true},
+		{"floatToTime", reflect.TypeOf(0.0), reflect.TypeOf(new(time.Time)), true},
+		{"durationToTime", reflect.TypeOf(time.Duration(0)), reflect.TypeOf(time.Time{}), true},
+		{"boolToTime", reflect.TypeOf(false), reflect.TypeOf(time.Time{}), false},
+		{"timeToInt", reflect.TypeOf(time.Time{}), reflect.TypeOf(0), false},
+		{"timeToDuration", reflect.TypeOf(time.Time{}), reflect.TypeOf(time.Duration(0)), false},
+		{"stringToDuration", reflect.TypeOf(""), reflect.TypeOf(time.Duration(0)), true},
+		{"durationToString", reflect.TypeOf(time.Duration(0)), reflect.TypeOf(""), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanConvert(tt.src, tt.dst); got != tt.want {
+				t.Errorf("CanConvert(%v, %v) = %v, want %v", tt.src, tt.dst, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsLossless(t *testing.T) {
+	tests := []struct {
+		name     string
+		src, dst reflect.Type
+		want     bool
+	}{
+		{"int8ToInt64", reflect.TypeOf(int8(0)), reflect.TypeOf(int64(0)), true},
+		{"uint16ToFloat64", reflect.TypeOf(uint16(0)), reflect.TypeOf(0.0), true},
+		{"uint32ToInt64", reflect.TypeOf(uint32(0)), reflect.TypeOf(int64(0)), true},
+		{"float32ToFloat64", reflect.TypeOf(float32(0)), reflect.TypeOf(0.0), true},
+		{"intToInt64", reflect.TypeOf(0), reflect.TypeOf(int64(0)), true},
+		{"int32ToInt", reflect.TypeOf(int32(0)), reflect.TypeOf(0), true},
+		{"identity", reflect.TypeOf(""), reflect.TypeOf(""), true},
+		{"named", reflect.TypeOf(time.Duration(0)), reflect.TypeOf(int64(0)), true},
+		{"identityTime", reflect.TypeOf(time.Time{}), reflect.TypeOf(time.Time{}), true},
+		{"identityUnsupported", reflect.TypeOf([]int{}), reflect.TypeOf([]int{}), false},
+		{"identityStruct", reflect.TypeOf(point{}), reflect.TypeOf(point{}), false},
+		{"int64ToInt", reflect.TypeOf(int64(0)), reflect.TypeOf(0), false},
+		{"uint32ToInt", reflect.TypeOf(uint32(0)), reflect.TypeOf(0), false},
+		{"int64ToFloat64", reflect.TypeOf(int64(0)), reflect.TypeOf(0.0), false},
+		{"int32ToFloat32", reflect.TypeOf(int32(0)), reflect.TypeOf(float32(0)), false},
+		{"int8ToUint64", reflect.TypeOf(int8(0)), reflect.TypeOf(uint64(0)), false},
+		{"float64ToFloat32", reflect.TypeOf(0.0), reflect.TypeOf(float32(0)), false},
+		{"boolToInt", reflect.TypeOf(false), reflect.TypeOf(0), false},
+		{"intToString", reflect.TypeOf(0), reflect.TypeOf(""), false},
+		{"pointer", reflect.TypeOf(new(int8)), reflect.TypeOf(new(int8)), false},
+		{"registered", reflect.TypeOf(point{}), reflect.TypeOf(""), false},
+		{"nil", nil, reflect.TypeOf(0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsLossless(tt.src, tt.dst); got != tt.want {
+				t.Errorf("IsLossless(%v, %v) = %v, want %v", tt.src, tt.dst, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsInfallible(t *testing.T) {
+	tests := []struct {
+		name     string
+		src, dst reflect.Type
+		want     bool
+	}{
+		{"int8ToInt64", reflect.TypeOf(int8(0)), reflect.TypeOf(int64(0)), true},
+		{"int64ToFloat64", reflect.TypeOf(int64(0)), reflect.TypeOf(0.0), true},
+		{"uint64ToFloat32", reflect.TypeOf(uint64(0)), reflect.TypeOf(float32(0)), true},
+		{"boolToInt", reflect.TypeOf(false), reflect.TypeOf(0), true},
+		{"boolToString", reflect.TypeOf(false), reflect.TypeOf(""), true},
+		{"int8ToString", reflect.TypeOf(int8(0)), reflect.TypeOf(""), true},
+		{"float64ToBool", reflect.TypeOf(0.0), reflect.TypeOf(false), true},
+		{"identity", reflect.TypeOf(""), reflect.TypeOf(""), true},
+		{"named", reflect.TypeOf(time.Duration(0)), reflect.TypeOf(""), true},
+		{"int64ToInt", reflect.TypeOf(int64(0)), reflect.TypeOf(0), false},
+		{"float64ToInt64", reflect.TypeOf(0.0), reflect.TypeOf(int64(0)), false},
+		{"float64ToFloat32", reflect.TypeOf(0.0), reflect.TypeOf(float32(0)), false},
+		{"stringToBool", reflect.TypeOf(""), reflect.TypeOf(false), false},
+		{"identityUnsupported", reflect.TypeOf([]int{}), reflect.TypeOf([]int{}), false},
+		{"pointer", reflect.TypeOf(new(int8)), reflect.TypeOf(new(int8)), false},
+		{"registered", reflect.TypeOf(point{}), reflect.TypeOf(""), false},
+		{"nil", nil, reflect.TypeOf(0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsInfallible(tt.src, tt.dst); got != tt.want {
+				t.Errorf("IsInfallible(%v, %v) = %v, want %v", tt.src, tt.dst, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsInfallibleBounds checks that the bounds and special values of the
+// source type of every infallible pair convert without an error.
+func TestIsInfallibleBounds(t *testing.T) {
+	for _, c := range SupportedConversions() {
+		src, dst := basicType(c.From), basicType(c.To)
+		if src == nil || dst == nil || !IsInfallible(src, dst) {
+			continue
+		}
+		values := boundValues(src)
+		switch src.Kind() {
+		case reflect.Bool:
+			values = []reflect.Value{reflect.ValueOf(false), reflect.ValueOf(true)}
+		case reflect.Float32, reflect.Float64:
+			values = append(values, reflect.ValueOf(math.NaN()).Convert(src), reflect.ValueOf(math.Inf(-1)).Convert(src))
+		}
+		for _, v := range values {
+			if err := TryIntoValue(reflect.New(dst).Elem(), v); err != nil {
+				t.Errorf("TryIntoValue(%v, %v %v) error = %v", dst, src, v, err)
+			}
+		}
+	}
+}
+
+// TestIsLosslessBounds checks that the bounds of the source type of every
+// lossless pair convert and convert back to the same value.
+func TestIsLosslessBounds(t *testing.T) {
+	for _, c := range SupportedConversions() {
+		src, dst := basicType(c.From), basicType(c.To)
+		if !IsLossless(src, dst) {
+			continue
+		}
+		for _, v := range boundValues(src) {
+			converted := reflect.New(dst).Elem()
+			if err := TryIntoValue(converted, v); err != nil {
+				t.Errorf("TryIntoValue(%v, %v) error = %v", dst, v, err)
+				continue
+			}
+			back := reflect.New(src).Elem()
+			if err := TryIntoValue(back, converted); err != nil || back.Interface() != v.Interface() {
+				t.Errorf("%v %v converted to %v and back = %v, %v", src, v, dst, back, err)
+			}
+		}
+	}
+}
+
+func TestWidensTo(t *testing.T) {
+	if got := widenToInt64(int8(-128)) + widenToInt64(uint32(math.MaxUint32)); got != math.MaxUint32-128 {
+		t.Errorf("widenToInt64() sum = %d, want %d", got, int64(math.MaxUint32-128))
+	}
+	if got := widenToFloat64(uint16(65535)); got != 65535 {
+		t.Errorf("widenToFloat64() = %v, want 65535", got)
+	}
+}
+
+// widenToInt64 converts v to int64 without an error to handle.
+func widenToInt64[T WidensToInt64](v T) int64 {
+	return int64(v)
+}
+
+// widenToFloat64 converts v to float64 without an error to handle.
+func widenToFloat64[T WidensToFloat64](v T) float64 {
+	return float64(v)
+}
+
+// boundValues returns the minimum and maximum values of the numeric type t.
+func boundValues(t reflect.Type) []reflect.Value {
+	lo, hi := reflect.New(t).Elem(), reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lo.SetInt(-1 << (t.Bits() - 1))
+		hi.SetInt(1<<(t.Bits()-1) - 1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		hi.SetUint(1<<t.Bits() - 1)
+	case reflect.Float32:
+		lo.SetFloat(-math.MaxFloat32)
+		hi.SetFloat(math.SmallestNonzeroFloat32)
+	case reflect.Float64:
+		lo.SetFloat(-math.MaxFloat64)
+		hi.SetFloat(math.SmallestNonzeroFloat64)
+	default:
+		return nil
+	}
+	return []reflect.Value{lo, hi}
+}
+
+// basicType returns the predeclared type of kind.
+func basicType(kind reflect.Kind) reflect.Type {
+	for _, v := range []any{false, 0, int8(0), int16(0), int32(0), int64(0), uint(0), uint8(0),
+		uint16(0), uint32(0), uint64(0), float32(0), float64(0), ""} {
+		if t := reflect.TypeOf(v); t.Kind() == kind {
+			return t
+		}
+	}
+	return nil
+}
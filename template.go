@@ -0,0 +1,47 @@
+package into
+
+import (
+	"text/template"
+	"time"
+)
+
+// FuncMap returns template functions backed by the checked converters.
+//
+// FuncMap returns functions for text/template and html/template that
+// convert their argument like TryIntoAny, so template pipelines reject
+// out-of-range or malformed values with the error of the converter, which
+// stops the execution of the template, instead of printing a wrapped or
+// truncated number. The functions are toBool, toInt, toInt8, toInt16,
+// toInt32, toInt64, toUint, toUint8, toUint16, toUint32, toUint64, toFloat
+// (float64), toFloat32, toString, toDuration and toTime. Each call returns a
+// new map, which the caller may extend. For html/template, convert it with
+// htmltemplate.FuncMap(FuncMap()).
+//
+// Returns:
+//   - template.FuncMap: the conversion functions by name.
+//
+// Example:
+//
+//	tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(`{{toInt8 .}}`))
+//	err := tmpl.Execute(os.Stdout, "300")
+//	fmt.Println(err != nil) // Output: true
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"toBool":     TryIntoAny[bool],
+		"toInt":      TryIntoAny[int],
+		"toInt8":     TryIntoAny[int8],
+		"toInt16":    TryIntoAny[int16],
+		"toInt32":    TryIntoAny[int32],
+		"toInt64":    TryIntoAny[int64],
+		"toUint":     TryIntoAny[uint],
+		"toUint8":    TryIntoAny[uint8],
+		"toUint16":   TryIntoAny[uint16],
+		"toUint32":   TryIntoAny[uint32],
+		"toUint64":   TryIntoAny[uint64],
+		"toFloat":    TryIntoAny[float64],
+		"toFloat32":  TryIntoAny[float32],
+		"toString":   TryIntoAny[string],
+		"toDuration": TryIntoAny[time.Duration],
+		"toTime":     TryIntoAny[time.Time],
+	}
+}
@@ -0,0 +1,59 @@
+package into_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestFuncMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		data    any
+		want    string
+		wantErr error
+	}{
+		{"toInt", `{{toInt .}}`, "42", "42", nil},
+		{"toIntArithmetic", `{{if gt (toInt .) 10}}big{{end}}`, "42", "big", nil},
+		{"toInt8Overflow", `{{toInt8 .}}`, "300", "", ErrOverflow},
+		{"toUintNegative", `{{toUint .}}`, -1, "", ErrUnderflow},
+		{"toFloat", `{{toFloat .}}`, "2.5", "2.5", nil},
+		{"toBool", `{{if toBool .}}yes{{end}}`, "true", "yes", nil},
+		{"toString", `{{toString .}}`, 1.5, "1.5", nil},
+		{"toDuration", `{{toDuration .}}`, "90s", "1m30s", nil},
+		{"toTime", `{{(toTime .).Year}}`, "2024-03-01T00:00:00Z", "2024", nil},
+		{"pointer", `{{toInt .}}`, func() *string { s := "7"; return &s }(), "7", nil},
+		{"nil", `{{toInt .}}`, nil, "", ErrNil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := template.Must(template.New(tt.name).Funcs(FuncMap()).Parse(tt.text))
+			var b strings.Builder
+			err := tmpl.Execute(&b, tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && b.String() != tt.want {
+				t.Errorf("Execute() = %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFuncMapIsNew(t *testing.T) {
+	m := FuncMap()
+	delete(m, "toInt")
+	if _, ok := FuncMap()["toInt"]; !ok {
+		t.Error("FuncMap() returned a shared map")
+	}
+	if _, ok := FuncMap()["toDuration"].(func(any) (time.Duration, error)); !ok {
+		t.Errorf("FuncMap()[\"toDuration\"] has type %T", FuncMap()["toDuration"])
+	}
+}
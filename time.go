@@ -1,16 +1,24 @@
 package into
 
 import (
-	"errors"
+	"fmt"
 	"math"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 )
 
+func init() {
+	Register(TimeToFloat64)
+}
+
 // TryIntoTime converts the given value to a time.Time value.
 //
 // TryIntoTime attempts to convert the given value to a time.Time value.
 // It supports the following types:
+//   - float32
+//   - float64
 //   - int
 //   - int8
 //   - int16
@@ -20,10 +28,16 @@ import (
 //   - uint8
 //   - uint16
 //   - uint32
+//   - uint64
 //   - string
 //   - time.Time
 //
 // If the given value is not one of the supported types, it returns an error.
+// If the EpochStrings option is set with SetDefaults, digit-only strings are
+// parsed as Unix timestamps in seconds. If the Locale option is set, month and
+// weekday names of that locale are accepted as with StringToTimeLocale. If
+// the DateOrder option is set, numeric dates such as "03/04/05" are parsed
+// as with StringToTimeOrder.
 // If the given value is a string, it attempts to parse it using the following formats:
 //   - "2006-01-02T15:04:05.999999-0700MST"
 //   - "2006-01-02T15:04:05.999999-0700"
@@ -51,15 +65,21 @@ import (
 //	  log.Fatal(err)
 //	}
 //	fmt.Println(result) // Output: 2023-03-15T00:00:00Z
-func TryIntoTime[T String | Int | Uint | time.Time](value T) (time.Time, error) {
-	result, err := toTime(value)
-	return result.(time.Time), err
+func TryIntoTime[T String | Float | Int | Uint | time.Time](value T) (time.Time, error) {
+	result, err := toTimeWith(value, Defaults())
+	observe[time.Time](value, err)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return result.(time.Time), nil
 }
 
 // toTime converts the given value to a time.Time value.
 //
 // toTime attempts to convert the given value to a time.Time value.
 // It supports the following types:
+//   - float32
+//   - float64
 //   - int
 //   - int8
 //   - int16
@@ -69,7 +89,9 @@ func TryIntoTime[T String | Int | Uint | time.Time](value T) (time.Time, error)
 //   - uint8
 //   - uint16
 //   - uint32
+//   - uint64
 //   - string
+//   - time.Time, returned as is
 //
 // If the given value is not one of the supported types, it returns an error.
 // If the given value is a string, it attempts to parse it using the following formats:
@@ -92,8 +114,15 @@ func TryIntoTime[T String | Int | Uint | time.Time](value T) (time.Time, error)
 //   - any: the converted value.
 //   - error: an error if the conversion fails.
 func toTime(value any) (any, error) {
+	if t, ok := value.(time.Time); ok {
+		return t, nil
+	}
 	valueType := reflect.TypeOf(value)
 	switch valueType.Kind() {
+	case reflect.Float64:
+		return Float64ToTime(value.(float64))
+	case reflect.Float32:
+		return Float64ToTime(float64(value.(float32)))
 	case reflect.Int:
 		return IntToTime(value.(int))
 	case reflect.Int8:
@@ -112,11 +141,88 @@ func toTime(value any) (any, error) {
 		return Uint16ToTime(value.(uint16))
 	case reflect.Uint32:
 		return Uint32ToTime(value.(uint32))
+	case reflect.Uint64:
+		return Uint64ToTime(value.(uint64))
 	case reflect.String:
 		return StringToTime(value.(string))
 	default:
-		return time.Time{}, errors.New("unsupported type")
+		return time.Time{}, unsupportedError(value, "time.Time")
+	}
+}
+
+// toTimeWith converts the given value to a time.Time value using opts.
+//
+// toTimeWith normalizes strings and applies the Empty policy like the other
+// targets, handles the string options that apply to time.Time targets and
+// dispatches everything else to toTime.
+func toTimeWith(value any, opts Options) (any, error) {
+	value = kindValue(value)
+	if v, ok := value.(string); ok {
+		v = normalizeString(v, opts)
+		if v == "" && opts.Empty != EmptyReject {
+			return time.Time{}, nil
+		}
+		value = v
+		switch {
+		case opts.EpochStrings && isDigits(v):
+			return StringToTimeEpoch(v)
+		case opts.DateOrder != DateOrderReject && isNumericDate(v):
+			return StringToTimeOrder(v, opts.DateOrder, opts.YearPivot)
+		case opts.Locale != nil:
+			return StringToTimeLocale(v, *opts.Locale)
+		}
+	}
+	return toTime(value)
+}
+
+// Float64ToTime converts the given float64 value to a time.Time value.
+//
+// Float64ToTime converts the given float64 value to a time.Time value,
+// representing the number of seconds since the Unix epoch with a fractional
+// part, as emitted by many JSON APIs and Prometheus. The fraction is taken
+// from the shortest decimal representation of the value, so 1678867200.123456
+// yields 123456000 nanoseconds rather than the nearest binary fraction.
+// Digits beyond nanoseconds are truncated.
+//
+// Parameters:
+//   - value: the float64 value to be converted.
+//
+// Returns:
+//   - time.Time: the converted time.Time value.
+//   - error: a *ConversionError wrapping ErrNaN if the value is NaN, or
+//     ErrOverflow or ErrUnderflow if the seconds exceed the int64 range.
+//
+// Example:
+//
+//	result, err := Float64ToTime(1678867200.123456)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.UTC()) // Output: 2023-03-15 08:00:00.123456 +0000 UTC
+func Float64ToTime(value float64) (time.Time, error) {
+	if math.IsNaN(value) {
+		return time.Time{}, nanError(value, "time.Time")
+	}
+	if value >= math.MaxInt64+1 {
+		return time.Time{}, overflowError(value, "time.Time")
+	}
+	if value < math.MinInt64 {
+		return time.Time{}, underflowError(value, "time.Time")
 	}
+
+	whole, frac, _ := strings.Cut(strconv.FormatFloat(value, 'f', -1, 64), ".")
+	sec, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	nsec, err := strconv.ParseInt((frac + "000000000")[:9], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if value < 0 {
+		nsec = -nsec
+	}
+	return time.Unix(sec, nsec), nil
 }
 
 // IntToTime converts the given int value to a time.Time value.
@@ -216,6 +322,10 @@ func Int64ToTime(value int64) (time.Time, error) {
 //   - "2006-01-02 15:04:05.999999-0700"
 //   - "2006-01-02 15:04:05.999999Z07:00"
 //
+// Formats without a date, such as time.Kitchen, yield a time on January 1
+// of year 0. Use StringToTimeOfDay for times of day and StringToDate or
+// StringToTimeDateOnly for dates without a time.
+//
 // Parameters:
 //   - value: the string value to be converted.
 //
@@ -226,10 +336,46 @@ func StringToTime(value string) (time.Time, error) {
 	return parseDateWith(value, time.UTC, timeFormats)
 }
 
+// StringToTimeEpoch converts the given string value to a time.Time value,
+// accepting Unix timestamps.
+//
+// StringToTimeEpoch converts a digit-only string such as "1678867200" to a
+// time.Time value, representing the number of seconds since the Unix epoch.
+// Other strings are parsed with the formats of StringToTime.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - time.Time: the converted time.Time value.
+//   - error: an error if the conversion fails, or a *ConversionError
+//     wrapping ErrOverflow if the timestamp exceeds the int64 max limit.
+//
+// Example:
+//
+//	result, err := StringToTimeEpoch("1678867200")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.UTC()) // Output: 2023-03-15 08:00:00 +0000 UTC
+func StringToTimeEpoch(value string) (time.Time, error) {
+	if !isDigits(value) {
+		return StringToTime(value)
+	}
+
+	timestamp, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, overflowError(value, "time.Time")
+	}
+	return time.Unix(timestamp, 0), nil
+}
+
 // StringToDuration converts the given string value to a time.Duration value.
 //
 // StringToDuration converts the given string value to a time.Duration value,
-// using the standard time duration format, e.g. "1h30m".
+// using the standard time duration format, e.g. "1h30m". Strings in the ISO
+// 8601 duration format, e.g. "PT1H30M", are parsed with
+// ISO8601StringToDuration.
 //
 // Parameters:
 //   - value: the string value to be converted.
@@ -238,6 +384,9 @@ func StringToTime(value string) (time.Time, error) {
 //   - time.Duration: the converted time.Duration value.
 //   - error: an error if the conversion fails.
 func StringToDuration(value string) (time.Duration, error) {
+	if strings.HasPrefix(strings.TrimPrefix(value, "-"), "P") {
+		return ISO8601StringToDuration(value)
+	}
 	return time.ParseDuration(value)
 }
 
@@ -253,8 +402,8 @@ func StringToDuration(value string) (time.Duration, error) {
 //   - time.Time: the converted time.Time value.
 //   - error: an error if the value exceeds the int64 max limit.
 func UintToTime(value uint) (time.Time, error) {
-	if value > uint(math.MaxInt64) {
-		return time.Time{}, errors.New("value exceeds int64 max limit")
+	if uint64(value) > math.MaxInt64 {
+		return time.Time{}, overflowError(value, "time.Time")
 	}
 
 	timestamp := int64(value)
@@ -325,9 +474,45 @@ func Uint32ToTime(value uint32) (time.Time, error) {
 //   - error: an error if the value exceeds the int64 max limit.
 func Uint64ToTime(value uint64) (time.Time, error) {
 	if value > uint64(math.MaxInt64) {
-		return time.Time{}, errors.New("value exceeds int64 max limit")
+		return time.Time{}, overflowError(value, "time.Time")
 	}
 	timestamp := int64(value)
 
 	return time.Unix(timestamp, 0), nil
 }
+
+// TimeToFloat64 converts a time.Time value to a float64 value.
+//
+// TimeToFloat64 converts a time.Time value to the number of seconds since
+// the Unix epoch, with the nanoseconds as the fractional part. The result is
+// the float64 nearest to the exact decimal value, so it round-trips through
+// Float64ToTime at the precision float64 offers (about a microsecond for
+// current dates).
+//
+// Parameters:
+//   - value: the time.Time value to be converted.
+//
+// Returns:
+//   - float64: the converted float64 value.
+//   - error: nil.
+//
+// Example:
+//
+//	result, err := TimeToFloat64(time.Unix(1678867200, 123456000))
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1.678867200123456e+09
+func TimeToFloat64(value time.Time) (float64, error) {
+	sec, nsec := value.Unix(), int64(value.Nanosecond())
+	sign := ""
+	if sec < 0 && nsec > 0 {
+		// Unix rounds toward negative infinity; express the value as a
+		// negative whole part and a negative fraction instead.
+		sec, nsec = sec+1, 1e9-nsec
+		if sec == 0 {
+			sign = "-"
+		}
+	}
+	return strconv.ParseFloat(fmt.Sprintf("%s%d.%09d", sign, sec, nsec), 64)
+}
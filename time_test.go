@@ -0,0 +1,198 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestTryIntoTimeUint64(t *testing.T) {
+	got, err := TryIntoTime(uint64(1678867200))
+	if err != nil || !got.Equal(time.Unix(1678867200, 0)) {
+		t.Errorf("TryIntoTime(uint64) = %v, %v", got, err)
+	}
+	if _, err := TryIntoTime(uint64(math.MaxUint64)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TryIntoTime(MaxUint64) error = %v, want ErrOverflow", err)
+	}
+}
+
+func TestTryIntoTimeGeneric(t *testing.T) {
+	ts := time.Date(2023, 3, 15, 12, 30, 0, 500000000, time.UTC)
+
+	if got, err := TryInto[string](ts); err != nil || got != "2023-03-15T12:30:00.5Z" {
+		t.Errorf("TryInto[string](time) = %q, %v", got, err)
+	}
+	if got, err := TryIntoWith[string](ts, Options{TimeLayout: "2006-01-02"}); err != nil || got != "2023-03-15" {
+		t.Errorf("TryIntoWith[string](time, date layout) = %q, %v", got, err)
+	}
+	if got, err := TryInto[float64](ts); err != nil || got != 1678883400.5 {
+		t.Errorf("TryInto[float64](time) = %v, %v", got, err)
+	}
+	if got, err := TryInto[time.Time]("2023-03-15T12:30:00.5Z"); err != nil || !got.Equal(ts) {
+		t.Errorf("TryInto[time.Time](string) = %v, %v", got, err)
+	}
+	if got, err := TryInto[time.Time](int64(1678883400)); err != nil || !got.Equal(ts.Truncate(time.Second)) {
+		t.Errorf("TryInto[time.Time](int64) = %v, %v", got, err)
+	}
+	if got, err := TryInto[time.Time](ts); err != nil || !got.Equal(ts) {
+		t.Errorf("TryInto[time.Time](time) = %v, %v", got, err)
+	}
+	if got, err := TryIntoWith[time.Time]("1678883400", Options{EpochStrings: true}); err != nil || !got.Equal(ts.Truncate(time.Second)) {
+		t.Errorf("TryIntoWith[time.Time](epoch string) = %v, %v", got, err)
+	}
+	if got, err := TryIntoAny[time.Time](&ts); err != nil || !got.Equal(ts) {
+		t.Errorf("TryIntoAny[time.Time](*time.Time) = %v, %v", got, err)
+	}
+	if got := Into[string](time.Unix(0, 0).UTC()); got != "1970-01-01T00:00:00Z" {
+		t.Errorf("Into[string](time) = %q", got)
+	}
+	if _, err := TryInto[time.Time](true); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("TryInto[time.Time](bool) error = %v, want ErrUnsupportedType", err)
+	}
+	if _, err := TryInto[int8](ts); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("TryInto[int8](time) error = %v, want ErrUnsupportedType", err)
+	}
+}
+
+func TestStringToTimeEpoch(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"epoch", "1678867200", time.Unix(1678867200, 0), false},
+		{"zero", "0", time.Unix(0, 0), false},
+		{"date", "2023-03-15", time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC), false},
+		{"signed", "-1", time.Time{}, true},
+		{"overflow", "9223372036854775808", time.Time{}, true},
+		{"empty", "", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToTimeEpoch(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToTimeEpoch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("StringToTimeEpoch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEpochStringsOption(t *testing.T) {
+	defer SetDefaults(Defaults())
+
+	if _, err := TryIntoTime("1678867200"); err == nil {
+		t.Error("TryIntoTime(\"1678867200\") without EpochStrings error = nil")
+	}
+
+	SetDefaults(Options{EpochStrings: true})
+	got, err := TryIntoTime("1678867200")
+	if err != nil || !got.Equal(time.Unix(1678867200, 0)) {
+		t.Errorf("TryIntoTime(\"1678867200\") = %v, %v", got, err)
+	}
+}
+
+func TestFloat64ToTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   float64
+		want    time.Time
+		wantErr error
+	}{
+		{"whole", 1678867200, time.Unix(1678867200, 0), nil},
+		{"micros", 1678867200.123456, time.Unix(1678867200, 123456000), nil},
+		{"millis", 1678867200.5, time.Unix(1678867200, 500000000), nil},
+		{"smallFraction", 0.000000001, time.Unix(0, 1), nil},
+		{"negativeFraction", -0.25, time.Unix(0, -250000000), nil},
+		{"negative", -1678867200.5, time.Unix(-1678867200, -500000000), nil},
+		{"nan", math.NaN(), time.Time{}, ErrNaN},
+		{"overflow", math.Inf(1), time.Time{}, ErrOverflow},
+		{"underflow", -1e19, time.Time{}, ErrUnderflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Float64ToTime(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Float64ToTime() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Float64ToTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var convErr *ConversionError
+	if _, err := Float64ToTime(math.NaN()); !errors.As(err, &convErr) || convErr.From != "float64" || convErr.To != "time.Time" {
+		t.Errorf("Float64ToTime(NaN) error = %v, want a ConversionError from float64 to time.Time", err)
+	}
+}
+
+func TestTimeToFloat64(t *testing.T) {
+	tests := []struct {
+		name  string
+		input time.Time
+		want  float64
+	}{
+		{"whole", time.Unix(1678867200, 0), 1678867200},
+		{"micros", time.Unix(1678867200, 123456000), 1678867200.123456},
+		{"negativeFraction", time.Unix(0, -250000000), -0.25},
+		{"negative", time.Unix(-1678867200, -500000000), -1678867200.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TimeToFloat64(tt.input)
+			if err != nil || got != tt.want {
+				t.Errorf("TimeToFloat64() = %v, %v, want %v", got, err, tt.want)
+			}
+			back, err := Float64ToTime(got)
+			if err != nil || !back.Equal(tt.input) {
+				t.Errorf("Float64ToTime(TimeToFloat64()) = %v, %v, want %v", back, err, tt.input)
+			}
+		})
+	}
+}
+
+func TestTimeToString(t *testing.T) {
+	defer SetDefaults(Defaults())
+	tm := time.Date(2023, 3, 15, 8, 0, 0, 500000000, time.UTC)
+
+	if got, err := TimeToStringRFC3339(tm); err != nil || got != "2023-03-15T08:00:00.5Z" {
+		t.Errorf("TimeToStringRFC3339() = %q, %v", got, err)
+	}
+	if _, err := TimeToStringRFC3339(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("TimeToStringRFC3339(year 10000) error = nil")
+	}
+	if got, err := TimeToStringLayout(tm, time.Kitchen); err != nil || got != "8:00AM" {
+		t.Errorf("TimeToStringLayout(Kitchen) = %q, %v", got, err)
+	}
+	if _, err := TimeToStringLayout(tm, ""); err == nil {
+		t.Error("TimeToStringLayout(\"\") error = nil")
+	}
+
+	got, err := TryIntoString(tm)
+	if err != nil || got != "2023-03-15T08:00:00.5Z" {
+		t.Errorf("TryIntoString(time) = %q, %v", got, err)
+	}
+	if back, err := StringToTime(got); err != nil || !back.Equal(tm) {
+		t.Errorf("StringToTime(TryIntoString(time)) = %v, %v, want %v", back, err, tm)
+	}
+	if got, err := TryIntoAny[string](tm); err != nil || got != "2023-03-15T08:00:00.5Z" {
+		t.Errorf("TryIntoAny[string](time) = %q, %v", got, err)
+	}
+
+	SetDefaults(Options{TimeLayout: "2006-01-02"})
+	if got, err := TryIntoString(tm); err != nil || got != "2023-03-15" {
+		t.Errorf("TryIntoString(time) with TimeLayout = %q, %v", got, err)
+	}
+}
@@ -78,3 +78,16 @@ func parseDateWith(s string, location *time.Location, formats []timeFormat) (d t
 	}
 	return d, fmt.Errorf("unable to parse date: %s", s)
 }
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
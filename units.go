@@ -0,0 +1,157 @@
+package into
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// unitMultipliers maps the supported SI and IEC unit prefixes to their factor.
+var unitMultipliers = map[string]int64{
+	"":   1,
+	"k":  1e3,
+	"K":  1e3,
+	"M":  1e6,
+	"G":  1e9,
+	"T":  1e12,
+	"P":  1e15,
+	"E":  1e18,
+	"Ki": 1 << 10,
+	"Mi": 1 << 20,
+	"Gi": 1 << 30,
+	"Ti": 1 << 40,
+	"Pi": 1 << 50,
+	"Ei": 1 << 60,
+}
+
+// StringToInt64WithUnits converts a string with an optional unit suffix to int64.
+//
+// StringToInt64WithUnits converts a string such as "10MiB" or "1.5k" to int64.
+// The number may be followed by an SI prefix (k, K, M, G, T, P, E; powers of
+// 1000) or an IEC prefix (Ki, Mi, Gi, Ti, Pi, Ei; powers of 1024), optionally
+// followed by "B". A plain "B" suffix is accepted as a factor of 1.
+// The scaling is exact: the result must be an integer within the int64 range.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - int64: the converted int64 value.
+//   - error: an error if the number or suffix is invalid, ErrLossOfPrecision
+//     if the scaled value has a fractional part, or a *ConversionError
+//     wrapping ErrOverflow or ErrUnderflow if it is out of the int64 range.
+//
+// Example:
+//
+//	result, err := StringToInt64WithUnits("10MiB")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 10485760
+func StringToInt64WithUnits(value string) (int64, error) {
+	r, err := parseUnits(value)
+	if err != nil {
+		return 0, err
+	}
+	if !r.IsInt() {
+		return 0, ErrLossOfPrecision
+	}
+	if !r.Num().IsInt64() {
+		if r.Sign() < 0 {
+			return 0, underflowError(value, "int64")
+		}
+		return 0, overflowError(value, "int64")
+	}
+	return r.Num().Int64(), nil
+}
+
+// StringToFloat64WithUnits converts a string with an optional unit suffix to float64.
+//
+// StringToFloat64WithUnits converts a string such as "1.5k" or "2GiB" to
+// float64, accepting the same suffixes as StringToInt64WithUnits.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - float64: the converted float64 value.
+//   - error: an error if the number or suffix is invalid.
+//
+// Example:
+//
+//	result, err := StringToFloat64WithUnits("1.5k")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: 1500
+func StringToFloat64WithUnits(value string) (float64, error) {
+	r, err := parseUnits(value)
+	if err != nil {
+		return 0, err
+	}
+	f, _ := r.Float64()
+	return f, nil
+}
+
+// parseUnits parses a number with an optional unit suffix into an exact rational.
+//
+// parseUnits splits value into its number and its trailing letters, looks the
+// letters up in unitMultipliers and scales the number exactly.
+//
+// Parameters:
+//   - value: the string value to be parsed.
+//
+// Returns:
+//   - *big.Rat: the scaled value.
+//   - error: an error if the number or suffix is invalid.
+func parseUnits(value string) (*big.Rat, error) {
+	i := len(value)
+	for i > 0 && isASCIILetter(value[i-1]) {
+		i--
+	}
+	number, suffix := strings.TrimSpace(value[:i]), value[i:]
+
+	multiplier, ok := unitMultipliers[strings.TrimSuffix(suffix, "B")]
+	if !ok {
+		return nil, fmt.Errorf("unknown unit suffix %q", suffix)
+	}
+
+	// ParseFloat validates the syntax and bounds the exponent before the
+	// exact parse, so inputs such as "1e999999999" are rejected cheaply.
+	if _, err := strconv.ParseFloat(number, 64); err != nil {
+		return nil, err
+	}
+	r, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", number)
+	}
+	return r.Mul(r, new(big.Rat).SetInt64(multiplier)), nil
+}
+
+// unitsValue converts a string with a unit suffix to a number for toKindWith.
+//
+// unitsValue returns an int64 or uint64 when the scaled value is an integer
+// that fits, and a float64 otherwise, so the rounding and overflow policies
+// apply to the result as they do to numeric sources.
+func unitsValue(value string) (any, error) {
+	r, err := parseUnits(value)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsInt() {
+		if r.Num().IsInt64() {
+			return r.Num().Int64(), nil
+		}
+		if r.Num().IsUint64() {
+			return r.Num().Uint64(), nil
+		}
+	}
+	f, _ := r.Float64()
+	return f, nil
+}
+
+// isASCIILetter reports whether c is an ASCII letter.
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,104 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToInt64WithUnits(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{"plain", "123", 123, false},
+		{"bytes", "512B", 512, false},
+		{"kilo", "10k", 10000, false},
+		{"kiloBytes", "10kB", 10000, false},
+		{"mebi", "10MiB", 10 << 20, false},
+		{"gibiNoB", "2Gi", 2 << 30, false},
+		{"fractionScaled", "1.5Ki", 1536, false},
+		{"space", "3 GB", 3e9, false},
+		{"negative", "-2M", -2e6, false},
+		{"exponent", "1e3k", 1e6, false},
+		{"exa", "9E", 9e18, false},
+		{"fraction", "1.5", 0, true},
+		{"overflow", "8Ei", 0, true},
+		{"unknownSuffix", "10Q", 0, true},
+		{"lowercaseMega", "10m", 0, true},
+		{"noNumber", "MiB", 0, true},
+		{"empty", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToInt64WithUnits(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToInt64WithUnits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToInt64WithUnits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := StringToInt64WithUnits("1.5"); !errors.Is(err, ErrLossOfPrecision) {
+		t.Errorf("StringToInt64WithUnits(1.5) error = %v, want ErrLossOfPrecision", err)
+	}
+}
+
+func TestStringToFloat64WithUnits(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{"kilo", "1.5k", 1500, false},
+		{"gibi", "0.5GiB", 1 << 29, false},
+		{"plain", "2.25", 2.25, false},
+		{"invalid", "abc", 0, true},
+		{"inf", "inf", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToFloat64WithUnits(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToFloat64WithUnits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToFloat64WithUnits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryIntoWithUnits(t *testing.T) {
+	opts := Options{Units: true}
+	if got, err := TryIntoWith[uint32]("64Mi", opts); err != nil || got != 64<<20 {
+		t.Errorf("TryIntoWith[uint32](64Mi) = %v, %v", got, err)
+	}
+	if _, err := TryIntoWith[uint32]("8Gi", opts); err == nil {
+		t.Errorf("TryIntoWith[uint32](8Gi) should overflow")
+	}
+	opts.Overflow = Saturate
+	if got, err := TryIntoWith[uint32]("8Gi", opts); err != nil || got != math.MaxUint32 {
+		t.Errorf("TryIntoWith[uint32](8Gi) with Saturate = %v, %v", got, err)
+	}
+	if got, err := TryIntoWith[uint64]("15Ei", opts); err != nil || got != 15<<60 {
+		t.Errorf("TryIntoWith[uint64](15Ei) = %v, %v", got, err)
+	}
+	if got, err := TryIntoWith[float32]("2.5k", opts); err != nil || got != 2500 {
+		t.Errorf("TryIntoWith[float32](2.5k) = %v, %v", got, err)
+	}
+	if _, err := TryIntoWith[int]("10MiB", Options{}); err == nil {
+		t.Errorf("TryIntoWith[int](10MiB) without Units should fail")
+	}
+}
@@ -0,0 +1,60 @@
+package into
+
+import (
+	"fmt"
+	"net/url"
+)
+
+func init() {
+	Register(StringToURL)
+	Register(URLToString)
+}
+
+// StringToURL converts the given string value to a *url.URL value.
+//
+// StringToURL converts an absolute URL such as "https://example.com/a?b=c"
+// to a *url.URL value. Unlike url.Parse, it rejects relative references
+// such as "example.com/a", which are usually a mistake in configuration.
+// The registry converts strings to *url.URL with it.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - *url.URL: the converted *url.URL value.
+//   - error: an error if the string is not an absolute URL.
+//
+// Example:
+//
+//	result, err := StringToURL("https://example.com:8443/path")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result.Port()) // Output: 8443
+func StringToURL(value string) (*url.URL, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" {
+		return nil, fmt.Errorf("URL %q has no scheme", value)
+	}
+	return u, nil
+}
+
+// URLToString converts the given *url.URL value to a string value.
+//
+// URLToString converts a *url.URL value to a string with its String method.
+//
+// Parameters:
+//   - value: the *url.URL value to be converted.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: ErrNil if the value is nil.
+func URLToString(value *url.URL) (string, error) {
+	if value == nil {
+		return "", ErrNil
+	}
+	return value.String(), nil
+}
@@ -0,0 +1,87 @@
+package into_test
+
+import (
+	"net/mail"
+	"net/url"
+	"reflect"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"https", "https://example.com:8443/a?b=c#d", false},
+		{"file", "file:///etc/hosts", false},
+		{"mailto", "mailto:alice@example.com", false},
+		{"relative", "example.com/a", true},
+		{"badEscape", "https://example.com/%zz", true},
+		{"empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToURL(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StringToURL(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if s, err := URLToString(got); err != nil || s != tt.input {
+				t.Errorf("URLToString() = %q, %v, want %q", s, err, tt.input)
+			}
+		})
+	}
+
+	var u *url.URL
+	if err := TryIntoValue(reflect.ValueOf(&u).Elem(), reflect.ValueOf("https://example.com")); err != nil || u.Host != "example.com" {
+		t.Errorf("TryIntoValue(*url.URL) = %v, %v", u, err)
+	}
+	if _, err := URLToString(nil); err == nil {
+		t.Error("URLToString(nil) error = nil")
+	}
+}
+
+func TestStringToMailAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantAddr string
+		wantErr  bool
+	}{
+		{"plain", "alice@example.com", "alice@example.com", false},
+		{"named", "Alice <alice@example.com>", "alice@example.com", false},
+		{"quoted", `"Smith, Bob" <bob@example.com>`, "bob@example.com", false},
+		{"noDomain", "alice", "", true},
+		{"list", "a@example.com, b@example.com", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToMailAddress(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StringToMailAddress(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Address != tt.wantAddr {
+				t.Errorf("StringToMailAddress(%q).Address = %q, want %q", tt.input, got.Address, tt.wantAddr)
+			}
+			s, _ := MailAddressToString(got)
+			if back, err := StringToMailAddress(s); err != nil || *back != *got {
+				t.Errorf("StringToMailAddress(%q) = %v, %v, want %v", s, back, err, got)
+			}
+		})
+	}
+
+	var addr *mail.Address
+	if err := TryIntoValue(reflect.ValueOf(&addr).Elem(), reflect.ValueOf("bob@example.com")); err != nil || addr.Address != "bob@example.com" {
+		t.Errorf("TryIntoValue(*mail.Address) = %v, %v", addr, err)
+	}
+}
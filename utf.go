@@ -0,0 +1,213 @@
+package into
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// StringToValidUTF8 converts a string to valid UTF-8.
+//
+// StringToValidUTF8 checks that value is valid UTF-8, and handles invalid
+// sequences as selected by invalid: UTF8Keep returns the string unchanged,
+// UTF8Replace replaces each run of invalid bytes with U+FFFD, as
+// strings.ToValidUTF8 does, and UTF8Reject returns an error. It is applied
+// to byte slices converted to strings when Options.UTF8 is set.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//   - invalid: how invalid sequences are handled.
+//
+// Returns:
+//   - string: the valid UTF-8 string.
+//   - error: a *ConversionError wrapping ErrInvalidUTF8 with the offset of
+//     the first invalid byte if invalid is UTF8Reject, or an error if invalid
+//     is not a known mode.
+//
+// Example:
+//
+//	result, err := StringToValidUTF8("a\xffb", UTF8Replace)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: a�b
+func StringToValidUTF8(value string, invalid InvalidUTF8) (string, error) {
+	switch invalid {
+	case UTF8Keep:
+		return value, nil
+	case UTF8Replace:
+		return strings.ToValidUTF8(value, string(utf8.RuneError)), nil
+	case UTF8Reject:
+		if i := invalidUTF8(value); i >= 0 {
+			return "", &ConversionError{From: "string", To: "string", Value: value, Err: fmt.Errorf("%w at byte %d", ErrInvalidUTF8, i)}
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("unknown UTF-8 mode %d", invalid)
+	}
+}
+
+// invalidUTF8 returns the offset of the first invalid byte of s, or -1 if s
+// is valid UTF-8.
+func invalidUTF8(s string) int {
+	for i := 0; i < len(s); {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && n == 1 {
+			return i
+		}
+		i += n
+	}
+	return -1
+}
+
+// BytesUTF16ToString converts UTF-16 bytes to a string.
+//
+// BytesUTF16ToString decodes value as UTF-16 code units in the byte order
+// given by its byte order mark, which is removed, or in order if it has
+// none. A nil order is big-endian, as RFC 2781 specifies for UTF-16 without
+// a byte order mark. Unpaired surrogates are replaced with U+FFFD.
+//
+// Parameters:
+//   - value: the UTF-16 bytes, with an even length.
+//   - order: the byte order used when value has no byte order mark.
+//
+// Returns:
+//   - string: the decoded UTF-8 string.
+//   - error: a *ConversionError wrapping ErrLength if the length of value
+//     is odd.
+//
+// Example:
+//
+//	result, err := BytesUTF16ToString([]byte{0xff, 0xfe, 'h', 0, 'i', 0}, nil)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: hi
+func BytesUTF16ToString(value []byte, order binary.ByteOrder) (string, error) {
+	if len(value)%2 != 0 {
+		return "", &ConversionError{From: "[]byte", To: "string", Value: value, Err: fmt.Errorf("%w: got %d, want an even length", ErrLength, len(value))}
+	}
+	if order == nil {
+		order = binary.BigEndian
+	}
+	switch {
+	case len(value) >= 2 && value[0] == 0xfe && value[1] == 0xff:
+		order, value = binary.BigEndian, value[2:]
+	case len(value) >= 2 && value[0] == 0xff && value[1] == 0xfe:
+		order, value = binary.LittleEndian, value[2:]
+	}
+
+	units := make([]uint16, len(value)/2)
+	for i := range units {
+		units[i] = order.Uint16(value[2*i:])
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+// StringToBytesUTF16 converts a string to UTF-16 bytes.
+//
+// StringToBytesUTF16 encodes value as UTF-16 code units in the given byte
+// order, preceded by a byte order mark if bom is true. A nil order is
+// big-endian.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//   - order: the byte order of the code units.
+//   - bom: whether to write a byte order mark.
+//
+// Returns:
+//   - []byte: the UTF-16 bytes.
+//   - error: a *ConversionError wrapping ErrInvalidUTF8 if value is not
+//     valid UTF-8.
+//
+// Example:
+//
+//	result, err := StringToBytesUTF16("hi", binary.LittleEndian, true)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [255 254 104 0 105 0]
+func StringToBytesUTF16(value string, order binary.ByteOrder, bom bool) ([]byte, error) {
+	if i := invalidUTF8(value); i >= 0 {
+		return nil, &ConversionError{From: "string", To: "UTF-16", Value: value, Err: fmt.Errorf("%w at byte %d", ErrInvalidUTF8, i)}
+	}
+	if order == nil {
+		order = binary.BigEndian
+	}
+
+	units := utf16.Encode([]rune(value))
+	if bom {
+		units = append([]uint16{0xfeff}, units...)
+	}
+	result := make([]byte, 2*len(units))
+	for i, u := range units {
+		order.PutUint16(result[2*i:], u)
+	}
+	return result, nil
+}
+
+// BytesLatin1ToString converts ISO 8859-1 bytes to a string.
+//
+// BytesLatin1ToString decodes value as ISO 8859-1 (Latin-1), where each byte
+// is the code point of the same value, so every byte slice is valid.
+//
+// Parameters:
+//   - value: the Latin-1 bytes.
+//
+// Returns:
+//   - string: the decoded UTF-8 string.
+//   - error: nil.
+//
+// Example:
+//
+//	result, err := BytesLatin1ToString([]byte{'c', 'a', 'f', 0xe9})
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: café
+func BytesLatin1ToString(value []byte) (string, error) {
+	var b strings.Builder
+	b.Grow(len(value))
+	for _, c := range value {
+		b.WriteRune(rune(c))
+	}
+	return b.String(), nil
+}
+
+// StringToBytesLatin1 converts a string to ISO 8859-1 bytes.
+//
+// StringToBytesLatin1 encodes value as ISO 8859-1 (Latin-1), which holds
+// only the code points up to U+00FF.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - []byte: the Latin-1 bytes.
+//   - error: a *ConversionError wrapping ErrInvalidUTF8 if value is not
+//     valid UTF-8, or ErrOverflow if it has a code point above U+00FF.
+//
+// Example:
+//
+//	result, err := StringToBytesLatin1("café")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [99 97 102 233]
+func StringToBytesLatin1(value string) ([]byte, error) {
+	result := make([]byte, 0, len(value))
+	for i := 0; i < len(value); {
+		r, n := utf8.DecodeRuneInString(value[i:])
+		switch {
+		case r == utf8.RuneError && n == 1:
+			return nil, &ConversionError{From: "string", To: "Latin-1", Value: value, Err: fmt.Errorf("%w at byte %d", ErrInvalidUTF8, i)}
+		case r > 0xff:
+			return nil, &ConversionError{From: "string", To: "Latin-1", Value: value, Err: fmt.Errorf("%w: %U at byte %d", ErrOverflow, r, i)}
+		}
+		result = append(result, byte(r))
+		i += n
+	}
+	return result, nil
+}
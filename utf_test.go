@@ -0,0 +1,162 @@
+package into_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToValidUTF8(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		invalid InvalidUTF8
+		want    string
+		wantErr error
+	}{
+		{"valid", "héllo", UTF8Reject, "héllo", nil},
+		{"keep", "a\xffb", UTF8Keep, "a\xffb", nil},
+		{"replace", "a\xffb", UTF8Replace, "a�b", nil},
+		{"replaceRun", "a\xff\xfeb", UTF8Replace, "a�b", nil},
+		{"reject", "a\xffb", UTF8Reject, "", ErrInvalidUTF8},
+		{"rejectTruncated", "\xe2\x82", UTF8Reject, "", ErrInvalidUTF8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToValidUTF8(tt.input, tt.invalid)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StringToValidUTF8() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToValidUTF8() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := StringToValidUTF8("a", InvalidUTF8(-1)); err == nil {
+		t.Error("StringToValidUTF8() with an unknown mode error = nil, want an error")
+	}
+}
+
+func TestBytesUTF16ToString(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		order   binary.ByteOrder
+		want    string
+		wantErr error
+	}{
+		{"bigEndian", []byte{0, 'h', 0, 'i'}, binary.BigEndian, "hi", nil},
+		{"littleEndian", []byte{'h', 0, 'i', 0}, binary.LittleEndian, "hi", nil},
+		{"defaultOrder", []byte{0, 'h', 0, 'i'}, nil, "hi", nil},
+		{"bomBig", []byte{0xfe, 0xff, 0, 'h'}, binary.LittleEndian, "h", nil},
+		{"bomLittle", []byte{0xff, 0xfe, 'h', 0}, binary.BigEndian, "h", nil},
+		{"surrogatePair", []byte{0xd8, 0x3d, 0xde, 0x00}, nil, "😀", nil},
+		{"unpairedSurrogate", []byte{0xd8, 0x3d, 0, 'a'}, nil, "�a", nil},
+		{"empty", nil, nil, "", nil},
+		{"oddLength", []byte{0, 'h', 0}, nil, "", ErrLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BytesUTF16ToString(tt.input, tt.order)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("BytesUTF16ToString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BytesUTF16ToString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringToBytesUTF16(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		order   binary.ByteOrder
+		bom     bool
+		want    []byte
+		wantErr error
+	}{
+		{"bigEndian", "hi", binary.BigEndian, false, []byte{0, 'h', 0, 'i'}, nil},
+		{"littleEndianBOM", "hi", binary.LittleEndian, true, []byte{0xff, 0xfe, 'h', 0, 'i', 0}, nil},
+		{"defaultOrderBOM", "h", nil, true, []byte{0xfe, 0xff, 0, 'h'}, nil},
+		{"surrogatePair", "😀", nil, false, []byte{0xd8, 0x3d, 0xde, 0x00}, nil},
+		{"invalid", "a\xff", nil, false, nil, ErrInvalidUTF8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToBytesUTF16(tt.input, tt.order, tt.bom)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StringToBytesUTF16() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("StringToBytesUTF16() = %v, want %v", got, tt.want)
+			}
+			if err == nil {
+				back, err := BytesUTF16ToString(got, tt.order)
+				if err != nil || back != tt.input {
+					t.Errorf("BytesUTF16ToString() = %q, %v, want %q", back, err, tt.input)
+				}
+			}
+		})
+	}
+}
+
+func TestLatin1(t *testing.T) {
+	got, err := BytesLatin1ToString([]byte{'c', 'a', 'f', 0xe9, 0xff})
+	if err != nil || got != "caféÿ" {
+		t.Errorf("BytesLatin1ToString() = %q, %v, want %q", got, err, "caféÿ")
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []byte
+		wantErr error
+	}{
+		{"ascii", "cafe", []byte("cafe"), nil},
+		{"latin1", "caféÿ", []byte{'c', 'a', 'f', 0xe9, 0xff}, nil},
+		{"aboveLatin1", "€", nil, ErrOverflow},
+		{"replacementChar", "�", nil, ErrOverflow},
+		{"invalid", "a\xe9", nil, ErrInvalidUTF8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToBytesLatin1(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StringToBytesLatin1() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("StringToBytesLatin1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionsUTF8(t *testing.T) {
+	defer SetDefaults(Defaults())
+	input := []byte("a\xffb")
+	if got, err := TryIntoString(input); err != nil || got != "a\xffb" {
+		t.Errorf("TryIntoString() = %q, %v, want %q", got, err, "a\xffb")
+	}
+	SetDefaults(Options{UTF8: UTF8Replace})
+	if got, err := TryIntoString(input); err != nil || got != "a�b" {
+		t.Errorf("TryIntoString() with UTF8Replace = %q, %v, want %q", got, err, "a�b")
+	}
+	SetDefaults(Options{UTF8: UTF8Reject})
+	if _, err := TryIntoString(input); !errors.Is(err, ErrInvalidUTF8) {
+		t.Errorf("TryIntoString() with UTF8Reject error = %v, want %v", err, ErrInvalidUTF8)
+	}
+}
@@ -0,0 +1,172 @@
+package into
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// StringToUUID converts the given string value to the 16 bytes of a UUID.
+//
+// StringToUUID converts a UUID in the canonical form
+// "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx" to its 16 bytes. Hex digits may be
+// upper or lower case, and the forms "{xxxxxxxx-...}", "urn:uuid:xxxxxxxx-..."
+// and 32 hex digits without hyphens are also accepted. The version and
+// variant bits are not checked; use StringToUUIDStrict for that.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - [16]byte: the bytes of the UUID.
+//   - error: an error if the string is not a UUID.
+//
+// Example:
+//
+//	result, err := StringToUUID("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(result[0]) // Output: 244
+func StringToUUID(value string) ([16]byte, error) {
+	var u [16]byte
+	s := value
+	switch {
+	case len(s) == 38 && s[0] == '{' && s[37] == '}':
+		s = s[1:37]
+	case len(s) == 45 && strings.EqualFold(s[:9], "urn:uuid:"):
+		s = s[9:]
+	}
+
+	switch len(s) {
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return u, fmt.Errorf("invalid UUID %q", value)
+		}
+		s = s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	case 32:
+	default:
+		return u, fmt.Errorf("invalid UUID %q", value)
+	}
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return u, fmt.Errorf("invalid UUID %q", value)
+	}
+	return u, nil
+}
+
+// StringToUUIDStrict converts the given string value to the 16 bytes of a
+// UUID, checking its version and variant.
+//
+// StringToUUIDStrict converts a UUID like StringToUUID, and also requires
+// the variant of RFC 9562 and a version from 1 to 8. The nil UUID
+// "00000000-0000-0000-0000-000000000000" and the max UUID
+// "ffffffff-ffff-ffff-ffff-ffffffffffff" are accepted.
+//
+// Parameters:
+//   - value: the string value to be converted.
+//
+// Returns:
+//   - [16]byte: the bytes of the UUID.
+//   - error: an error if the string is not a UUID, or if its version or
+//     variant is not valid.
+//
+// Example:
+//
+//	_, err := StringToUUIDStrict("f47ac10b-58cc-0372-a567-0e02b2c3d479")
+//	fmt.Println(err != nil) // Output: true
+func StringToUUIDStrict(value string) ([16]byte, error) {
+	u, err := StringToUUID(value)
+	if err != nil || u == [16]byte{} || u == [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff} {
+		return u, err
+	}
+	if version := u[6] >> 4; version < 1 || version > 8 {
+		return [16]byte{}, fmt.Errorf("UUID %q has the invalid version %d", value, version)
+	}
+	if u[8]&0xc0 != 0x80 {
+		return [16]byte{}, fmt.Errorf("UUID %q does not have the RFC 9562 variant", value)
+	}
+	return u, nil
+}
+
+// UUIDIntoString converts the given 16 bytes of a UUID to a string value.
+//
+// UUIDIntoString converts the bytes of a UUID to the canonical lower-case
+// form "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx".
+//
+// Parameters:
+//   - value: the bytes of the UUID.
+//
+// Returns:
+//   - string: the converted string value.
+func UUIDIntoString(value [16]byte) string {
+	var b [36]byte
+	hex.Encode(b[0:8], value[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], value[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], value[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], value[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], value[10:])
+	return string(b[:])
+}
+
+// UUIDToString converts the given 16 bytes of a UUID to a string value.
+//
+// UUIDToString converts the bytes of a UUID to a string like UUIDIntoString.
+// The conversion cannot fail, so the error is always nil.
+//
+// Parameters:
+//   - value: the bytes of the UUID.
+//
+// Returns:
+//   - string: the converted string value.
+//   - error: nil.
+//
+// Deprecated: Use UUIDIntoString, which has no error to handle.
+func UUIDToString(value [16]byte) (string, error) {
+	return UUIDIntoString(value), nil
+}
+
+// UUIDToUint64Pair converts the given 16 bytes of a UUID to two uint64 values.
+//
+// UUIDToUint64Pair converts the bytes of a UUID to its high and low 64 bits
+// in big-endian order, as stored by databases and protocols that have no
+// 128-bit integer type.
+//
+// Parameters:
+//   - value: the bytes of the UUID.
+//
+// Returns:
+//   - hi: the first 8 bytes as a big-endian uint64.
+//   - lo: the last 8 bytes as a big-endian uint64.
+//   - err: nil.
+//
+// Example:
+//
+//	u, _ := StringToUUID("00000000-0000-0001-0000-000000000002")
+//	hi, lo, _ := UUIDToUint64Pair(u)
+//	fmt.Println(hi, lo) // Output: 1 2
+func UUIDToUint64Pair(value [16]byte) (hi, lo uint64, err error) {
+	return binary.BigEndian.Uint64(value[:8]), binary.BigEndian.Uint64(value[8:]), nil
+}
+
+// Uint64PairToUUID converts the given two uint64 values to the 16 bytes of a UUID.
+//
+// Uint64PairToUUID is the inverse of UUIDToUint64Pair.
+//
+// Parameters:
+//   - hi: the first 8 bytes as a big-endian uint64.
+//   - lo: the last 8 bytes as a big-endian uint64.
+//
+// Returns:
+//   - [16]byte: the bytes of the UUID.
+//   - error: nil.
+func Uint64PairToUUID(hi, lo uint64) ([16]byte, error) {
+	var u [16]byte
+	binary.BigEndian.PutUint64(u[:8], hi)
+	binary.BigEndian.PutUint64(u[8:], lo)
+	return u, nil
+}
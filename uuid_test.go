@@ -0,0 +1,65 @@
+package into_test
+
+import (
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestStringToUUID(t *testing.T) {
+	const canonical = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	tests := []struct {
+		name      string
+		input     string
+		wantErr   bool
+		strictErr bool
+	}{
+		{"canonical", canonical, false, false},
+		{"upper", "F47AC10B-58CC-4372-A567-0E02B2C3D479", false, false},
+		{"braces", "{" + canonical + "}", false, false},
+		{"urn", "urn:uuid:" + canonical, false, false},
+		{"compact", "f47ac10b58cc4372a5670e02b2c3d479", false, false},
+		{"nil", "00000000-0000-0000-0000-000000000000", false, false},
+		{"max", "ffffffff-ffff-ffff-ffff-ffffffffffff", false, false},
+		{"version0", "f47ac10b-58cc-0372-a567-0e02b2c3d479", false, true},
+		{"version9", "f47ac10b-58cc-9372-a567-0e02b2c3d479", false, true},
+		{"microsoftVariant", "f47ac10b-58cc-4372-c567-0e02b2c3d479", false, true},
+		{"misplacedHyphen", "f47ac10b5-8cc-4372-a567-0e02b2c3d479", true, true},
+		{"notHex", "g47ac10b-58cc-4372-a567-0e02b2c3d479", true, true},
+		{"short", "f47ac10b-58cc-4372-a567", true, true},
+		{"empty", "", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := StringToUUID(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StringToUUID(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if _, err := StringToUUIDStrict(tt.input); (err != nil) != tt.strictErr {
+				t.Errorf("StringToUUIDStrict(%q) error = %v, wantErr %v", tt.input, err, tt.strictErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			s := UUIDIntoString(u)
+			if back, err := StringToUUID(s); err != nil || back != u {
+				t.Errorf("StringToUUID(UUIDIntoString(%v)) = %v, %v", u, back, err)
+			}
+			hi, lo, _ := UUIDToUint64Pair(u)
+			if back, _ := Uint64PairToUUID(hi, lo); back != u {
+				t.Errorf("Uint64PairToUUID(UUIDToUint64Pair(%v)) = %v", u, back)
+			}
+		})
+	}
+
+	u, _ := StringToUUID("{F47AC10B-58CC-4372-A567-0E02B2C3D479}")
+	if s := UUIDIntoString(u); s != canonical {
+		t.Errorf("UUIDIntoString() = %q, want %q", s, canonical)
+	}
+	u, _ = StringToUUID("00000000-0000-0001-0000-000000000002")
+	if hi, lo, _ := UUIDToUint64Pair(u); hi != 1 || lo != 2 {
+		t.Errorf("UUIDToUint64Pair() = %v, %v, want 1, 2", hi, lo)
+	}
+}
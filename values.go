@@ -0,0 +1,129 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// DecodeValues decodes form or query string values into the fields of a struct.
+//
+// DecodeValues walks the exported fields of the struct pointed to by dst
+// like BindOptions does, taking the key of each field from the `into`
+// struct tag, and converts the values stored under the key with the checked
+// string converters:
+//   - slice fields (e.g. []int) receive every value of the key, in order.
+//   - other fields receive the first value, as url.Values.Get returns it.
+//   - pointer fields are allocated only when the key is present, so they
+//     stay nil for optional values that were not sent.
+//
+// Nested structs are decoded with dotted keys (e.g. "page.size"), keys
+// without a matching field are ignored and fields tagged
+// `into:"name,required"` must be present. The options set by SetDefaults are
+// applied to every value.
+//
+// Parameters:
+//   - dst: a non-nil pointer to the struct to be filled.
+//   - values: the values to be decoded, such as url.URL.Query() or
+//     http.Request.Form.
+//
+// Returns:
+//   - error: a FieldErrors naming the key and field of every conversion that
+//     fails and every required key that is missing.
+//
+// Example:
+//
+//	type Search struct {
+//	  Query string   `into:"q,required"`
+//	  Tags  []string `into:"tag"`
+//	  Page  *uint    `into:"page"`
+//	}
+//	values, _ := url.ParseQuery("q=gopher&tag=go&tag=fun")
+//	var search Search
+//	if err := DecodeValues(&search, values); err != nil {
+//	  log.Fatal(err)
+//	}
+//	fmt.Println(search.Query, search.Tags, search.Page) // Output: gopher [go fun] <nil>
+func DecodeValues(dst any, values url.Values) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("destination must be a non-nil pointer to a struct")
+	}
+	errs := FieldErrors{}
+	decodeStruct(rv.Elem(), values, "", Defaults(), errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// decodeStruct decodes values into the fields of the struct value v.
+//
+// decodeStruct decodes values into the fields of the struct value v, looking
+// up each field's key with the given prefix, as bindStruct does for a map of
+// single values.
+//
+// Parameters:
+//   - v: the addressable struct value to be filled.
+//   - values: the values keyed by field key.
+//   - prefix: the prefix prepended to every key of v.
+//   - opts: the conversion policies.
+//   - errs: the failures, added under the key of the field that failed when
+//     a conversion fails or a required key is missing.
+func decodeStruct(v reflect.Value, values url.Values, prefix string, opts Options, errs FieldErrors) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !(field.Anonymous && isNestedStruct(field.Type)) {
+			continue
+		}
+
+		name, required := parseBindTag(field)
+		if name == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+		if isNestedStruct(field.Type) {
+			nested := prefix + name + "."
+			if field.Anonymous && field.Tag.Get("into") == "" {
+				nested = prefix
+			}
+			decodeStruct(fv, values, nested, opts, errs)
+			continue
+		}
+
+		key := prefix + name
+		vs, ok := values[key]
+		if !ok || len(vs) == 0 {
+			if required {
+				errs[key] = fmt.Errorf("missing required key %q for field %s", key, field.Name)
+			}
+			continue
+		}
+		if err := setStrings(fv, vs, opts); err != nil {
+			errs[key] = fmt.Errorf("cannot decode key %q to field %s: %w", key, field.Name, err)
+		}
+	}
+}
+
+// setStrings converts vs to the type of v and stores the result in v.
+//
+// A slice v receives one element per value; v is only replaced once every
+// value has been converted. Any other v receives the first value, converted
+// by setString.
+func setStrings(v reflect.Value, vs []string, opts Options) error {
+	if v.Kind() != reflect.Slice {
+		return setString(v, vs[0], opts)
+	}
+
+	s := reflect.MakeSlice(v.Type(), len(vs), len(vs))
+	for i, value := range vs {
+		if err := setString(s.Index(i), value, opts); err != nil {
+			return fmt.Errorf("value %d: %w", i, err)
+		}
+	}
+	v.Set(s)
+	return nil
+}
@@ -0,0 +1,79 @@
+package into_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestDecodeValues(t *testing.T) {
+	type search struct {
+		Query   string        `into:"q,required"`
+		Tags    []string      `into:"tag"`
+		IDs     []uint16      `into:"id"`
+		Page    *uint         `into:"page"`
+		Limit   *uint         `into:"limit"`
+		Timeout time.Duration `into:"timeout"`
+		Filter  struct {
+			Min int `into:"min"`
+		} `into:"filter"`
+	}
+
+	values, err := url.ParseQuery("q=gopher&tag=go&tag=fun&id=1&id=2&page=3&timeout=2s&filter.min=-5&extra=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got search
+	if err := DecodeValues(&got, values); err != nil {
+		t.Fatalf("DecodeValues() error = %v", err)
+	}
+	if got.Query != "gopher" || len(got.Tags) != 2 || got.Tags[1] != "fun" || len(got.IDs) != 2 || got.IDs[1] != 2 ||
+		got.Page == nil || *got.Page != 3 || got.Limit != nil || got.Timeout != 2*time.Second || got.Filter.Min != -5 {
+		t.Errorf("DecodeValues() = %+v", got)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		keys    []string
+		wantErr error
+	}{
+		{"missingRequired", "tag=go", []string{"q"}, nil},
+		{"sliceOverflow", "q=x&id=1&id=70000", []string{"id"}, ErrOverflow},
+		{"scalarSyntax", "q=x&page=abc", []string{"page"}, nil},
+		{"several", "id=-1&filter.min=x", []string{"q", "id", "filter.min"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dst := search{IDs: []uint16{9}}
+			err = DecodeValues(&dst, values)
+			var fieldErrs FieldErrors
+			if !errors.As(err, &fieldErrs) || len(fieldErrs) != len(tt.keys) {
+				t.Fatalf("DecodeValues() error = %v, want errors for %v", err, tt.keys)
+			}
+			for _, key := range tt.keys {
+				if fieldErrs[key] == nil {
+					t.Errorf("DecodeValues() error = %v, want an error for %q", err, key)
+				}
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("DecodeValues() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(dst.IDs) != 1 || dst.IDs[0] != 9 {
+				t.Errorf("DecodeValues() changed IDs to %v after a failure", dst.IDs)
+			}
+		})
+	}
+
+	if err := DecodeValues(search{}, url.Values{}); err == nil {
+		t.Errorf("DecodeValues() with a non-pointer should fail")
+	}
+}
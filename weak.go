@@ -0,0 +1,68 @@
+package into
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// weakValue converts value to the basic type of kind by the coercion rules
+// of the Weak option, and reports whether a rule applied. If none does, the
+// value is converted by the regular rules.
+func weakValue(kind reflect.Kind, value any) (any, bool, error) {
+	switch v := value.(type) {
+	case bool:
+		if kind == reflect.String {
+			if v {
+				return "1", true, nil
+			}
+			return "0", true, nil
+		}
+	case string:
+		if kind == reflect.String {
+			return nil, false, nil
+		}
+		if v == "" {
+			return reflect.Zero(basicTypes[kind]).Interface(), true, nil
+		}
+		if kind == reflect.Bool {
+			r, err := StringToBoolNumeric(v)
+			return r, true, err
+		}
+		if b, err := strconv.ParseBool(v); err == nil && isNumericKind(kind) {
+			r, err := toKind(kind, b)
+			return r, true, err
+		}
+		if isIntegerKind(kind) && hasBasePrefix(v) {
+			var (
+				n   any
+				err error
+			)
+			if kind >= reflect.Uint && !strings.HasPrefix(v, "-") {
+				n, err = strconv.ParseUint(v, 0, 64)
+			} else {
+				n, err = strconv.ParseInt(v, 0, 64)
+			}
+			if err != nil {
+				return nil, true, parseError(err, v, kind.String())
+			}
+			r, err := toKind(kind, n)
+			return r, true, err
+		}
+	}
+	return nil, false, nil
+}
+
+// hasBasePrefix reports whether s, after an optional sign, starts with the
+// 0x, 0o or 0b prefix of a Go integer literal.
+func hasBasePrefix(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	if len(s) < 2 || s[0] != '0' {
+		return false
+	}
+	switch s[1] {
+	case 'x', 'X', 'o', 'O', 'b', 'B':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package into_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+func TestWeak(t *testing.T) {
+	weak := Options{Weak: true}
+	tests := []struct {
+		name    string
+		convert func(opts Options) (any, error)
+		want    any
+		wantErr error
+	}{
+		{"emptyInt", func(o Options) (any, error) { return TryIntoWith[int]("", o) }, 0, nil},
+		{"emptyFloat", func(o Options) (any, error) { return TryIntoWith[float64]("", o) }, 0.0, nil},
+		{"emptyBool", func(o Options) (any, error) { return TryIntoWith[bool]("", o) }, false, nil},
+		{"trueInt", func(o Options) (any, error) { return TryIntoWith[int]("true", o) }, 1, nil},
+		{"falseUint", func(o Options) (any, error) { return TryIntoWith[uint8]("false", o) }, uint8(0), nil},
+		{"trueFloat", func(o Options) (any, error) { return TryIntoWith[float64]("true", o) }, 1.0, nil},
+		{"hex", func(o Options) (any, error) { return TryIntoWith[int]("0x1f", o) }, 31, nil},
+		{"negativeHex", func(o Options) (any, error) { return TryIntoWith[int]("-0x10", o) }, -16, nil},
+		{"binary", func(o Options) (any, error) { return TryIntoWith[uint]("0b101", o) }, uint(5), nil},
+		{"octal", func(o Options) (any, error) { return TryIntoWith[int16]("0o17", o) }, int16(15), nil},
+		{"hexOverflow", func(o Options) (any, error) { return TryIntoWith[int8]("0x80", o) }, int8(0), ErrOverflow},
+		{"negativeHexUint", func(o Options) (any, error) { return TryIntoWith[uint]("-0x1", o) }, uint(0), ErrUnderflow},
+		{"decimal", func(o Options) (any, error) { return TryIntoWith[int]("42", o) }, 42, nil},
+		{"numericBool", func(o Options) (any, error) { return TryIntoWith[bool]("2", o) }, true, nil},
+		{"zeroBool", func(o Options) (any, error) { return TryIntoWith[bool]("0", o) }, false, nil},
+		{"intBool", func(o Options) (any, error) { return TryIntoWith[bool](-3, o) }, true, nil},
+		{"boolString", func(o Options) (any, error) { return TryIntoWith[string](true, o) }, "1", nil},
+		{"falseString", func(o Options) (any, error) { return TryIntoWith[string](false, o) }, "0", nil},
+		{"trimmedEmpty", func(o Options) (any, error) {
+			o.TrimSpace = true
+			return TryIntoWith[int]("  ", o)
+		}, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert(weak)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("TryIntoWith() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TryIntoWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeakDisabled(t *testing.T) {
+	if _, err := TryIntoWith[int]("", Options{}); err == nil {
+		t.Error(`TryIntoWith[int]("") error = nil, want an error`)
+	}
+	if _, err := TryIntoWith[int]("true", Options{}); err == nil {
+		t.Error(`TryIntoWith[int]("true") error = nil, want an error`)
+	}
+	if _, err := TryIntoWith[int]("0x1f", Options{}); err == nil {
+		t.Error(`TryIntoWith[int]("0x1f") error = nil, want an error`)
+	}
+	if got, err := TryIntoWith[string](true, Options{}); err != nil || got != "true" {
+		t.Errorf("TryIntoWith[string](true) = %q, %v, want %q", got, err, "true")
+	}
+}
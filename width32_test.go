@@ -0,0 +1,54 @@
+//go:build 386 || arm || mips || mipsle
+
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+// TestIntWidth checks the conversions whose range depends on the size of
+// int and uint, as seen on 32-bit platforms. width64_test.go holds the
+// 64-bit counterpart.
+func TestIntWidth(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (any, error)
+		want    any
+		wantErr error
+	}{
+		{"Uint32ToIntOverflow", func() (any, error) { return Uint32ToInt(math.MaxUint32) }, nil, ErrOverflow},
+		{"Uint32ToIntMax", func() (any, error) { return Uint32ToInt(math.MaxInt32) }, int(math.MaxInt32), nil},
+		{"UintToIntMax", func() (any, error) { return UintToInt(math.MaxInt) }, int(math.MaxInt32), nil},
+		{"UintToIntOverflow", func() (any, error) { return UintToInt(math.MaxInt + 1) }, nil, ErrOverflow},
+		{"Int64ToIntOverflow", func() (any, error) { return Int64ToInt(math.MaxInt32 + 1) }, nil, ErrOverflow},
+		{"Int64ToIntUnderflow", func() (any, error) { return Int64ToInt(math.MinInt32 - 1) }, nil, ErrUnderflow},
+		{"Uint64ToIntOverflow", func() (any, error) { return Uint64ToInt(math.MaxInt32 + 1) }, nil, ErrOverflow},
+		{"Int64ToUintOverflow", func() (any, error) { return Int64ToUint(math.MaxUint32 + 1) }, nil, ErrOverflow},
+		{"Uint64ToUintOverflow", func() (any, error) { return Uint64ToUint(math.MaxUint32 + 1) }, nil, ErrOverflow},
+		{"IntToInt32", func() (any, error) { return IntToInt32(math.MaxInt) }, int32(math.MaxInt32), nil},
+		{"IntToUint32", func() (any, error) { return IntToUint32(math.MaxInt) }, uint32(math.MaxInt32), nil},
+		{"UintToInt64", func() (any, error) { return UintToInt64(math.MaxUint) }, int64(math.MaxUint32), nil},
+		{"StringToInt", func() (any, error) { return StringToInt("2147483648") }, nil, ErrOverflow},
+		{"StringToUint", func() (any, error) { return StringToUint("4294967296") }, nil, ErrOverflow},
+		{"Float64ToIntOverflow", func() (any, error) { return Float64ToInt(1 << 31) }, nil, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("got %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
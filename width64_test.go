@@ -0,0 +1,52 @@
+//go:build !386 && !arm && !mips && !mipsle
+
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/zenless-lab/into"
+)
+
+// TestIntWidth checks the conversions whose range depends on the size of
+// int and uint, as seen on 64-bit platforms. width32_test.go holds the
+// 32-bit counterpart.
+func TestIntWidth(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (any, error)
+		want    any
+		wantErr error
+	}{
+		{"Uint32ToInt", func() (any, error) { return Uint32ToInt(math.MaxUint32) }, int(math.MaxUint32), nil},
+		{"UintToIntMax", func() (any, error) { return UintToInt(math.MaxInt) }, int(math.MaxInt64), nil},
+		{"UintToIntOverflow", func() (any, error) { return UintToInt(math.MaxInt + 1) }, nil, ErrOverflow},
+		{"Int64ToInt", func() (any, error) { return Int64ToInt(math.MinInt64) }, int(math.MinInt64), nil},
+		{"Uint64ToIntOverflow", func() (any, error) { return Uint64ToInt(1 << 63) }, nil, ErrOverflow},
+		{"Int64ToUint", func() (any, error) { return Int64ToUint(math.MaxInt64) }, uint(math.MaxInt64), nil},
+		{"Uint64ToUint", func() (any, error) { return Uint64ToUint(math.MaxUint64) }, uint(math.MaxUint64), nil},
+		{"IntToInt32Overflow", func() (any, error) { return IntToInt32(math.MaxInt32 + 1) }, nil, ErrOverflow},
+		{"IntToUint32Overflow", func() (any, error) { return IntToUint32(math.MaxUint32 + 1) }, nil, ErrOverflow},
+		{"UintToInt64Overflow", func() (any, error) { return UintToInt64(math.MaxUint) }, nil, ErrOverflow},
+		{"StringToInt", func() (any, error) { return StringToInt("9223372036854775807") }, int(math.MaxInt64), nil},
+		{"StringToUint", func() (any, error) { return StringToUint("18446744073709551615") }, uint(math.MaxUint64), nil},
+		{"Float64ToIntOverflow", func() (any, error) { return Float64ToInt(1 << 63) }, nil, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("got %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}